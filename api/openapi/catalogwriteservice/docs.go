@@ -1,5 +1,4 @@
-// Code generated by swaggo/swag. DO NOT EDIT.
-
+// Package catalogwriteservice Code generated by swaggo/swag. DO NOT EDIT
 package catalogwriteservice
 
 import "github.com/swaggo/swag"
@@ -33,6 +32,26 @@ const docTemplate = `{
                 ],
                 "summary": "Get all product",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "After and Before are the cursors of the list results for the keyset pagination, the page is ignored with a cursor",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "filters",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "name": "orderBy",
@@ -53,7 +72,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_getting_products_v1_dtos.GetProductsResponseDto"
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_gettingproducts_v1_dtos.GetProductsResponseDto"
                         }
                     }
                 }
@@ -77,7 +96,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creating_product_v1_dtos.CreateProductRequestDto"
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creatingproduct_v1_dtos.CreateProductRequestDto"
                         }
                     }
                 ],
@@ -85,7 +104,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creating_product_v1_dtos.CreateProductResponseDto"
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creatingproduct_v1_dtos.CreateProductResponseDto"
                         }
                     }
                 }
@@ -115,7 +134,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_searching_product_v1_dtos.SearchProductsResponseDto"
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_searchingproduct_v1_dtos.SearchProductsResponseDto"
                         }
                     }
                 }
@@ -147,7 +166,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_getting_product_by_id_v1_dtos.GetProductByIdResponseDto"
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_gettingproductbyid_v1_dtos.GetProductByIdResponseDto"
                         }
                     }
                 }
@@ -171,7 +190,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_updating_product_v1_dtos.UpdateProductRequestDto"
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_updatingproduct_v1_dtos.UpdateProductRequestDto"
                         }
                     },
                     {
@@ -218,7 +237,7 @@ const docTemplate = `{
         }
     },
     "definitions": {
-        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dto_v1.ProductDto": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dtos_v1.ProductDto": {
             "type": "object",
             "properties": {
                 "createdAt": {
@@ -227,21 +246,21 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
                 "price": {
                     "type": "number"
                 },
-                "productId": {
-                    "type": "string"
-                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creating_product_v1_dtos.CreateProductRequestDto": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creatingproduct_v1_dtos.CreateProductRequestDto": {
             "type": "object",
             "properties": {
                 "description": {
@@ -255,7 +274,7 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creating_product_v1_dtos.CreateProductResponseDto": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_creatingproduct_v1_dtos.CreateProductResponseDto": {
             "type": "object",
             "properties": {
                 "productId": {
@@ -263,31 +282,31 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_getting_product_by_id_v1_dtos.GetProductByIdResponseDto": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_gettingproductbyid_v1_dtos.GetProductByIdResponseDto": {
             "type": "object",
             "properties": {
                 "product": {
-                    "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dto_v1.ProductDto"
+                    "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dtos_v1.ProductDto"
                 }
             }
         },
-        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_getting_products_v1_dtos.GetProductsResponseDto": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_gettingproducts_v1_dtos.GetProductsResponseDto": {
             "type": "object",
             "properties": {
                 "products": {
-                    "$ref": "#/definitions/utils.ListResult-github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dto_v1_ProductDto"
+                    "$ref": "#/definitions/utils.ListResult-github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dtos_v1_ProductDto"
                 }
             }
         },
-        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_searching_product_v1_dtos.SearchProductsResponseDto": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_searchingproduct_v1_dtos.SearchProductsResponseDto": {
             "type": "object",
             "properties": {
                 "products": {
-                    "$ref": "#/definitions/utils.ListResult-github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dto_v1_ProductDto"
+                    "$ref": "#/definitions/utils.ListResult-github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dtos_v1_ProductDto"
                 }
             }
         },
-        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_updating_product_v1_dtos.UpdateProductRequestDto": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_features_updatingproduct_v1_dtos.UpdateProductRequestDto": {
             "type": "object",
             "properties": {
                 "description": {
@@ -301,27 +320,20 @@ const docTemplate = `{
                 }
             }
         },
-        "utils.FilterModel": {
+        "utils.ListQuery": {
             "type": "object",
             "properties": {
-                "comparison": {
+                "after": {
+                    "description": "After and Before are the cursors of the list results for the keyset pagination, the page is ignored with a cursor",
                     "type": "string"
                 },
-                "field": {
+                "before": {
                     "type": "string"
                 },
-                "value": {
-                    "type": "string"
-                }
-            }
-        },
-        "utils.ListQuery": {
-            "type": "object",
-            "properties": {
                 "filters": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/utils.FilterModel"
+                        "type": "string"
                     }
                 },
                 "orderBy": {
@@ -335,18 +347,25 @@ const docTemplate = `{
                 }
             }
         },
-        "utils.ListResult-github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dto_v1_ProductDto": {
+        "utils.ListResult-github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dtos_v1_ProductDto": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dto_v1.ProductDto"
+                        "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_catalogwriteservice_internal_products_dtos_v1.ProductDto"
                     }
                 },
+                "nextCursor": {
+                    "type": "string"
+                },
                 "page": {
                     "type": "integer"
                 },
+                "previousCursor": {
+                    "description": "PreviousCursor and NextCursor are the ` + "`" + `before` + "`" + ` and the ` + "`" + `after` + "`" + ` cursors of the pages around the items, they are\nempty when there is no page",
+                    "type": "string"
+                },
                 "size": {
                     "type": "integer"
                 },
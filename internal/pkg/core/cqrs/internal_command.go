@@ -16,6 +16,11 @@ func NewInternalCommandByT[T any]() InternalCommand {
 func (c *internalCommand) isInternalCommand() {
 }
 
+// MarshalJSON skips the embedded command in the stored internal commands, it is initialized again by the internal commands registry
+func (c *internalCommand) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
 func IsInternalCommand(obj interface{}) bool {
 	if _, ok := obj.(InternalCommand); ok {
 		return true
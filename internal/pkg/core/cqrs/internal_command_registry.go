@@ -0,0 +1,123 @@
+package cqrs
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// internalCommandRegistration creates an empty internal command for deserializing the stored data and sends it through mediatr
+type internalCommandRegistration struct {
+	newCommand func() InternalCommand
+	send       func(ctx context.Context, command InternalCommand) error
+}
+
+// internal commands are dispatched by their stored type name, so like the mediatr handlers we keep them in a global registry
+var (
+	internalCommandsMu sync.RWMutex
+	internalCommands   = map[string]*internalCommandRegistration{}
+)
+
+// RegisterInternalCommand registers the internal command for dispatching its stored messages with `mediatr.Send`, the command
+// handler should be registered in mediatr separately
+func RegisterInternalCommand[TCommand InternalCommand, TResponse any]() error {
+	typ := typemapper.GetGenericTypeByT[TCommand]()
+	if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("internal command `%s` should be a pointer to a struct", typ.String())
+	}
+
+	typeName := typemapper.GetGenericFullTypeNameByT[TCommand]()
+
+	internalCommandsMu.Lock()
+	defer internalCommandsMu.Unlock()
+
+	if _, exists := internalCommands[typeName]; exists {
+		return errors.Errorf("internal command `%s` is already registered", typeName)
+	}
+
+	internalCommands[typeName] = &internalCommandRegistration{
+		newCommand: func() InternalCommand {
+			return newInternalCommandInstance[TCommand](typ)
+		},
+		send: func(ctx context.Context, command InternalCommand) error {
+			typedCommand, ok := command.(TCommand)
+			if !ok {
+				return errors.Errorf("internal command is `%T`, expected `%s`", command, typeName)
+			}
+
+			initializeInternalCommand[TCommand](typedCommand)
+
+			_, err := mediatr.Send[TCommand, TResponse](ctx, typedCommand)
+
+			return err
+		},
+	}
+
+	return nil
+}
+
+// NewInternalCommandInstance returns an empty instance of the registered internal command for deserializing its stored data
+func NewInternalCommandInstance(typeName string) (InternalCommand, error) {
+	registration, err := getInternalCommandRegistration(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return registration.newCommand(), nil
+}
+
+// SendInternalCommand sends the internal command to its mediatr handler
+func SendInternalCommand(ctx context.Context, command InternalCommand) error {
+	registration, err := getInternalCommandRegistration(typemapper.GetFullTypeName(command))
+	if err != nil {
+		return err
+	}
+
+	return registration.send(ctx, command)
+}
+
+// ClearInternalCommandRegistrations removes all the registered internal commands
+func ClearInternalCommandRegistrations() {
+	internalCommandsMu.Lock()
+	defer internalCommandsMu.Unlock()
+
+	internalCommands = map[string]*internalCommandRegistration{}
+}
+
+func getInternalCommandRegistration(typeName string) (*internalCommandRegistration, error) {
+	internalCommandsMu.RLock()
+	defer internalCommandsMu.RUnlock()
+
+	registration, ok := internalCommands[typeName]
+	if !ok {
+		return nil, errors.Errorf("internal command `%s` is not registered", typeName)
+	}
+
+	return registration, nil
+}
+
+func newInternalCommandInstance[TCommand InternalCommand](typ reflect.Type) InternalCommand {
+	command := reflect.New(typ.Elem()).Interface().(InternalCommand)
+	initializeInternalCommand[TCommand](command)
+
+	return command
+}
+
+// initializeInternalCommand sets the embedded `InternalCommand` of the command, the embedded command is not serialized with the
+// stored data, so it is nil after deserializing
+func initializeInternalCommand[TCommand InternalCommand](command InternalCommand) {
+	value := reflect.ValueOf(command).Elem()
+	internalCommandType := reflect.TypeOf((*InternalCommand)(nil)).Elem()
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if value.Type().Field(i).Anonymous && field.Type() == internalCommandType && field.CanSet() && field.IsNil() {
+			field.Set(reflect.ValueOf(NewInternalCommandByT[TCommand]()))
+		}
+	}
+}
@@ -1 +1,82 @@
 package cqrs
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mehdihadeli/go-mediatr"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Command(t *testing.T) {
+	command := &RecalculateStockTest{
+		InternalCommand: NewInternalCommandByT[*RecalculateStockTest](),
+		ProductID:       uuid.NewV4(),
+	}
+
+	assert.True(t, IsInternalCommand(command))
+	assert.True(t, IsCommand(command))
+	assert.True(t, IsRequest(command))
+	assert.Equal(t, "*cqrs.RecalculateStockTest", command.FullTypeName())
+}
+
+func Test_Send_Internal_Command_Should_Dispatch_Deserialized_Command(t *testing.T) {
+	defer ClearInternalCommandRegistrations()
+	defer mediatr.ClearRequestRegistrations()
+
+	handler := &recalculateStockTestHandler{}
+	require.NoError(t, mediatr.RegisterRequestHandler[*RecalculateStockTest, *mediatr.Unit](handler))
+	require.NoError(t, RegisterInternalCommand[*RecalculateStockTest, *mediatr.Unit]())
+
+	command := &RecalculateStockTest{
+		InternalCommand: NewInternalCommandByT[*RecalculateStockTest](),
+		ProductID:       uuid.NewV4(),
+	}
+	data, err := json.Marshal(command)
+	require.NoError(t, err)
+
+	storedCommand, err := NewInternalCommandInstance(command.FullTypeName())
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, storedCommand))
+
+	err = SendInternalCommand(context.Background(), storedCommand)
+	require.NoError(t, err)
+
+	require.NotNil(t, handler.command)
+	assert.Equal(t, command.ProductID, handler.command.ProductID)
+	assert.Equal(t, command.FullTypeName(), handler.command.FullTypeName())
+}
+
+func Test_Register_Internal_Command_Should_Fail_For_Duplicate_Registration(t *testing.T) {
+	defer ClearInternalCommandRegistrations()
+
+	require.NoError(t, RegisterInternalCommand[*RecalculateStockTest, *mediatr.Unit]())
+	assert.Error(t, RegisterInternalCommand[*RecalculateStockTest, *mediatr.Unit]())
+}
+
+func Test_New_Internal_Command_Instance_Should_Fail_For_Unregistered_Command(t *testing.T) {
+	_, err := NewInternalCommandInstance("*cqrs.UnknownCommand")
+	assert.Error(t, err)
+}
+
+type RecalculateStockTest struct {
+	InternalCommand
+
+	ProductID uuid.UUID
+}
+
+type recalculateStockTestHandler struct {
+	command *RecalculateStockTest
+}
+
+func (h *recalculateStockTestHandler) Handle(
+	ctx context.Context,
+	command *RecalculateStockTest,
+) (*mediatr.Unit, error) {
+	h.command = command
+
+	return &mediatr.Unit{}, nil
+}
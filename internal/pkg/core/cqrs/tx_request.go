@@ -2,8 +2,17 @@ package cqrs
 
 // https://www.mohitkhare.com/blog/go-naming-conventions/
 // https://github.com/EventStore/EventStore-Client-Go/blob/master/esdb/position.go
+
+// TxRequest marks the requests which run in a unit of work on the mediatr pipeline, the marker method is exported so the
+// requests of the services can implement it
 type TxRequest interface {
-	Request
+	IsTxRequest()
+}
+
+func IsTxRequest(obj interface{}) bool {
+	if _, ok := obj.(TxRequest); ok {
+		return true
+	}
 
-	isTxRequest()
+	return false
 }
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 
@@ -14,6 +15,10 @@ type GenericRepositoryWithDataModel[TDataModel interface{}, TEntity interface{}]
 	AddAll(ctx context.Context, entities []TEntity) error
 	GetById(ctx context.Context, id uuid.UUID) (TEntity, error)
 	GetByFilter(ctx context.Context, filters map[string]interface{}) ([]TEntity, error)
+	// GetByFuncFilter loads all the entities and filters them in memory, because the function can't be translated to a
+	// database query.
+	//
+	// Deprecated: use Find with a specification, which is filtered by the database.
 	GetByFuncFilter(ctx context.Context, filterFunc func(TEntity) bool) ([]TEntity, error)
 	GetAll(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[TEntity], error)
 	FirstOrDefault(ctx context.Context, filters map[string]interface{}) (TEntity, error)
@@ -24,6 +29,10 @@ type GenericRepositoryWithDataModel[TDataModel interface{}, TEntity interface{}]
 	SkipTake(ctx context.Context, skip int, take int) ([]TEntity, error)
 	Count(ctx context.Context) int64
 	Find(ctx context.Context, specification specification.Specification) ([]TEntity, error)
+	// FindPage returns the page of the entities which match the filters of the list query, sorted by the `orderBy`, the
+	// filters and the sorts are validated against the allow-list of the schema, and the `after` and `before` cursors
+	// page by the key field of the schema
+	FindPage(ctx context.Context, listQuery *utils.ListQuery, schema *listquery.Schema) (*utils.ListResult[TEntity], error)
 }
 
 type GenericRepository[TEntity interface{}] interface {
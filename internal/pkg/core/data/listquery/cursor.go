@@ -0,0 +1,285 @@
+package listquery
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+
+	"emperror.dev/errors"
+	"github.com/goccy/go-json"
+	uuid "github.com/satori/go.uuid"
+)
+
+// https://use-the-index-luke.com/no-offset
+
+// Cursors are the opaque tokens of the pages before and after a page, they are empty when there is no page
+type Cursors struct {
+	Previous string
+	Next     string
+}
+
+// cursor is the encoded content of the tokens, the sorts are kept to reject the cursors of the other `orderBy`s
+type cursor struct {
+	Sorts  string   `json:"s"`
+	Values []string `json:"v"`
+}
+
+// Offset is the number of the skipped items, the cursor pages start from the cursor
+func (q *Query) Offset(listQuery *utils.ListQuery) int {
+	if q.Keyset != nil {
+		return 0
+	}
+
+	return listQuery.GetOffset()
+}
+
+// Limit is the number of the fetched items, one more item than the size is fetched for the cursors to know whether there
+// is a page after the items
+func (q *Query) Limit(listQuery *utils.ListQuery) int {
+	if !q.cursorable() || listQuery.GetLimit() <= 0 {
+		return listQuery.GetLimit()
+	}
+
+	return listQuery.GetLimit() + 1
+}
+
+// Page trims the items which are fetched with the `Offset` and the `Limit` of the query to the size of the list query,
+// and returns them in the order of the `orderBy` with the cursors of the previous and the next pages
+func Page[T any](query *Query, listQuery *utils.ListQuery, items []T) ([]T, Cursors, error) {
+	if !query.cursorable() || listQuery.GetLimit() <= 0 {
+		return items, Cursors{}, nil
+	}
+
+	hasMore := len(items) > listQuery.GetLimit()
+	if hasMore {
+		items = items[:listQuery.GetLimit()]
+	}
+
+	backward := query.Keyset != nil && query.Keyset.Backward
+	if backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	if len(items) == 0 {
+		return items, Cursors{}, nil
+	}
+
+	first, err := query.encodeCursor(items[0])
+	if err != nil {
+		return nil, Cursors{}, err
+	}
+	last, err := query.encodeCursor(items[len(items)-1])
+	if err != nil {
+		return nil, Cursors{}, err
+	}
+
+	var cursors Cursors
+	switch {
+	case backward:
+		// the item of the `before` cursor is after the page
+		cursors.Next = last
+		if hasMore {
+			cursors.Previous = first
+		}
+	default:
+		if hasMore {
+			cursors.Next = last
+		}
+		if query.Keyset != nil || listQuery.GetOffset() > 0 {
+			cursors.Previous = first
+		}
+	}
+
+	return items, cursors, nil
+}
+
+// cursorable reports whether the sorts end with the key of the schema, so every item has a unique position, the json paths
+// can't be read from the items, and the nullable fields are just sorted with the offset pages, because the databases
+// order the nulls differently and the comparisons of the keyset don't match them
+func (q *Query) cursorable() bool {
+	if len(q.sorts) == 0 || !q.sorts[len(q.sorts)-1].field.key {
+		return false
+	}
+
+	for _, sort := range q.sorts {
+		if sort.field.nullable || len(sort.field.fieldName.Path) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (q *Query) parseCursor(listQuery *utils.ListQuery) error {
+	token, backward := listQuery.After, false
+	if listQuery.Before != "" {
+		if listQuery.After != "" {
+			return errors.New("list query can't have both the after and the before cursors")
+		}
+		token, backward = listQuery.Before, true
+	}
+
+	if token == "" {
+		return nil
+	}
+	if !q.cursorable() {
+		return errors.New("cursors are not supported by the list query")
+	}
+
+	values, err := q.decodeCursor(token)
+	if err != nil {
+		return err
+	}
+
+	q.Keyset = &Keyset{Specification: keysetSpecification(q.sorts, values, backward), Backward: backward}
+	q.Sorts = toSorts(q.sorts, backward)
+
+	return nil
+}
+
+// keysetSpecification selects the items after the values in the order of the sorts, e.g. `price > 10 OR (price = 10 AND
+// id > 5)` for the `price,id` sorts and the `10,5` values
+func keysetSpecification(sorts []fieldSort, values []any, backward bool) specification.Specification {
+	specifications := make([]specification.Specification, 0, len(sorts))
+
+	for i, sort := range sorts {
+		operator := specification.OperatorGreaterThan
+		if sort.descending != backward {
+			operator = specification.OperatorLessThan
+		}
+
+		conditions := make([]specification.Specification, 0, i+1)
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, sorts[j].field.specification(specification.OperatorEqual, values[j]))
+		}
+		conditions = append(conditions, sort.field.specification(operator, values[i]))
+
+		if len(conditions) == 1 {
+			specifications = append(specifications, conditions[0])
+		} else {
+			specifications = append(specifications, specification.And(conditions...))
+		}
+	}
+
+	if len(specifications) == 1 {
+		return specifications[0]
+	}
+
+	return specification.Or(specifications...)
+}
+
+func (q *Query) encodeCursor(item any) (string, error) {
+	value := reflect.ValueOf(item)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "", errors.New("can't create the cursor of a nil item")
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return "", errors.Errorf("can't create the cursor of the '%s' item", value.Type())
+	}
+
+	content := cursor{Sorts: q.sortsSignature(), Values: make([]string, 0, len(q.sorts))}
+	for _, sort := range q.sorts {
+		fieldValue := value.FieldByName(sort.field.structField)
+		if !fieldValue.IsValid() {
+			return "", errors.Errorf("item '%s' doesn't have the field '%s'", value.Type(), sort.field.structField)
+		}
+
+		formatted, err := formatValue(fieldValue)
+		if err != nil {
+			return "", errors.WrapIff(err, "value of field '%s' can't be used in the cursor", sort.field.name)
+		}
+		content.Values = append(content.Values, formatted)
+	}
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func (q *Query) decodeCursor(token string) ([]any, error) {
+	invalidErr := errors.Errorf("cursor '%s' is not valid", token)
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidErr
+	}
+
+	var content cursor
+	if err := json.Unmarshal(data, &content); err != nil || len(content.Values) != len(q.sorts) {
+		return nil, invalidErr
+	}
+	if content.Sorts != q.sortsSignature() {
+		return nil, errors.Errorf("cursor '%s' doesn't belong to the orderBy of the list query", token)
+	}
+
+	values := make([]any, 0, len(content.Values))
+	for i, sort := range q.sorts {
+		value, err := parseValue(content.Values[i], sort.field.valueType)
+		if err != nil {
+			return nil, invalidErr
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// sortsSignature is the sorts with the `orderBy` syntax, e.g. `-price,id`
+func (q *Query) sortsSignature() string {
+	items := make([]string, 0, len(q.sorts))
+	for _, sort := range q.sorts {
+		if sort.descending {
+			items = append(items, "-"+sort.field.name)
+		} else {
+			items = append(items, sort.field.name)
+		}
+	}
+
+	return strings.Join(items, ",")
+}
+
+// formatValue formats the value of the field with the formats of `parseValue`, the times keep the nanoseconds, so the
+// cursor doesn't skip the items of the same second
+func formatValue(value reflect.Value) (string, error) {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return "", errors.New("nil values can't be compared")
+		}
+		value = value.Elem()
+	}
+
+	switch value.Type() {
+	case timeType:
+		return value.Interface().(time.Time).Format(time.RFC3339Nano), nil
+	case uuidType:
+		return value.Interface().(uuid.UUID).String(), nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, value.Type().Bits()), nil
+	default:
+		return "", errors.Errorf("type '%s' is not supported in the cursor", value.Type())
+	}
+}
@@ -0,0 +1,191 @@
+//go:build unit
+// +build unit
+
+package listquery
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cursorTestProduct struct {
+	Id       int
+	Name     string
+	Price    float64
+	Discount *float64
+}
+
+var cursorTestProductSchema = NewSchema(
+	NewKeyField("id", specification.MustField[cursorTestProduct, int]("Id")),
+	NewField("name", specification.MustField[cursorTestProduct, string]("Name")),
+	NewField("price", specification.MustField[cursorTestProduct, float64]("Price")),
+	NewField("discount", specification.MustField[cursorTestProduct, float64]("Discount")),
+)
+
+var (
+	cursorTestId    = specification.FieldName{Column: "id", Document: "id"}
+	cursorTestPrice = specification.FieldName{Column: "price", Document: "price"}
+)
+
+func Test_Parse_Should_Add_Key_As_Last_Sort(t *testing.T) {
+	query, err := cursorTestProductSchema.Parse(&utils.ListQuery{OrderBy: "-price"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Sort{{Field: cursorTestPrice, Descending: true}, {Field: cursorTestId}}, query.Sorts)
+	assert.Nil(t, query.Keyset)
+
+	query, err = cursorTestProductSchema.Parse(utils.NewListQuery(10, 1))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Sort{{Field: cursorTestId}}, query.Sorts)
+}
+
+func Test_Page_Should_Return_Cursors_Of_Next_Page(t *testing.T) {
+	listQuery := &utils.ListQuery{Size: 2, Page: 1, OrderBy: "-price"}
+	query, err := cursorTestProductSchema.Parse(listQuery)
+	require.NoError(t, err)
+	require.Equal(t, 3, query.Limit(listQuery))
+	require.Equal(t, 0, query.Offset(listQuery))
+
+	items, cursors, err := Page(query, listQuery, []*cursorTestProduct{
+		{Id: 3, Price: 30},
+		{Id: 1, Price: 20},
+		{Id: 2, Price: 20},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*cursorTestProduct{{Id: 3, Price: 30}, {Id: 1, Price: 20}}, items)
+	assert.Empty(t, cursors.Previous)
+	require.NotEmpty(t, cursors.Next)
+
+	listQuery.After = cursors.Next
+	query, err = cursorTestProductSchema.Parse(listQuery)
+	require.NoError(t, err)
+
+	require.NotNil(t, query.Keyset)
+	assert.False(t, query.Keyset.Backward)
+	assert.Equal(t, 0, query.Offset(&utils.ListQuery{Size: 2, Page: 3, After: cursors.Next}))
+	assert.Equal(t, []Sort{{Field: cursorTestPrice, Descending: true}, {Field: cursorTestId}}, query.Sorts)
+	assert.Equal(
+		t,
+		specification.Or(
+			specification.FieldSpecification{Field: cursorTestPrice, Operator: specification.OperatorLessThan, Value: 20.0},
+			specification.And(
+				specification.FieldSpecification{Field: cursorTestPrice, Operator: specification.OperatorEqual, Value: 20.0},
+				specification.FieldSpecification{Field: cursorTestId, Operator: specification.OperatorGreaterThan, Value: 1},
+			),
+		),
+		query.Keyset.Specification,
+	)
+
+	items, cursors, err = Page(query, listQuery, []*cursorTestProduct{{Id: 2, Price: 20}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*cursorTestProduct{{Id: 2, Price: 20}}, items)
+	assert.NotEmpty(t, cursors.Previous)
+	assert.Empty(t, cursors.Next)
+}
+
+func Test_Page_Should_Reverse_Items_Of_Before_Cursor(t *testing.T) {
+	listQuery := &utils.ListQuery{Size: 1, OrderBy: "-price"}
+	query, err := cursorTestProductSchema.Parse(listQuery)
+	require.NoError(t, err)
+
+	_, cursors, err := Page(query, listQuery, []*cursorTestProduct{{Id: 1, Price: 20}})
+	require.NoError(t, err)
+	require.Empty(t, cursors.Next)
+
+	listQuery.Before, err = query.encodeCursor(&cursorTestProduct{Id: 2, Price: 20})
+	require.NoError(t, err)
+
+	query, err = cursorTestProductSchema.Parse(listQuery)
+	require.NoError(t, err)
+
+	require.NotNil(t, query.Keyset)
+	assert.True(t, query.Keyset.Backward)
+	assert.Equal(t, []Sort{{Field: cursorTestPrice}, {Field: cursorTestId, Descending: true}}, query.Sorts)
+	assert.Equal(
+		t,
+		specification.Or(
+			specification.FieldSpecification{Field: cursorTestPrice, Operator: specification.OperatorGreaterThan, Value: 20.0},
+			specification.And(
+				specification.FieldSpecification{Field: cursorTestPrice, Operator: specification.OperatorEqual, Value: 20.0},
+				specification.FieldSpecification{Field: cursorTestId, Operator: specification.OperatorLessThan, Value: 2},
+			),
+		),
+		query.Keyset.Specification,
+	)
+
+	items, cursors, err := Page(query, listQuery, []*cursorTestProduct{{Id: 1, Price: 20}, {Id: 3, Price: 30}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*cursorTestProduct{{Id: 1, Price: 20}}, items)
+	assert.NotEmpty(t, cursors.Previous)
+	assert.NotEmpty(t, cursors.Next)
+}
+
+func Test_Parse_Should_Return_Error_For_Invalid_Cursor(t *testing.T) {
+	query, err := cursorTestProductSchema.Parse(&utils.ListQuery{Size: 1, OrderBy: "name"})
+	require.NoError(t, err)
+	nameCursor, err := query.encodeCursor(cursorTestProduct{Id: 1, Name: "pizza"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		listQuery *utils.ListQuery
+		expected  string
+	}{
+		{
+			name:      "not encoded cursor",
+			listQuery: &utils.ListQuery{After: "not a cursor"},
+			expected:  "cursor 'not a cursor' is not valid",
+		},
+		{
+			name:      "cursor of other orderBy",
+			listQuery: &utils.ListQuery{After: nameCursor, OrderBy: "-price"},
+			expected:  "doesn't belong to the orderBy of the list query",
+		},
+		{
+			name:      "both cursors",
+			listQuery: &utils.ListQuery{After: nameCursor, Before: nameCursor, OrderBy: "name"},
+			expected:  "list query can't have both the after and the before cursors",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := cursorTestProductSchema.Validate(testCase.listQuery)
+
+			assert.ErrorContains(t, err, testCase.expected)
+		})
+	}
+
+	err = schemaTestProductSchema.Validate(&utils.ListQuery{After: nameCursor})
+	assert.ErrorContains(t, err, "cursors are not supported by the list query")
+}
+
+func Test_Page_Should_Not_Return_Cursors_For_Nullable_Sort(t *testing.T) {
+	listQuery := &utils.ListQuery{Size: 1, Page: 1, OrderBy: "discount"}
+	query, err := cursorTestProductSchema.Parse(listQuery)
+	require.NoError(t, err)
+
+	items, cursors, err := Page(query, listQuery, []cursorTestProduct{{Id: 1}})
+	require.NoError(t, err)
+
+	assert.Len(t, items, 1)
+	assert.Empty(t, cursors)
+
+	_, err = cursorTestProductSchema.Parse(&utils.ListQuery{Size: 1, OrderBy: "discount", After: "token"})
+	assert.ErrorContains(t, err, "cursors are not supported by the list query")
+}
+
+func Test_New_Key_Field_Should_Panic_For_Nullable_Field(t *testing.T) {
+	assert.Panics(t, func() {
+		NewKeyField("discount", specification.MustField[cursorTestProduct, float64]("Discount"))
+	})
+}
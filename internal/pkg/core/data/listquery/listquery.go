@@ -0,0 +1,47 @@
+package listquery
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+)
+
+// Comparison is the comparison of a list query filter
+type Comparison string
+
+const (
+	ComparisonEqual       Comparison = "eq"
+	ComparisonNotEqual    Comparison = "neq"
+	ComparisonGreaterThan Comparison = "gt"
+	ComparisonLessThan    Comparison = "lt"
+	// ComparisonIn matches one of the comma separated values, e.g. `status:in:Paid,Shipped`
+	ComparisonIn Comparison = "in"
+	// ComparisonContains matches the text fields which contain the value (case-insensitive) and the array fields which
+	// contain the element
+	ComparisonContains Comparison = "contains"
+	// ComparisonBetween matches the values between the comma separated bounds, both bounds are inclusive,
+	// e.g. `price:between:10,20`
+	ComparisonBetween Comparison = "between"
+)
+
+// Sort is a sort of the list query on a field
+type Sort struct {
+	Field      specification.FieldName
+	Descending bool
+}
+
+// Query is a list query which is validated against the schema of a resource, the filters are combined into one
+// specification, and the sorts keep the order of the `orderBy`
+type Query struct {
+	Specification specification.Specification
+	Sorts         []Sort
+	// Keyset is the keyset of the `after` or the `before` cursor, it is nil for the offset pagination
+	Keyset *Keyset
+
+	sorts []fieldSort
+}
+
+// Keyset selects the items after the cursor in the order of the sorts of the query, the sorts of the query are reversed
+// for the `before` cursor, so the items of the page are found in the reverse order and `Page` restores the order
+type Keyset struct {
+	Specification specification.Specification
+	Backward      bool
+}
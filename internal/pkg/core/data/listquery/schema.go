@@ -0,0 +1,353 @@
+package listquery
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+
+	"emperror.dev/errors"
+)
+
+// Field is a field of a resource which is allowed in the filters and the sorts of the list queries
+type Field struct {
+	name        string
+	fieldName   specification.FieldName
+	structField string
+	valueType   reflect.Type
+	array       bool
+	nullable    bool
+	key         bool
+}
+
+// NewField allows the data model field with the public name, e.g. `listquery.NewField("price", productFields.Price)`
+func NewField[T any](name string, field specification.Field[T]) Field {
+	return Field{
+		name:        name,
+		fieldName:   field.Name(),
+		structField: field.StructField(),
+		valueType:   indirectType(reflect.TypeOf((*T)(nil)).Elem()),
+		nullable:    field.Nullable(),
+	}
+}
+
+// NewKeyField allows the unique key field of the data model (e.g. the id), the key is the last sort of the list queries,
+// so the items with the same values of the `orderBy` fields have a stable order and the cursors point to one item, it
+// panics for a nullable field because the null keys have no position in the keyset
+func NewKeyField[T any](name string, field specification.Field[T]) Field {
+	if field.Nullable() {
+		panic(errors.Errorf("key field '%s' can't be nullable", name))
+	}
+
+	keyField := NewField(name, field)
+	keyField.key = true
+
+	return keyField
+}
+
+// NewArrayField allows the data model array field with the public name, the array fields just support the `contains`
+// comparison and can't be sorted
+func NewArrayField[E any](name string, field specification.ArrayField[E]) Field {
+	return Field{
+		name:        name,
+		fieldName:   field.Name(),
+		structField: field.StructField(),
+		valueType:   indirectType(reflect.TypeOf((*E)(nil)).Elem()),
+		array:       true,
+	}
+}
+
+func (f Field) Name() string {
+	return f.name
+}
+
+// Schema is the allow-list of the fields which the list queries of a resource can filter and sort by, the field names are
+// case-insensitive
+type Schema struct {
+	fields map[string]Field
+	key    *Field
+}
+
+func NewSchema(fields ...Field) *Schema {
+	schema := &Schema{fields: make(map[string]Field, len(fields))}
+	for _, field := range fields {
+		schema.fields[strings.ToLower(field.name)] = field
+		if field.key {
+			keyField := field
+			schema.key = &keyField
+		}
+	}
+
+	return schema
+}
+
+// Parse validates the filters, the `orderBy` and the cursors of the list query against the schema, and converts them to
+// the specification, the sorts and the keyset of the fields, a nil schema doesn't allow any field
+func (s *Schema) Parse(listQuery *utils.ListQuery) (*Query, error) {
+	query := &Query{Specification: specification.And()}
+	if listQuery == nil {
+		return query, nil
+	}
+
+	specifications := make([]specification.Specification, 0, len(listQuery.Filters))
+	for _, filter := range listQuery.Filters {
+		if filter == nil {
+			continue
+		}
+
+		spec, err := s.filterSpecification(filter)
+		if err != nil {
+			return nil, err
+		}
+		specifications = append(specifications, spec)
+	}
+	query.Specification = specification.And(specifications...)
+
+	sorts, err := s.sorts(listQuery.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	query.sorts = sorts
+	query.Sorts = toSorts(sorts, false)
+
+	if err := query.parseCursor(listQuery); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+// ParseWithoutSchema parses the list query of a list without a schema, its filters, `orderBy` and cursors are rejected
+// because no field is allowed for them
+func ParseWithoutSchema(listQuery *utils.ListQuery) (*Query, error) {
+	var schema *Schema
+
+	query, err := schema.Parse(listQuery)
+	if err != nil {
+		return nil, errors.WrapIf(err, "the filters and the sorts of the list query need a schema")
+	}
+
+	return query, nil
+}
+
+// Validate checks the filters and the `orderBy` of the list query against the schema
+func (s *Schema) Validate(listQuery *utils.ListQuery) error {
+	_, err := s.Parse(listQuery)
+
+	return err
+}
+
+func (s *Schema) field(name string) (Field, error) {
+	if s != nil {
+		if field, ok := s.fields[strings.ToLower(strings.TrimSpace(name))]; ok {
+			return field, nil
+		}
+	}
+
+	return Field{}, errors.Errorf("field '%s' is not allowed in the list query", name)
+}
+
+func (s *Schema) filterSpecification(filter *utils.FilterModel) (specification.Specification, error) {
+	field, err := s.field(filter.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := Comparison(strings.ToLower(strings.TrimSpace(filter.Comparison)))
+	if !field.supports(comparison) {
+		return nil, errors.Errorf(
+			"comparison '%s' is not supported by field '%s'",
+			filter.Comparison,
+			field.name,
+		)
+	}
+
+	switch comparison {
+	case ComparisonEqual, ComparisonNotEqual, ComparisonGreaterThan, ComparisonLessThan:
+		value, err := field.parse(filter.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return field.specification(comparisonOperators[comparison], value), nil
+	case ComparisonIn:
+		values, err := field.parseList(filter.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return field.specification(specification.OperatorIn, values), nil
+	case ComparisonBetween:
+		values, err := field.parseList(filter.Value)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != 2 {
+			return nil, errors.Errorf(
+				"between comparison of field '%s' needs two comma separated values",
+				field.name,
+			)
+		}
+
+		return field.specification(
+			specification.OperatorBetween,
+			specification.Range{From: values[0], To: values[1]},
+		), nil
+	default:
+		if field.array {
+			value, err := field.parse(filter.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			return field.specification(specification.OperatorContains, value), nil
+		}
+
+		return field.specification(
+			specification.OperatorILike,
+			"%"+specification.EscapeLike(filter.Value)+"%",
+		), nil
+	}
+}
+
+// sorts parses the comma separated sorts of the `orderBy`, a sort is a field with an optional `asc` or `desc` direction
+// (e.g. `price desc`) or a field with the `-` prefix for the descending sort (e.g. `-price,name`), the key field of the
+// schema is added as the last sort
+func (s *Schema) sorts(orderBy string) ([]fieldSort, error) {
+	var sorts []fieldSort
+
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 {
+			continue
+		}
+		if len(parts) > 2 {
+			return nil, errors.Errorf("sort '%s' is not valid", strings.TrimSpace(item))
+		}
+
+		name := parts[0]
+		descending := false
+		switch {
+		case strings.HasPrefix(name, "-"):
+			name, descending = name[1:], true
+		case strings.HasPrefix(name, "+"):
+			name = name[1:]
+		}
+
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				descending = !descending
+			default:
+				return nil, errors.Errorf("sort direction '%s' is not valid", parts[1])
+			}
+		}
+
+		field, err := s.field(name)
+		if err != nil {
+			return nil, err
+		}
+		if field.array {
+			return nil, errors.Errorf("array field '%s' can't be sorted", field.name)
+		}
+
+		sorts = append(sorts, fieldSort{field: field, descending: descending})
+	}
+
+	if s != nil && s.key != nil && !containsKey(sorts) {
+		sorts = append(sorts, fieldSort{field: *s.key})
+	}
+
+	return sorts, nil
+}
+
+type fieldSort struct {
+	field      Field
+	descending bool
+}
+
+func containsKey(sorts []fieldSort) bool {
+	for _, sort := range sorts {
+		if sort.field.key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toSorts converts the field sorts to the sorts of the query, the directions are reversed for the `before` cursor
+func toSorts(sorts []fieldSort, reverse bool) []Sort {
+	if len(sorts) == 0 {
+		return nil
+	}
+
+	items := make([]Sort, 0, len(sorts))
+	for _, sort := range sorts {
+		items = append(items, Sort{Field: sort.field.fieldName, Descending: sort.descending != reverse})
+	}
+
+	return items
+}
+
+var comparisonOperators = map[Comparison]specification.Operator{
+	ComparisonEqual:       specification.OperatorEqual,
+	ComparisonNotEqual:    specification.OperatorNotEqual,
+	ComparisonGreaterThan: specification.OperatorGreaterThan,
+	ComparisonLessThan:    specification.OperatorLessThan,
+}
+
+func (f Field) supports(comparison Comparison) bool {
+	if f.array {
+		return comparison == ComparisonContains
+	}
+
+	switch comparison {
+	case ComparisonEqual, ComparisonNotEqual, ComparisonIn:
+		return true
+	case ComparisonGreaterThan, ComparisonLessThan, ComparisonBetween:
+		return f.valueType.Kind() != reflect.Bool
+	case ComparisonContains:
+		return f.valueType.Kind() == reflect.String
+	default:
+		return false
+	}
+}
+
+func (f Field) specification(operator specification.Operator, value any) specification.Specification {
+	return specification.FieldSpecification{Field: f.fieldName, Operator: operator, Value: value}
+}
+
+func (f Field) parse(value string) (any, error) {
+	parsed, err := parseValue(value, f.valueType)
+	if err != nil {
+		return nil, errors.WrapIff(err, "value '%s' of field '%s' is not valid", value, f.name)
+	}
+
+	return parsed, nil
+}
+
+func (f Field) parseList(value string) ([]any, error) {
+	items := strings.Split(value, ",")
+	values := make([]any, 0, len(items))
+
+	for _, item := range items {
+		parsed, err := f.parse(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, parsed)
+	}
+
+	return values, nil
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
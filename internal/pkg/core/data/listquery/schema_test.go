@@ -0,0 +1,228 @@
+//go:build unit
+// +build unit
+
+package listquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaTestStatus string
+
+type schemaTestProduct struct {
+	Name      string `bson:"name"`
+	Price     float64
+	Quantity  int
+	Available bool
+	Status    schemaTestStatus
+	Tags      []string
+	CreatedAt time.Time
+}
+
+var schemaTestProductSchema = NewSchema(
+	NewField("name", specification.MustField[schemaTestProduct, string]("Name")),
+	NewField("price", specification.MustField[schemaTestProduct, float64]("Price")),
+	NewField("quantity", specification.MustField[schemaTestProduct, int]("Quantity")),
+	NewField("available", specification.MustField[schemaTestProduct, bool]("Available")),
+	NewField("status", specification.MustField[schemaTestProduct, schemaTestStatus]("Status")),
+	NewArrayField("tags", specification.MustArrayField[schemaTestProduct, string]("Tags")),
+	NewField("createdAt", specification.MustField[schemaTestProduct, time.Time]("CreatedAt")),
+)
+
+func Test_Parse_Should_Convert_Filters_To_Specification(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	query, err := schemaTestProductSchema.Parse(&utils.ListQuery{
+		Filters: []*utils.FilterModel{
+			{Field: "name", Comparison: "eq", Value: "pizza"},
+			{Field: "price", Comparison: "gt", Value: "10.5"},
+			{Field: "quantity", Comparison: "lt", Value: "3"},
+			{Field: "available", Comparison: "neq", Value: "true"},
+			{Field: "status", Comparison: "in", Value: "paid,shipped"},
+			{Field: "Price", Comparison: "BETWEEN", Value: "1, 2"},
+			{Field: "tags", Comparison: "contains", Value: "new"},
+			{Field: "name", Comparison: "contains", Value: "50%_off"},
+			{Field: "createdAt", Comparison: "gt", Value: "2024-01-02T03:04:05Z"},
+		},
+	})
+	require.NoError(t, err)
+
+	name := specification.FieldName{Column: "name", Document: "name"}
+	price := specification.FieldName{Column: "price", Document: "price"}
+	assert.Equal(
+		t,
+		specification.And(
+			specification.FieldSpecification{Field: name, Operator: specification.OperatorEqual, Value: "pizza"},
+			specification.FieldSpecification{Field: price, Operator: specification.OperatorGreaterThan, Value: 10.5},
+			specification.FieldSpecification{
+				Field:    specification.FieldName{Column: "quantity", Document: "quantity"},
+				Operator: specification.OperatorLessThan,
+				Value:    3,
+			},
+			specification.FieldSpecification{
+				Field:    specification.FieldName{Column: "available", Document: "available"},
+				Operator: specification.OperatorNotEqual,
+				Value:    true,
+			},
+			specification.FieldSpecification{
+				Field:    specification.FieldName{Column: "status", Document: "status"},
+				Operator: specification.OperatorIn,
+				Value:    []any{schemaTestStatus("paid"), schemaTestStatus("shipped")},
+			},
+			specification.FieldSpecification{
+				Field:    price,
+				Operator: specification.OperatorBetween,
+				Value:    specification.Range{From: 1.0, To: 2.0},
+			},
+			specification.FieldSpecification{
+				Field:    specification.FieldName{Column: "tags", Document: "tags"},
+				Operator: specification.OperatorContains,
+				Value:    "new",
+			},
+			specification.FieldSpecification{Field: name, Operator: specification.OperatorILike, Value: `%50\%\_off%`},
+			specification.FieldSpecification{
+				Field:    specification.FieldName{Column: "created_at", Document: "createdat"},
+				Operator: specification.OperatorGreaterThan,
+				Value:    createdAt,
+			},
+		),
+		query.Specification,
+	)
+}
+
+func Test_Parse_Should_Convert_Order_By_To_Sorts(t *testing.T) {
+	query, err := schemaTestProductSchema.Parse(&utils.ListQuery{OrderBy: "-price, name asc,quantity DESC,+status"})
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]Sort{
+			{Field: specification.FieldName{Column: "price", Document: "price"}, Descending: true},
+			{Field: specification.FieldName{Column: "name", Document: "name"}},
+			{Field: specification.FieldName{Column: "quantity", Document: "quantity"}, Descending: true},
+			{Field: specification.FieldName{Column: "status", Document: "status"}},
+		},
+		query.Sorts,
+	)
+}
+
+func Test_Parse_Should_Match_All_Without_Filters_And_Sorts(t *testing.T) {
+	query, err := schemaTestProductSchema.Parse(utils.NewListQuery(10, 1))
+	require.NoError(t, err)
+
+	require.IsType(t, specification.AndSpecification{}, query.Specification)
+	assert.Empty(t, query.Specification.(specification.AndSpecification).Specifications)
+	assert.Empty(t, query.Sorts)
+}
+
+func Test_Parse_Should_Return_Error_For_Invalid_List_Query(t *testing.T) {
+	testCases := []struct {
+		name      string
+		listQuery *utils.ListQuery
+		expected  string
+	}{
+		{
+			name:      "field is not allowed",
+			listQuery: &utils.ListQuery{Filters: []*utils.FilterModel{{Field: "cost", Comparison: "eq", Value: "1"}}},
+			expected:  "field 'cost' is not allowed in the list query",
+		},
+		{
+			name:      "comparison is not supported by bool",
+			listQuery: &utils.ListQuery{Filters: []*utils.FilterModel{{Field: "available", Comparison: "gt", Value: "1"}}},
+			expected:  "comparison 'gt' is not supported by field 'available'",
+		},
+		{
+			name:      "comparison is not supported by number",
+			listQuery: &utils.ListQuery{Filters: []*utils.FilterModel{{Field: "price", Comparison: "contains", Value: "1"}}},
+			expected:  "comparison 'contains' is not supported by field 'price'",
+		},
+		{
+			name:      "comparison is not supported by array",
+			listQuery: &utils.ListQuery{Filters: []*utils.FilterModel{{Field: "tags", Comparison: "eq", Value: "new"}}},
+			expected:  "comparison 'eq' is not supported by field 'tags'",
+		},
+		{
+			name:      "unknown comparison",
+			listQuery: &utils.ListQuery{Filters: []*utils.FilterModel{{Field: "name", Comparison: "like", Value: "a"}}},
+			expected:  "comparison 'like' is not supported by field 'name'",
+		},
+		{
+			name:      "invalid value",
+			listQuery: &utils.ListQuery{Filters: []*utils.FilterModel{{Field: "price", Comparison: "eq", Value: "cheap"}}},
+			expected:  "value 'cheap' of field 'price' is not valid",
+		},
+		{
+			name: "between without two values",
+			listQuery: &utils.ListQuery{
+				Filters: []*utils.FilterModel{{Field: "price", Comparison: "between", Value: "1"}},
+			},
+			expected: "between comparison of field 'price' needs two comma separated values",
+		},
+		{
+			name:      "sort field is not allowed",
+			listQuery: &utils.ListQuery{OrderBy: "cost desc"},
+			expected:  "field 'cost' is not allowed in the list query",
+		},
+		{
+			name:      "invalid sort direction",
+			listQuery: &utils.ListQuery{OrderBy: "price down"},
+			expected:  "sort direction 'down' is not valid",
+		},
+		{
+			name:      "array sort",
+			listQuery: &utils.ListQuery{OrderBy: "tags"},
+			expected:  "array field 'tags' can't be sorted",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := schemaTestProductSchema.Validate(testCase.listQuery)
+
+			assert.ErrorContains(t, err, testCase.expected)
+		})
+	}
+}
+
+func Test_Nil_Schema_Should_Not_Allow_Any_Field(t *testing.T) {
+	var schema *Schema
+
+	err := schema.Validate(&utils.ListQuery{OrderBy: "name"})
+	assert.ErrorContains(t, err, "field 'name' is not allowed in the list query")
+
+	assert.NoError(t, schema.Validate(utils.NewListQuery(10, 1)))
+}
+
+func Test_Parse_Without_Schema_Should_Reject_Filters_And_Sorts(t *testing.T) {
+	_, err := ParseWithoutSchema(&utils.ListQuery{
+		Filters: []*utils.FilterModel{{Field: "name", Comparison: "equals", Value: "a"}},
+	})
+	assert.ErrorContains(t, err, "field 'name' is not allowed in the list query")
+
+	_, err = ParseWithoutSchema(&utils.ListQuery{OrderBy: "price desc"})
+	assert.ErrorContains(t, err, "field 'price' is not allowed in the list query")
+
+	query, err := ParseWithoutSchema(utils.NewListQuery(10, 1))
+	require.NoError(t, err)
+	assert.Empty(t, query.Sorts)
+}
+
+func Test_Parse_Filter_Should_Split_Field_Comparison_And_Value(t *testing.T) {
+	filter, err := utils.ParseFilter("createdAt:gt:2024-01-02T03:04:05Z")
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		&utils.FilterModel{Field: "createdAt", Comparison: "gt", Value: "2024-01-02T03:04:05Z"},
+		filter,
+	)
+
+	_, err = utils.ParseFilter("price:10")
+	assert.ErrorContains(t, err, "filter 'price:10' should have the 'field:comparison:value' format")
+}
@@ -0,0 +1,65 @@
+package listquery
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf(uuid.UUID{})
+)
+
+// parseValue converts the query string value to the type of the field, so the databases compare the values with the same
+// type as the stored values (e.g. mongo doesn't match the number fields with the string values), the times use the
+// RFC3339 format
+func parseValue(value string, valueType reflect.Type) (any, error) {
+	value = strings.TrimSpace(value)
+
+	switch valueType {
+	case timeType:
+		return time.Parse(time.RFC3339, value)
+	case uuidType:
+		return uuid.FromString(value)
+	}
+
+	target := reflect.New(valueType).Elem()
+
+	switch valueType.Kind() {
+	case reflect.String:
+		target.SetString(value)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		target.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, valueType.Bits())
+		if err != nil {
+			return nil, err
+		}
+		target.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, valueType.Bits())
+		if err != nil {
+			return nil, err
+		}
+		target.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, valueType.Bits())
+		if err != nil {
+			return nil, err
+		}
+		target.SetFloat(parsed)
+	default:
+		return nil, errors.Errorf("type '%s' is not supported in the list query", valueType)
+	}
+
+	return target.Interface(), nil
+}
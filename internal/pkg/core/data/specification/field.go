@@ -0,0 +1,253 @@
+package specification
+
+import (
+	"reflect"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/iancoleman/strcase"
+)
+
+// FieldName is the name of a field in the databases, the path is the nested path inside a jsonb column or a sub document
+type FieldName struct {
+	// Column is the column name for gorm
+	Column string
+	// Document is the document field name for mongo
+	Document string
+	Path     []string
+}
+
+// NewFieldName creates a field name which is the same for all the databases
+func NewFieldName(name string, path ...string) FieldName {
+	return FieldName{Column: name, Document: name, Path: path}
+}
+
+func (n FieldName) String() string {
+	return strings.Join(append([]string{n.Column}, n.Path...), ".")
+}
+
+// Field is a field of a data model with the value type `T`, the specifications of a field are checked by the compiler,
+// e.g. `productFields.Price.Between(10, 20)`
+type Field[T any] struct {
+	name        FieldName
+	structField string
+	nullable    bool
+}
+
+// ArrayField is an array field of a data model with the element type `E`
+type ArrayField[E any] struct {
+	Field[[]E]
+}
+
+// NewField creates the descriptor of the data model field with the given struct field name, it returns an error when the
+// data model doesn't have the field or the field type is not `T`
+func NewField[TDataModel any, T any](name string) (Field[T], error) {
+	fieldName, structField, err := resolveFieldName[TDataModel](name, reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return Field[T]{}, err
+	}
+
+	return Field[T]{name: fieldName, structField: name, nullable: isNullable(structField.Type)}, nil
+}
+
+// MustField is like `NewField` but panics, so the fields which are declared as package variables fail at startup
+func MustField[TDataModel any, T any](name string) Field[T] {
+	field, err := NewField[TDataModel, T](name)
+	if err != nil {
+		panic(err)
+	}
+
+	return field
+}
+
+// NewArrayField creates the descriptor of the data model array field with the element type `E`
+func NewArrayField[TDataModel any, E any](name string) (ArrayField[E], error) {
+	field, err := NewField[TDataModel, []E](name)
+	if err != nil {
+		return ArrayField[E]{}, err
+	}
+
+	return ArrayField[E]{Field: field}, nil
+}
+
+func MustArrayField[TDataModel any, E any](name string) ArrayField[E] {
+	field, err := NewArrayField[TDataModel, E](name)
+	if err != nil {
+		panic(err)
+	}
+
+	return field
+}
+
+// NewJsonField creates the descriptor of a value inside a jsonb column or a sub document, just the struct field is checked
+// against the data model, because the nested path is not part of the struct
+func NewJsonField[TDataModel any, T any](name string, path ...string) (Field[T], error) {
+	if len(path) == 0 {
+		return Field[T]{}, errors.Errorf("json field '%s' needs a path", name)
+	}
+
+	fieldName, _, err := resolveFieldName[TDataModel](name, nil)
+	if err != nil {
+		return Field[T]{}, err
+	}
+	fieldName.Path = path
+
+	return Field[T]{name: fieldName, structField: name, nullable: true}, nil
+}
+
+func MustJsonField[TDataModel any, T any](name string, path ...string) Field[T] {
+	field, err := NewJsonField[TDataModel, T](name, path...)
+	if err != nil {
+		panic(err)
+	}
+
+	return field
+}
+
+func (f Field[T]) Name() FieldName {
+	return f.name
+}
+
+// StructField is the name of the data model struct field
+func (f Field[T]) StructField() string {
+	return f.structField
+}
+
+// Nullable reports whether the field can be null, e.g. a pointer struct field or a value inside a jsonb column
+func (f Field[T]) Nullable() bool {
+	return f.nullable
+}
+
+func (f Field[T]) Equal(value T) Specification {
+	return f.specification(OperatorEqual, value)
+}
+
+func (f Field[T]) NotEqual(value T) Specification {
+	return f.specification(OperatorNotEqual, value)
+}
+
+func (f Field[T]) GreaterThan(value T) Specification {
+	return f.specification(OperatorGreaterThan, value)
+}
+
+func (f Field[T]) GreaterOrEqual(value T) Specification {
+	return f.specification(OperatorGreaterOrEqual, value)
+}
+
+func (f Field[T]) LessThan(value T) Specification {
+	return f.specification(OperatorLessThan, value)
+}
+
+func (f Field[T]) LessOrEqual(value T) Specification {
+	return f.specification(OperatorLessOrEqual, value)
+}
+
+func (f Field[T]) In(values ...T) Specification {
+	return f.specification(OperatorIn, toAnySlice(values))
+}
+
+func (f Field[T]) NotIn(values ...T) Specification {
+	return f.specification(OperatorNotIn, toAnySlice(values))
+}
+
+func (f Field[T]) Between(from T, to T) Specification {
+	return f.specification(OperatorBetween, Range{From: from, To: to})
+}
+
+func (f Field[T]) Like(pattern string) Specification {
+	return f.specification(OperatorLike, pattern)
+}
+
+func (f Field[T]) ILike(pattern string) Specification {
+	return f.specification(OperatorILike, pattern)
+}
+
+func (f Field[T]) IsNull() Specification {
+	return f.specification(OperatorIsNull, nil)
+}
+
+func (f Field[T]) IsNotNull() Specification {
+	return f.specification(OperatorIsNotNull, nil)
+}
+
+func (f ArrayField[E]) Contains(value E) Specification {
+	return f.specification(OperatorContains, value)
+}
+
+func (f Field[T]) specification(operator Operator, value any) Specification {
+	return FieldSpecification{Field: f.name, Operator: operator, Value: value}
+}
+
+// resolveFieldName finds the struct field and its column and document names from the `gorm` and `bson` tags, the
+// defaults are the same as gorm and the mongo driver (snake case and lower case), the field type is not checked when the
+// value type is nil
+func resolveFieldName[TDataModel any](
+	name string,
+	valueType reflect.Type,
+) (FieldName, reflect.StructField, error) {
+	dataModelType := indirectType(reflect.TypeOf((*TDataModel)(nil)).Elem())
+	if dataModelType.Kind() != reflect.Struct {
+		return FieldName{}, reflect.StructField{}, errors.Errorf("data model '%s' is not a struct", dataModelType)
+	}
+
+	structField, ok := dataModelType.FieldByName(name)
+	if !ok {
+		return FieldName{}, reflect.StructField{}, errors.Errorf(
+			"data model '%s' doesn't have the field '%s'",
+			dataModelType,
+			name,
+		)
+	}
+
+	if valueType != nil && !indirectType(valueType).AssignableTo(indirectType(structField.Type)) {
+		return FieldName{}, reflect.StructField{}, errors.Errorf(
+			"field '%s' of data model '%s' has the type '%s', not '%s'",
+			name,
+			dataModelType,
+			structField.Type,
+			valueType,
+		)
+	}
+
+	return FieldName{
+		Column:   columnName(structField),
+		Document: documentName(structField),
+	}, structField, nil
+}
+
+func columnName(structField reflect.StructField) string {
+	for _, setting := range strings.Split(structField.Tag.Get("gorm"), ";") {
+		key, value, found := strings.Cut(setting, ":")
+		if found && strings.EqualFold(strings.TrimSpace(key), "column") {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return strcase.ToSnake(structField.Name)
+}
+
+func documentName(structField reflect.StructField) string {
+	name, _, _ := strings.Cut(structField.Tag.Get("bson"), ",")
+	if name != "" && name != "-" {
+		return name
+	}
+
+	return strings.ToLower(structField.Name)
+}
+
+func isNullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
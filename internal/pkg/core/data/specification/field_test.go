@@ -0,0 +1,95 @@
+//go:build unit
+// +build unit
+
+package specification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fieldTestProduct struct {
+	Id          string `gorm:"primaryKey;column:product_id" bson:"_id,omitempty"`
+	Name        string `bson:"name"`
+	UnitPrice   float64
+	Tags        []string `gorm:"type:text[]"`
+	Attributes  map[string]interface{}
+	Description *string
+}
+
+func Test_New_Field_Should_Resolve_Database_Names_From_Data_Model(t *testing.T) {
+	id, err := NewField[*fieldTestProduct, string]("Id")
+	require.NoError(t, err)
+	assert.Equal(t, FieldName{Column: "product_id", Document: "_id"}, id.Name())
+
+	price, err := NewField[fieldTestProduct, float64]("UnitPrice")
+	require.NoError(t, err)
+	assert.Equal(t, FieldName{Column: "unit_price", Document: "unitprice"}, price.Name())
+
+	description, err := NewField[*fieldTestProduct, string]("Description")
+	require.NoError(t, err)
+	assert.Equal(t, FieldName{Column: "description", Document: "description"}, description.Name())
+}
+
+func Test_New_Field_Should_Return_Error_For_Misspelled_Field(t *testing.T) {
+	_, err := NewField[*fieldTestProduct, string]("Nmae")
+	assert.ErrorContains(t, err, "doesn't have the field 'Nmae'")
+
+	assert.Panics(t, func() {
+		MustField[*fieldTestProduct, string]("Nmae")
+	})
+}
+
+func Test_New_Field_Should_Return_Error_For_Wrong_Value_Type(t *testing.T) {
+	_, err := NewField[*fieldTestProduct, int]("Name")
+	assert.ErrorContains(t, err, "has the type 'string', not 'int'")
+
+	_, err = NewArrayField[*fieldTestProduct, int]("Tags")
+	assert.Error(t, err)
+}
+
+func Test_Typed_Field_Should_Create_Field_Specifications(t *testing.T) {
+	price := MustField[*fieldTestProduct, float64]("UnitPrice")
+	tags := MustArrayField[*fieldTestProduct, string]("Tags")
+	color := MustJsonField[*fieldTestProduct, string]("Attributes", "color")
+
+	spec := And(
+		price.Between(10, 20),
+		price.In(1, 2),
+		tags.Contains("new"),
+		color.Equal("red"),
+	)
+
+	assert.Equal(t, AndSpecification{Specifications: []Specification{
+		FieldSpecification{
+			Field:    FieldName{Column: "unit_price", Document: "unitprice"},
+			Operator: OperatorBetween,
+			Value:    Range{From: float64(10), To: float64(20)},
+		},
+		FieldSpecification{
+			Field:    FieldName{Column: "unit_price", Document: "unitprice"},
+			Operator: OperatorIn,
+			Value:    []any{float64(1), float64(2)},
+		},
+		FieldSpecification{
+			Field:    FieldName{Column: "tags", Document: "tags"},
+			Operator: OperatorContains,
+			Value:    "new",
+		},
+		FieldSpecification{
+			Field:    FieldName{Column: "attributes", Document: "attributes", Path: []string{"color"}},
+			Operator: OperatorEqual,
+			Value:    "red",
+		},
+	}}, spec)
+}
+
+func Test_New_Json_Field_Should_Need_A_Path(t *testing.T) {
+	_, err := NewJsonField[*fieldTestProduct, string]("Attributes")
+	assert.Error(t, err)
+
+	_, err = NewJsonField[*fieldTestProduct, string]("Atributes", "color")
+	assert.Error(t, err)
+}
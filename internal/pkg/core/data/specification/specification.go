@@ -1,132 +1,180 @@
 package specification
 
-import (
-	"fmt"
-	"strings"
-)
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
 
+// Specification is a criteria on the entities, it is kept as an expression tree, so each database compiles it to its own
+// query (e.g. a sql where clause for gorm and a bson filter for mongo)
 type Specification interface {
-	GetQuery() string
-	GetValues() []any
-}
+	specification()
+}
+
+// Operator is the comparison operator of a field specification
+type Operator string
+
+const (
+	OperatorEqual          Operator = "eq"
+	OperatorNotEqual       Operator = "neq"
+	OperatorGreaterThan    Operator = "gt"
+	OperatorGreaterOrEqual Operator = "gte"
+	OperatorLessThan       Operator = "lt"
+	OperatorLessOrEqual    Operator = "lte"
+	OperatorIn             Operator = "in"
+	OperatorNotIn          Operator = "nin"
+	OperatorLike           Operator = "like"
+	OperatorILike          Operator = "ilike"
+	OperatorBetween        Operator = "between"
+	OperatorIsNull         Operator = "null"
+	OperatorIsNotNull      Operator = "notnull"
+	// OperatorContains matches the array fields which contain the value
+	OperatorContains Operator = "contains"
+)
 
-type joinSpecification struct {
-	specifications []Specification
-	separator      string
+// Range is the value of the between specifications, both bounds are inclusive
+type Range struct {
+	From any
+	To   any
 }
 
-func (s joinSpecification) GetQuery() string {
-	queries := make([]string, 0, len(s.specifications))
-
-	for _, spec := range s.specifications {
-		queries = append(queries, spec.GetQuery())
-	}
-
-	return strings.Join(queries, fmt.Sprintf(" %s ", s.separator))
+// AndSpecification matches the entities which match all the specifications, it matches all the entities when there is no
+// specification
+type AndSpecification struct {
+	Specifications []Specification
 }
 
-func (s joinSpecification) GetValues() []any {
-	values := make([]any, 0)
+// OrSpecification matches the entities which match at least one of the specifications
+type OrSpecification struct {
+	Specifications []Specification
+}
 
-	for _, spec := range s.specifications {
-		values = append(values, spec.GetValues()...)
-	}
+// NotSpecification matches the entities which don't match the specification
+type NotSpecification struct {
+	Specification Specification
+}
 
-	return values
+// FieldSpecification compares the field with the value, the value is a `[]any` for the in operators, a `Range` for the
+// between operator, a like pattern (with `%` and `_` wildcards and the `\` escape character) for the like operators and an array element for the
+// contains operator. Like sql, the comparisons (including `NotEqual` and `NotIn`) never match the null or missing fields on
+// any database, they are matched by `IsNull`. The `Not` of a comparison matches the null fields on mongo but not on sql, so
+// it is combined with `IsNotNull` on the nullable fields for the same results
+type FieldSpecification struct {
+	Field    FieldName
+	Operator Operator
+	Value    any
 }
 
+func (AndSpecification) specification()   {}
+func (OrSpecification) specification()    {}
+func (NotSpecification) specification()   {}
+func (FieldSpecification) specification() {}
+
 func And(specifications ...Specification) Specification {
-	return joinSpecification{
-		specifications: specifications,
-		separator:      "AND",
-	}
+	return AndSpecification{Specifications: specifications}
 }
 
 func Or(specifications ...Specification) Specification {
-	return joinSpecification{
-		specifications: specifications,
-		separator:      "OR",
-	}
+	return OrSpecification{Specifications: specifications}
 }
 
-type notSpecification struct {
-	Specification
+func Not(specification Specification) Specification {
+	return NotSpecification{Specification: specification}
 }
 
-func (s notSpecification) GetQuery() string {
-	return fmt.Sprintf(" NOT (%s)", s.Specification.GetQuery())
-}
+// Normalize rewrites the field specifications which databases evaluate differently, an empty `In` matches no entity and
+// an empty `NotIn` matches all the entities (the sql `NOT IN (NULL)` matches nothing and the mongo `$nin: []` matches
+// everything), the other specifications are returned as they are
+func Normalize(spec FieldSpecification) Specification {
+	if spec.Operator != OperatorIn && spec.Operator != OperatorNotIn {
+		return spec
+	}
 
-func Not(specification Specification) Specification {
-	return notSpecification{
-		specification,
+	values, ok := spec.Value.([]any)
+	if !ok || len(values) > 0 {
+		return spec
 	}
-}
 
-type binaryOperatorSpecification[T any] struct {
-	field    string
-	operator string
-	value    T
-}
+	if spec.Operator == OperatorIn {
+		return Not(And())
+	}
 
-func (s binaryOperatorSpecification[T]) GetQuery() string {
-	return fmt.Sprintf("%s %s ?", s.field, s.operator)
+	return And()
 }
 
-func (s binaryOperatorSpecification[T]) GetValues() []any {
-	return []any{s.value}
-}
+// The untyped constructors use the same field name for all the databases, the typed fields of `NewField` resolve the
+// names from the data model instead
 
 func Equal[T any](field string, value T) Specification {
-	return binaryOperatorSpecification[T]{
-		field:    field,
-		operator: "=",
-		value:    value,
-	}
+	return newFieldSpecification(field, OperatorEqual, value)
+}
+
+func NotEqual[T any](field string, value T) Specification {
+	return newFieldSpecification(field, OperatorNotEqual, value)
 }
 
 func GreaterThan[T comparable](field string, value T) Specification {
-	return binaryOperatorSpecification[T]{
-		field:    field,
-		operator: ">",
-		value:    value,
-	}
+	return newFieldSpecification(field, OperatorGreaterThan, value)
 }
 
 func GreaterOrEqual[T comparable](field string, value T) Specification {
-	return binaryOperatorSpecification[T]{
-		field:    field,
-		operator: ">=",
-		value:    value,
-	}
+	return newFieldSpecification(field, OperatorGreaterOrEqual, value)
 }
 
 func LessThan[T comparable](field string, value T) Specification {
-	return binaryOperatorSpecification[T]{
-		field:    field,
-		operator: "<",
-		value:    value,
-	}
+	return newFieldSpecification(field, OperatorLessThan, value)
 }
 
 func LessOrEqual[T comparable](field string, value T) Specification {
-	return binaryOperatorSpecification[T]{
-		field:    field,
-		operator: ">=",
-		value:    value,
-	}
+	return newFieldSpecification(field, OperatorLessOrEqual, value)
 }
 
-type stringSpecification string
+func In[T any](field string, values ...T) Specification {
+	return newFieldSpecification(field, OperatorIn, toAnySlice(values))
+}
 
-func (s stringSpecification) GetQuery() string {
-	return string(s)
+func NotIn[T any](field string, values ...T) Specification {
+	return newFieldSpecification(field, OperatorNotIn, toAnySlice(values))
 }
 
-func (s stringSpecification) GetValues() []any {
-	return nil
+func Like(field string, pattern string) Specification {
+	return newFieldSpecification(field, OperatorLike, pattern)
+}
+
+func ILike(field string, pattern string) Specification {
+	return newFieldSpecification(field, OperatorILike, pattern)
+}
+
+func Between[T comparable](field string, from T, to T) Specification {
+	return newFieldSpecification(field, OperatorBetween, Range{From: from, To: to})
 }
 
 func IsNull(field string) Specification {
-	return stringSpecification(fmt.Sprintf("%s IS NULL", field))
+	return newFieldSpecification(field, OperatorIsNull, nil)
+}
+
+func IsNotNull(field string) Specification {
+	return newFieldSpecification(field, OperatorIsNotNull, nil)
+}
+
+// Contains matches the array fields which contain the value
+func Contains[T any](field string, value T) Specification {
+	return newFieldSpecification(field, OperatorContains, value)
+}
+
+// EscapeLike escapes the `%`, `_` and `\` characters of the value, so the value is matched literally inside a like pattern
+func EscapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+func newFieldSpecification(field string, operator Operator, value any) Specification {
+	return FieldSpecification{Field: NewFieldName(field), Operator: operator, Value: value}
+}
+
+func toAnySlice[T any](values []T) []any {
+	items := make([]any, 0, len(values))
+	for _, value := range values {
+		items = append(items, value)
+	}
+
+	return items
 }
@@ -0,0 +1,56 @@
+package pipelines
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/pipeline"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+
+	"emperror.dev/errors"
+)
+
+// consumerUnitOfWorkPipeline runs the next consumer pipelines and the consumer handler in a unit of work, it should
+// register before the inbox pipeline to store the inbox message in the unit of work of the handler
+type consumerUnitOfWorkPipeline struct {
+	logger     logger.Logger
+	unitOfWork unitofwork.UnitOfWork
+}
+
+func NewConsumerUnitOfWorkPipeline(
+	l logger.Logger,
+	unitOfWork unitofwork.UnitOfWork,
+) pipeline.ConsumerPipeline {
+	return &consumerUnitOfWorkPipeline{
+		logger:     l,
+		unitOfWork: unitOfWork,
+	}
+}
+
+func (c *consumerUnitOfWorkPipeline) Handle(
+	ctx context.Context,
+	consumerContext types.MessageConsumeContext,
+	next pipeline.ConsumerHandlerFunc,
+) (err error) {
+	messageType := consumerContext.MessageType()
+
+	defer func() {
+		// the unit of work is rolled back before the panic reaches here, we return an error instead of the panic, so the
+		// message will be nacked and retried
+		if r := recover(); r != nil {
+			c.logger.Errorf("panic in handling message `%s`: %v", messageType, r)
+
+			err = errors.Errorf("panic in handling message `%s`: %v", messageType, r)
+		}
+	}()
+
+	err = c.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return next(ctx)
+	})
+	if err != nil {
+		c.logger.Errorf("unit of work for message `%s` is rolled back: %v", messageType, err)
+	}
+
+	return err
+}
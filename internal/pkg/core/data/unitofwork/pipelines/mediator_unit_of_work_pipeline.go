@@ -0,0 +1,61 @@
+package pipelines
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// mediatorUnitOfWorkPipeline runs the handlers of the `cqrs.TxRequest` requests in a unit of work, so the repository
+// writes and the outbox messages of the request are committed atomically
+type mediatorUnitOfWorkPipeline struct {
+	logger     logger.Logger
+	unitOfWork unitofwork.UnitOfWork
+}
+
+func NewMediatorUnitOfWorkPipeline(
+	l logger.Logger,
+	unitOfWork unitofwork.UnitOfWork,
+) mediatr.PipelineBehavior {
+	return &mediatorUnitOfWorkPipeline{
+		logger:     l,
+		unitOfWork: unitOfWork,
+	}
+}
+
+func (m *mediatorUnitOfWorkPipeline) Handle(
+	ctx context.Context,
+	request interface{},
+	next mediatr.RequestHandlerFunc,
+) (interface{}, error) {
+	if !cqrs.IsTxRequest(request) {
+		return next(ctx)
+	}
+
+	requestName := typeMapper.GetSnakeTypeName(request)
+
+	m.logger.Infof("beginning unit of work for request `%s`", requestName)
+
+	var result interface{}
+
+	err := m.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		response, err := next(ctx)
+		result = response
+
+		return err
+	})
+	if err != nil {
+		m.logger.Errorf("unit of work for request `%s` is rolled back: %v", requestName, err)
+
+		return nil, err
+	}
+
+	m.logger.Infof("unit of work for request `%s` is committed", requestName)
+
+	return result, nil
+}
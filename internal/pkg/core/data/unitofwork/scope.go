@@ -0,0 +1,115 @@
+package unitofwork
+
+import (
+	"context"
+	"sync"
+
+	"emperror.dev/errors"
+)
+
+type scopeKey struct{}
+
+// Scope keeps the state of a unit of work in the context, the after-commit hooks of a nested scope are moved to its
+// parent when it completes, so they just run after the commit of the outermost unit of work
+type Scope struct {
+	parent       *Scope
+	mu           sync.Mutex
+	hooks        []AfterCommitFunc
+	rollbackOnly bool
+}
+
+// Begin starts the scope of a unit of work, the scope is nested in the scope of the ctx if there is one
+func Begin(ctx context.Context) (context.Context, *Scope) {
+	scope := &Scope{parent: FromContext(ctx)}
+
+	return context.WithValue(ctx, scopeKey{}, scope), scope
+}
+
+// FromContext returns the scope of the current unit of work, or nil if the ctx is not in a unit of work
+func FromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(scopeKey{}).(*Scope)
+
+	return scope
+}
+
+// AfterCommit registers the hook for running after the commit of the current unit of work, the hook is dropped when the
+// unit of work rolls back, and it runs immediately if the ctx is not in a unit of work
+func AfterCommit(ctx context.Context, hook AfterCommitFunc) error {
+	scope := FromContext(ctx)
+	if scope == nil {
+		return hook(ctx)
+	}
+
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+
+	scope.hooks = append(scope.hooks, hook)
+
+	return nil
+}
+
+func (s *Scope) IsNested() bool {
+	return s.parent != nil
+}
+
+// SetRollbackOnly marks the outermost unit of work for rolling back, it is used when a nested unit of work fails and the
+// store can't roll back just the nested writes
+func (s *Scope) SetRollbackOnly() {
+	root := s.root()
+
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	root.rollbackOnly = true
+}
+
+func (s *Scope) IsRollbackOnly() bool {
+	root := s.root()
+
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	return root.rollbackOnly
+}
+
+// Complete moves the hooks of a nested scope to its parent, and runs the hooks of the outermost scope with the ctx, the
+// hooks run after the commit so all of them run and their errors are combined
+func (s *Scope) Complete(ctx context.Context) error {
+	s.mu.Lock()
+	hooks := s.hooks
+	s.hooks = nil
+	s.mu.Unlock()
+
+	if s.parent != nil {
+		s.parent.mu.Lock()
+		defer s.parent.mu.Unlock()
+
+		s.parent.hooks = append(s.parent.hooks, hooks...)
+
+		return nil
+	}
+
+	var err error
+	for _, hook := range hooks {
+		err = errors.Append(err, hook(ctx))
+	}
+
+	return err
+}
+
+// Discard drops the hooks of a rolled back scope
+func (s *Scope) Discard() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hooks = nil
+}
+
+func (s *Scope) root() *Scope {
+	root := s
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	return root
+}
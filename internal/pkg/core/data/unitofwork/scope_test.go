@@ -0,0 +1,90 @@
+//go:build unit
+// +build unit
+
+package unitofwork
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_After_Commit_Should_Run_Hook_Immediately_Without_Unit_Of_Work(t *testing.T) {
+	called := false
+
+	err := AfterCommit(context.Background(), func(ctx context.Context) error {
+		called = true
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.True(t, called)
+}
+
+func Test_Complete_Should_Run_Hooks_Of_Outermost_Scope(t *testing.T) {
+	var calls []string
+
+	ctx, root := Begin(context.Background())
+	require.NoError(t, AfterCommit(ctx, recordHook(&calls, "root")))
+
+	nestedCtx, nested := Begin(ctx)
+	assert.True(t, nested.IsNested())
+	require.NoError(t, AfterCommit(nestedCtx, recordHook(&calls, "nested")))
+
+	require.NoError(t, nested.Complete(nestedCtx))
+	assert.Empty(t, calls)
+
+	require.NoError(t, root.Complete(context.Background()))
+	assert.Equal(t, []string{"root", "nested"}, calls)
+}
+
+func Test_Discard_Should_Drop_Hooks_Of_Nested_Scope(t *testing.T) {
+	var calls []string
+
+	ctx, root := Begin(context.Background())
+	require.NoError(t, AfterCommit(ctx, recordHook(&calls, "root")))
+
+	nestedCtx, nested := Begin(ctx)
+	require.NoError(t, AfterCommit(nestedCtx, recordHook(&calls, "nested")))
+	nested.Discard()
+
+	require.NoError(t, root.Complete(context.Background()))
+	assert.Equal(t, []string{"root"}, calls)
+}
+
+func Test_Complete_Should_Run_All_Hooks_And_Combine_Errors(t *testing.T) {
+	var calls []string
+
+	ctx, root := Begin(context.Background())
+	require.NoError(t, AfterCommit(ctx, func(ctx context.Context) error {
+		return errors.New("first hook failed")
+	}))
+	require.NoError(t, AfterCommit(ctx, recordHook(&calls, "second")))
+
+	err := root.Complete(context.Background())
+
+	assert.ErrorContains(t, err, "first hook failed")
+	assert.Equal(t, []string{"second"}, calls)
+}
+
+func Test_Set_Rollback_Only_Should_Mark_Outermost_Scope(t *testing.T) {
+	ctx, root := Begin(context.Background())
+	_, nested := Begin(ctx)
+
+	nested.SetRollbackOnly()
+
+	assert.True(t, root.IsRollbackOnly())
+	assert.Same(t, root, FromContext(ctx))
+}
+
+func recordHook(calls *[]string, name string) AfterCommitFunc {
+	return func(ctx context.Context) error {
+		*calls = append(*calls, name)
+
+		return nil
+	}
+}
@@ -0,0 +1,26 @@
+package unitofwork
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+)
+
+// ErrRollbackOnly is returned by the outer unit of work when a nested unit of work failed and the store can't roll back
+// just the nested writes (e.g. mongo doesn't have savepoints), so the whole unit of work is rolled back
+var ErrRollbackOnly = errors.New("unit of work is rolled back because a nested unit of work failed")
+
+// ActionFunc is the work which runs in the unit of work, the repositories and the message persistence services should use
+// the ctx of the action for joining to the transaction of the unit of work
+type ActionFunc func(ctx context.Context) error
+
+// AfterCommitFunc runs after the commit of the outermost unit of work, e.g. for publishing the domain events
+type AfterCommitFunc func(ctx context.Context) error
+
+// UnitOfWork commits the writes of the repositories and the outbox messages of an action atomically
+type UnitOfWork interface {
+	// Do runs the action in a transaction, it commits the transaction when the action succeeds and rolls it back when the
+	// action returns an error or panics. calling Do inside another unit of work joins the outer transaction with a nested
+	// unit of work, which is rolled back to its savepoint on an error
+	Do(ctx context.Context, action ActionFunc) error
+}
@@ -9,4 +9,5 @@ type Bus interface {
 	producer.Producer
 	consumer2.BusControl
 	consumer2.ConsumerConnector
+	Requester
 }
@@ -0,0 +1,72 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+
+	"emperror.dev/errors"
+)
+
+// DefaultRequestTimeout is used for the requests without a timeout
+const DefaultRequestTimeout = 30 * time.Second
+
+// Requester sends the request messages and waits for their responses
+type Requester interface {
+	// Request publishes the request message with a reply address and a new correlation id, and waits for its response until the timeout
+	Request(
+		ctx context.Context,
+		request types.IMessage,
+		meta metadata.Metadata,
+		timeout time.Duration,
+	) (types.IMessage, error)
+}
+
+// Request sends the request message and waits for its response of type TRes
+func Request[TReq types.IMessage, TRes types.IMessage](
+	ctx context.Context,
+	requester Requester,
+	request TReq,
+	timeout time.Duration,
+) (TRes, error) {
+	response, err := requester.Request(ctx, request, nil, timeout)
+	if err != nil {
+		return *new(TRes), err
+	}
+
+	res, ok := response.(TRes)
+	if !ok {
+		return *new(TRes), errors.Errorf(
+			"response of request '%s' is '%T', expected '%T'",
+			request.GeMessageId(),
+			response,
+			*new(TRes),
+		)
+	}
+
+	return res, nil
+}
+
+// RequestTimeoutError is returned when no response is received for a request within its timeout
+type RequestTimeoutError struct {
+	MessageId     string
+	CorrelationId string
+	Timeout       time.Duration
+}
+
+func (e *RequestTimeoutError) Error() string {
+	return fmt.Sprintf(
+		"no response received for request '%s' with correlation id '%s' after %s",
+		e.MessageId,
+		e.CorrelationId,
+		e.Timeout,
+	)
+}
+
+func IsRequestTimeoutError(err error) bool {
+	var requestTimeoutError *RequestTimeoutError
+	return errors.As(err, &requestTimeoutError)
+}
@@ -7,4 +7,5 @@ const (
 	Type          string = "type"
 	ContentType   string = "content-type"
 	Created       string = "created"
+	ReplyTo       string = "reply-to"
 )
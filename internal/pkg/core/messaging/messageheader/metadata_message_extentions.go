@@ -53,3 +53,11 @@ func GetMessageCreated(m metadata.Metadata) time.Time {
 func SetMessageCreated(m metadata.Metadata, val time.Time) {
 	m.Set(Created, val)
 }
+
+func GetReplyTo(m metadata.Metadata) string {
+	return m.GetString(ReplyTo)
+}
+
+func SetReplyTo(m metadata.Metadata, val string) {
+	m.Set(ReplyTo, val)
+}
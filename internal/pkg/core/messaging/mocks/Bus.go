@@ -11,6 +11,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	types "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
 )
 
@@ -150,7 +152,7 @@ func (_c *Bus_IsConsumed_Call) Return() *Bus_IsConsumed_Call {
 }
 
 func (_c *Bus_IsConsumed_Call) RunAndReturn(run func(func(types.IMessage))) *Bus_IsConsumed_Call {
-	_c.Call.Return(run)
+	_c.Run(run)
 	return _c
 }
 
@@ -183,7 +185,7 @@ func (_c *Bus_IsProduced_Call) Return() *Bus_IsProduced_Call {
 }
 
 func (_c *Bus_IsProduced_Call) RunAndReturn(run func(func(types.IMessage))) *Bus_IsProduced_Call {
-	_c.Call.Return(run)
+	_c.Run(run)
 	return _c
 }
 
@@ -284,6 +286,115 @@ func (_c *Bus_PublishMessageWithTopicName_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// PublishMessages provides a mock function with given fields: ctx, messages, meta
+func (_m *Bus) PublishMessages(ctx context.Context, messages []types.IMessage, meta metadata.Metadata) error {
+	ret := _m.Called(ctx, messages, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.IMessage, metadata.Metadata) error); ok {
+		r0 = rf(ctx, messages, meta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Bus_PublishMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishMessages'
+type Bus_PublishMessages_Call struct {
+	*mock.Call
+}
+
+// PublishMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messages []types.IMessage
+//   - meta metadata.Metadata
+func (_e *Bus_Expecter) PublishMessages(ctx interface{}, messages interface{}, meta interface{}) *Bus_PublishMessages_Call {
+	return &Bus_PublishMessages_Call{Call: _e.mock.On("PublishMessages", ctx, messages, meta)}
+}
+
+func (_c *Bus_PublishMessages_Call) Run(run func(ctx context.Context, messages []types.IMessage, meta metadata.Metadata)) *Bus_PublishMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]types.IMessage), args[2].(metadata.Metadata))
+	})
+	return _c
+}
+
+func (_c *Bus_PublishMessages_Call) Return(_a0 error) *Bus_PublishMessages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Bus_PublishMessages_Call) RunAndReturn(run func(context.Context, []types.IMessage, metadata.Metadata) error) *Bus_PublishMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Request provides a mock function with given fields: ctx, request, meta, timeout
+func (_m *Bus) Request(ctx context.Context, request types.IMessage, meta metadata.Metadata, timeout time.Duration) (types.IMessage, error) {
+	ret := _m.Called(ctx, request, meta, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Request")
+	}
+
+	var r0 types.IMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.IMessage, metadata.Metadata, time.Duration) (types.IMessage, error)); ok {
+		return rf(ctx, request, meta, timeout)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.IMessage, metadata.Metadata, time.Duration) types.IMessage); ok {
+		r0 = rf(ctx, request, meta, timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.IMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.IMessage, metadata.Metadata, time.Duration) error); ok {
+		r1 = rf(ctx, request, meta, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Bus_Request_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Request'
+type Bus_Request_Call struct {
+	*mock.Call
+}
+
+// Request is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request types.IMessage
+//   - meta metadata.Metadata
+//   - timeout time.Duration
+func (_e *Bus_Expecter) Request(ctx interface{}, request interface{}, meta interface{}, timeout interface{}) *Bus_Request_Call {
+	return &Bus_Request_Call{Call: _e.mock.On("Request", ctx, request, meta, timeout)}
+}
+
+func (_c *Bus_Request_Call) Run(run func(ctx context.Context, request types.IMessage, meta metadata.Metadata, timeout time.Duration)) *Bus_Request_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.IMessage), args[2].(metadata.Metadata), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Bus_Request_Call) Return(_a0 types.IMessage, _a1 error) *Bus_Request_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Bus_Request_Call) RunAndReturn(run func(context.Context, types.IMessage, metadata.Metadata, time.Duration) (types.IMessage, error)) *Bus_Request_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Bus) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
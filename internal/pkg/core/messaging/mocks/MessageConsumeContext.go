@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	metadata "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
 	mock "github.com/stretchr/testify/mock"
 
@@ -388,6 +390,98 @@ func (_c *MessageConsumeContext_Metadata_Call) RunAndReturn(run func() metadata.
 	return _c
 }
 
+// ReplyTo provides a mock function with given fields:
+func (_m *MessageConsumeContext) ReplyTo() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplyTo")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MessageConsumeContext_ReplyTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplyTo'
+type MessageConsumeContext_ReplyTo_Call struct {
+	*mock.Call
+}
+
+// ReplyTo is a helper method to define mock.On call
+func (_e *MessageConsumeContext_Expecter) ReplyTo() *MessageConsumeContext_ReplyTo_Call {
+	return &MessageConsumeContext_ReplyTo_Call{Call: _e.mock.On("ReplyTo")}
+}
+
+func (_c *MessageConsumeContext_ReplyTo_Call) Run(run func()) *MessageConsumeContext_ReplyTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MessageConsumeContext_ReplyTo_Call) Return(_a0 string) *MessageConsumeContext_ReplyTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MessageConsumeContext_ReplyTo_Call) RunAndReturn(run func() string) *MessageConsumeContext_ReplyTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Respond provides a mock function with given fields: ctx, response
+func (_m *MessageConsumeContext) Respond(ctx context.Context, response types.IMessage) error {
+	ret := _m.Called(ctx, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Respond")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.IMessage) error); ok {
+		r0 = rf(ctx, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MessageConsumeContext_Respond_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Respond'
+type MessageConsumeContext_Respond_Call struct {
+	*mock.Call
+}
+
+// Respond is a helper method to define mock.On call
+//   - ctx context.Context
+//   - response types.IMessage
+func (_e *MessageConsumeContext_Expecter) Respond(ctx interface{}, response interface{}) *MessageConsumeContext_Respond_Call {
+	return &MessageConsumeContext_Respond_Call{Call: _e.mock.On("Respond", ctx, response)}
+}
+
+func (_c *MessageConsumeContext_Respond_Call) Run(run func(ctx context.Context, response types.IMessage)) *MessageConsumeContext_Respond_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.IMessage))
+	})
+	return _c
+}
+
+func (_c *MessageConsumeContext_Respond_Call) Return(_a0 error) *MessageConsumeContext_Respond_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MessageConsumeContext_Respond_Call) RunAndReturn(run func(context.Context, types.IMessage) error) *MessageConsumeContext_Respond_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMessageConsumeContext creates a new instance of MessageConsumeContext. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMessageConsumeContext(t interface {
@@ -5,9 +5,14 @@ package mocks
 import (
 	context "context"
 
+	cqrs "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	mock "github.com/stretchr/testify/mock"
+
 	persistmessage "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+
+	time "time"
+
 	types "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
-	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/satori/go.uuid"
 )
@@ -72,6 +77,53 @@ func (_c *MessagePersistenceService_Add_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// AddInternalMessage provides a mock function with given fields: internalCommand, ctx
+func (_m *MessagePersistenceService) AddInternalMessage(internalCommand cqrs.InternalCommand, ctx context.Context) error {
+	ret := _m.Called(internalCommand, ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddInternalMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(cqrs.InternalCommand, context.Context) error); ok {
+		r0 = rf(internalCommand, ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MessagePersistenceService_AddInternalMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddInternalMessage'
+type MessagePersistenceService_AddInternalMessage_Call struct {
+	*mock.Call
+}
+
+// AddInternalMessage is a helper method to define mock.On call
+//   - internalCommand cqrs.InternalCommand
+//   - ctx context.Context
+func (_e *MessagePersistenceService_Expecter) AddInternalMessage(internalCommand interface{}, ctx interface{}) *MessagePersistenceService_AddInternalMessage_Call {
+	return &MessagePersistenceService_AddInternalMessage_Call{Call: _e.mock.On("AddInternalMessage", internalCommand, ctx)}
+}
+
+func (_c *MessagePersistenceService_AddInternalMessage_Call) Run(run func(internalCommand cqrs.InternalCommand, ctx context.Context)) *MessagePersistenceService_AddInternalMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(cqrs.InternalCommand), args[1].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MessagePersistenceService_AddInternalMessage_Call) Return(_a0 error) *MessagePersistenceService_AddInternalMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MessagePersistenceService_AddInternalMessage_Call) RunAndReturn(run func(cqrs.InternalCommand, context.Context) error) *MessagePersistenceService_AddInternalMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddPublishMessage provides a mock function with given fields: messageEnvelope, ctx
 func (_m *MessagePersistenceService) AddPublishMessage(messageEnvelope types.MessageEnvelope, ctx context.Context) error {
 	ret := _m.Called(messageEnvelope, ctx)
@@ -318,65 +370,6 @@ func (_c *MessagePersistenceService_GetAllActive_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// GetByFilter provides a mock function with given fields: ctx, predicate
-func (_m *MessagePersistenceService) GetByFilter(ctx context.Context, predicate func(*persistmessage.StoreMessage) bool) ([]*persistmessage.StoreMessage, error) {
-	ret := _m.Called(ctx, predicate)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetByFilter")
-	}
-
-	var r0 []*persistmessage.StoreMessage
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, func(*persistmessage.StoreMessage) bool) ([]*persistmessage.StoreMessage, error)); ok {
-		return rf(ctx, predicate)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, func(*persistmessage.StoreMessage) bool) []*persistmessage.StoreMessage); ok {
-		r0 = rf(ctx, predicate)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*persistmessage.StoreMessage)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, func(*persistmessage.StoreMessage) bool) error); ok {
-		r1 = rf(ctx, predicate)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// MessagePersistenceService_GetByFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByFilter'
-type MessagePersistenceService_GetByFilter_Call struct {
-	*mock.Call
-}
-
-// GetByFilter is a helper method to define mock.On call
-//   - ctx context.Context
-//   - predicate func(*persistmessage.StoreMessage) bool
-func (_e *MessagePersistenceService_Expecter) GetByFilter(ctx interface{}, predicate interface{}) *MessagePersistenceService_GetByFilter_Call {
-	return &MessagePersistenceService_GetByFilter_Call{Call: _e.mock.On("GetByFilter", ctx, predicate)}
-}
-
-func (_c *MessagePersistenceService_GetByFilter_Call) Run(run func(ctx context.Context, predicate func(*persistmessage.StoreMessage) bool)) *MessagePersistenceService_GetByFilter_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(func(*persistmessage.StoreMessage) bool))
-	})
-	return _c
-}
-
-func (_c *MessagePersistenceService_GetByFilter_Call) Return(_a0 []*persistmessage.StoreMessage, _a1 error) *MessagePersistenceService_GetByFilter_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *MessagePersistenceService_GetByFilter_Call) RunAndReturn(run func(context.Context, func(*persistmessage.StoreMessage) bool) ([]*persistmessage.StoreMessage, error)) *MessagePersistenceService_GetByFilter_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // GetById provides a mock function with given fields: ctx, id
 func (_m *MessagePersistenceService) GetById(ctx context.Context, id uuid.UUID) (*persistmessage.StoreMessage, error) {
 	ret := _m.Called(ctx, id)
@@ -586,6 +579,54 @@ func (_c *MessagePersistenceService_Remove_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ScheduleInternalMessage provides a mock function with given fields: internalCommand, processAt, ctx
+func (_m *MessagePersistenceService) ScheduleInternalMessage(internalCommand cqrs.InternalCommand, processAt time.Time, ctx context.Context) error {
+	ret := _m.Called(internalCommand, processAt, ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleInternalMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(cqrs.InternalCommand, time.Time, context.Context) error); ok {
+		r0 = rf(internalCommand, processAt, ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MessagePersistenceService_ScheduleInternalMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleInternalMessage'
+type MessagePersistenceService_ScheduleInternalMessage_Call struct {
+	*mock.Call
+}
+
+// ScheduleInternalMessage is a helper method to define mock.On call
+//   - internalCommand cqrs.InternalCommand
+//   - processAt time.Time
+//   - ctx context.Context
+func (_e *MessagePersistenceService_Expecter) ScheduleInternalMessage(internalCommand interface{}, processAt interface{}, ctx interface{}) *MessagePersistenceService_ScheduleInternalMessage_Call {
+	return &MessagePersistenceService_ScheduleInternalMessage_Call{Call: _e.mock.On("ScheduleInternalMessage", internalCommand, processAt, ctx)}
+}
+
+func (_c *MessagePersistenceService_ScheduleInternalMessage_Call) Run(run func(internalCommand cqrs.InternalCommand, processAt time.Time, ctx context.Context)) *MessagePersistenceService_ScheduleInternalMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(cqrs.InternalCommand), args[1].(time.Time), args[2].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MessagePersistenceService_ScheduleInternalMessage_Call) Return(_a0 error) *MessagePersistenceService_ScheduleInternalMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MessagePersistenceService_ScheduleInternalMessage_Call) RunAndReturn(run func(cqrs.InternalCommand, time.Time, context.Context) error) *MessagePersistenceService_ScheduleInternalMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, storeMessage
 func (_m *MessagePersistenceService) Update(ctx context.Context, storeMessage *persistmessage.StoreMessage) error {
 	ret := _m.Called(ctx, storeMessage)
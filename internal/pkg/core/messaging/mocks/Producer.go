@@ -53,7 +53,7 @@ func (_c *Producer_IsProduced_Call) Return() *Producer_IsProduced_Call {
 }
 
 func (_c *Producer_IsProduced_Call) RunAndReturn(run func(func(types.IMessage))) *Producer_IsProduced_Call {
-	_c.Call.Return(run)
+	_c.Run(run)
 	return _c
 }
 
@@ -154,6 +154,54 @@ func (_c *Producer_PublishMessageWithTopicName_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// PublishMessages provides a mock function with given fields: ctx, messages, meta
+func (_m *Producer) PublishMessages(ctx context.Context, messages []types.IMessage, meta metadata.Metadata) error {
+	ret := _m.Called(ctx, messages, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.IMessage, metadata.Metadata) error); ok {
+		r0 = rf(ctx, messages, meta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Producer_PublishMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishMessages'
+type Producer_PublishMessages_Call struct {
+	*mock.Call
+}
+
+// PublishMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messages []types.IMessage
+//   - meta metadata.Metadata
+func (_e *Producer_Expecter) PublishMessages(ctx interface{}, messages interface{}, meta interface{}) *Producer_PublishMessages_Call {
+	return &Producer_PublishMessages_Call{Call: _e.mock.On("PublishMessages", ctx, messages, meta)}
+}
+
+func (_c *Producer_PublishMessages_Call) Run(run func(ctx context.Context, messages []types.IMessage, meta metadata.Metadata)) *Producer_PublishMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]types.IMessage), args[2].(metadata.Metadata))
+	})
+	return _c
+}
+
+func (_c *Producer_PublishMessages_Call) Return(_a0 error) *Producer_PublishMessages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Producer_PublishMessages_Call) RunAndReturn(run func(context.Context, []types.IMessage, metadata.Metadata) error) *Producer_PublishMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewProducer creates a new instance of Producer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProducer(t interface {
@@ -2,7 +2,9 @@ package persistmessage
 
 import (
 	"context"
+	"time"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
 
 	uuid "github.com/satori/go.uuid"
@@ -17,10 +19,6 @@ type MessagePersistenceService interface {
 		status MessageStatus,
 	) error
 	GetAllActive(ctx context.Context) ([]*StoreMessage, error)
-	GetByFilter(
-		ctx context.Context,
-		predicate func(*StoreMessage) bool,
-	) ([]*StoreMessage, error)
 	GetById(ctx context.Context, id uuid.UUID) (*StoreMessage, error)
 	Remove(ctx context.Context, storeMessage *StoreMessage) (bool, error)
 	CleanupMessages(ctx context.Context) error
@@ -34,8 +32,15 @@ type MessagePersistenceService interface {
 		messageEnvelope types.MessageEnvelope,
 		ctx context.Context,
 	) error
-	//AddInternalMessage(
-	//	internalCommand InternalMessage,
-	//	ctx context.Context,
-	//) error
+	// AddInternalMessage stores the internal command in the current transaction, it is dispatched to its handler by the message dispatcher
+	AddInternalMessage(
+		internalCommand cqrs.InternalCommand,
+		ctx context.Context,
+	) error
+	// ScheduleInternalMessage stores the internal command in the current transaction for dispatching at the process time
+	ScheduleInternalMessage(
+		internalCommand cqrs.InternalCommand,
+		processAt time.Time,
+		ctx context.Context,
+	) error
 }
@@ -0,0 +1,77 @@
+package persistmessage
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/producer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+)
+
+// TopicOrExchangeNameHeader keeps the explicit topic of an outbox message until the dispatcher publishes it
+const TopicOrExchangeNameHeader = "outbox-topic-or-exchange-name"
+
+// outboxProducer is a producer decorator that writes messages to the outbox in the current transaction instead of publishing them on the broker, the outbox dispatcher publishes them after the commit.
+type outboxProducer struct {
+	producer                  producer.Producer
+	messagePersistenceService MessagePersistenceService
+}
+
+func NewOutboxProducer(
+	producer producer.Producer,
+	messagePersistenceService MessagePersistenceService,
+) producer.Producer {
+	return &outboxProducer{
+		producer:                  producer,
+		messagePersistenceService: messagePersistenceService,
+	}
+}
+
+func (o *outboxProducer) PublishMessage(
+	ctx context.Context,
+	message types.IMessage,
+	meta metadata.Metadata,
+) error {
+	return o.PublishMessageWithTopicName(ctx, message, meta, "")
+}
+
+func (o *outboxProducer) PublishMessageWithTopicName(
+	ctx context.Context,
+	message types.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) error {
+	headers := make(map[string]interface{}, len(meta)+1)
+	for key, value := range meta {
+		headers[key] = value
+	}
+
+	if topicOrExchangeName != "" {
+		headers[TopicOrExchangeNameHeader] = topicOrExchangeName
+	}
+
+	return o.messagePersistenceService.AddPublishMessage(
+		*types.NewMessageEnvelope(message, headers),
+		ctx,
+	)
+}
+
+func (o *outboxProducer) PublishMessages(
+	ctx context.Context,
+	messages []types.IMessage,
+	meta metadata.Metadata,
+) error {
+	for _, message := range messages {
+		err := o.PublishMessageWithTopicName(ctx, message, meta, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsProduced notifications raise by the actual producer when the dispatcher published the message
+func (o *outboxProducer) IsProduced(h func(message types.IMessage)) {
+	o.producer.IsProduced(h)
+}
@@ -19,6 +19,9 @@ type MessageStatus int
 const (
 	Stored    MessageStatus = 1
 	Processed MessageStatus = 2
+	// DeadLettered is the terminal state of a message which failed in all the retries, it is not dispatched anymore and
+	// is kept in the store for the manual inspection
+	DeadLettered MessageStatus = 3
 )
 
 type StoreMessage struct {
@@ -27,8 +30,14 @@ type StoreMessage struct {
 	Data          string
 	CreatedAt     time.Time `gorm:"default:current_timestamp"`
 	RetryCount    int
-	MessageStatus MessageStatus
+	MessageStatus MessageStatus `gorm:"index"`
 	DeliveryType  MessageDeliveryType
+	// NextRetryAt is the earliest time a scheduled or a failed message will be picked up by the dispatcher
+	NextRetryAt *time.Time
+	ProcessedAt *time.Time
+	// ClaimToken is the token of the dispatcher claim, the result of the processing is just saved while the message has
+	// the same token, so a dispatcher whose claim expired doesn't override the result of the next claim
+	ClaimToken string
 }
 
 func NewStoreMessage(
@@ -56,6 +65,33 @@ func (sm *StoreMessage) IncreaseRetry() {
 	sm.RetryCount++
 }
 
+// MarkAsProcessed changes the message state to `Processed` and keeps the processing time for the cleanup
+func (sm *StoreMessage) MarkAsProcessed(processedAt time.Time) {
+	sm.MessageStatus = Processed
+	sm.ProcessedAt = &processedAt
+	sm.NextRetryAt = nil
+	sm.ClaimToken = ""
+}
+
+// MarkAsDeadLettered changes the message state to the terminal `DeadLettered` state after the last failed retry
+func (sm *StoreMessage) MarkAsDeadLettered() {
+	sm.MessageStatus = DeadLettered
+	sm.NextRetryAt = nil
+	sm.ClaimToken = ""
+}
+
+// ScheduleRetry increases the retry count and postpones the next processing of the message
+func (sm *StoreMessage) ScheduleRetry(nextRetryAt time.Time) {
+	sm.IncreaseRetry()
+	sm.NextRetryAt = &nextRetryAt
+	sm.ClaimToken = ""
+}
+
+// ScheduleAt postpones the first processing of the message to the process time
+func (sm *StoreMessage) ScheduleAt(processAt time.Time) {
+	sm.NextRetryAt = &processAt
+}
+
 func (sm *StoreMessage) TableName() string {
 	return "store_messages"
 }
@@ -0,0 +1,90 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// inboxConsumerPipeline makes consumers idempotent by recording the received message ids in the inbox and skipping redelivered messages.
+// it uses the handler context, so the inbox record participates in the transaction of the handler if there is one in the context.
+type inboxConsumerPipeline struct {
+	messagePersistenceService persistmessage.MessagePersistenceService
+	logger                    logger.Logger
+}
+
+func NewInboxConsumerPipeline(
+	messagePersistenceService persistmessage.MessagePersistenceService,
+	logger logger.Logger,
+) ConsumerPipeline {
+	return &inboxConsumerPipeline{
+		messagePersistenceService: messagePersistenceService,
+		logger:                    logger,
+	}
+}
+
+func (i *inboxConsumerPipeline) Handle(
+	ctx context.Context,
+	consumerContext types.MessageConsumeContext,
+	next ConsumerHandlerFunc,
+) error {
+	if consumerContext.Message() == nil {
+		return next(ctx)
+	}
+
+	// inbox row id is the message id, same as the id that `AddReceivedMessage` uses for storing the message
+	messageId, err := uuid.FromString(consumerContext.Message().GeMessageId())
+	if err != nil {
+		i.logger.Warnf(
+			"message with id `%s` is not a valid inbox id, handling the message without inbox",
+			consumerContext.Message().GeMessageId(),
+		)
+
+		return next(ctx)
+	}
+
+	// the unique insert of the inbox message claims the message before the handler runs, a duplicate message is already
+	// processed or handled by a concurrent delivery, so it is skipped without checking and storing it in two steps
+	err = i.messagePersistenceService.AddReceivedMessage(
+		*types.NewMessageEnvelope(consumerContext.Message(), consumerContext.Metadata()),
+		ctx,
+	)
+	if customErrors.IsConflictError(err) {
+		i.logger.Infof(
+			"message with id `%s` and type `%s` already processed, skipping the duplicate message",
+			messageId,
+			consumerContext.MessageType(),
+		)
+
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := next(ctx); err != nil {
+		i.removeInboxMessage(ctx, messageId)
+
+		return err
+	}
+
+	return i.messagePersistenceService.ChangeState(
+		ctx,
+		messageId,
+		persistmessage.Processed,
+	)
+}
+
+// removeInboxMessage removes the inbox message of a failed handler, so the redelivery of the message is handled again,
+// in a transaction the inbox message is also removed by the rollback
+func (i *inboxConsumerPipeline) removeInboxMessage(ctx context.Context, messageId uuid.UUID) {
+	_, err := i.messagePersistenceService.Remove(ctx, &persistmessage.StoreMessage{ID: messageId})
+	if err != nil {
+		i.logger.Warnf("error in removing the inbox message with id `%s` of the failed handler: %v", messageId, err)
+	}
+}
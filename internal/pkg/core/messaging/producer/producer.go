@@ -15,5 +15,7 @@ type Producer interface {
 		meta metadata.Metadata,
 		topicOrExchangeName string,
 	) error
+	// PublishMessages publishes a batch of messages to their configured topics or exchanges
+	PublishMessages(ctx context.Context, messages []types.IMessage, meta metadata.Metadata) error
 	IsProduced(func(message types.IMessage))
 }
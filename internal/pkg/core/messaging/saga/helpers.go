@@ -0,0 +1,39 @@
+package saga
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
+
+	"go.uber.org/fx"
+)
+
+// Module connects the sagas which are provided with `AsSaga` to the bus
+var Module = fx.Module("sagafx", fx.Invoke(ConnectSagas)) //nolint:gochecknoglobals
+
+// AsSaga provides the saga in the `sagas` group, the sagas of the group consume their messages from the bus and receive their
+// timeouts from the timeout scheduler
+func AsSaga(saga interface{}) interface{} {
+	return fx.Annotate(
+		saga,
+		fx.As(new(Saga)),
+		fx.ResultTags(`group:"sagas"`),
+	)
+}
+
+type SagasParams struct {
+	fx.In
+
+	Sagas []Saga `group:"sagas"`
+}
+
+// ConnectSagas connects the sagas as the consumer handlers of their messages, it should run before starting the bus
+func ConnectSagas(bus bus.Bus, params SagasParams) error {
+	for _, saga := range params.Sagas {
+		for _, messageType := range saga.MessageTypes() {
+			if err := bus.ConnectConsumerHandler(messageType, saga); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
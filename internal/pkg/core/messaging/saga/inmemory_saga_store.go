@@ -0,0 +1,58 @@
+package saga
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type inMemorySagaStore struct {
+	instances map[string]SagaInstance
+	mu        sync.RWMutex
+}
+
+// NewInMemorySagaStore creates a saga store without persistence and transactions, it is used for the tests
+func NewInMemorySagaStore() SagaStore {
+	return &inMemorySagaStore{instances: make(map[string]SagaInstance)}
+}
+
+func (i *inMemorySagaStore) Load(ctx context.Context, sagaName string, correlationId string) (*SagaInstance, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	instance, ok := i.instances[instanceKey(sagaName, correlationId)]
+	if !ok {
+		return nil, nil
+	}
+
+	instance.Compensations = append([]string(nil), instance.Compensations...)
+
+	return &instance, nil
+}
+
+func (i *inMemorySagaStore) Save(ctx context.Context, instance *SagaInstance, expectedVersion int64) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	key := instanceKey(instance.SagaName, instance.CorrelationId)
+
+	stored, exists := i.instances[key]
+	if (!exists && expectedVersion != 0) || (exists && stored.Version != expectedVersion) {
+		return ErrSagaConcurrencyConflict
+	}
+
+	i.instances[key] = *instance
+
+	return nil
+}
+
+func (i *inMemorySagaStore) ExecuteInTransaction(
+	ctx context.Context,
+	action func(ctx context.Context) error,
+) error {
+	return action(ctx)
+}
+
+func instanceKey(sagaName string, correlationId string) string {
+	return fmt.Sprintf("%s/%s", sagaName, correlationId)
+}
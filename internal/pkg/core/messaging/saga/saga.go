@@ -0,0 +1,272 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/messageheader"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/producer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+
+	"emperror.dev/errors"
+)
+
+// maxConcurrencyRetries is the number of the attempts for handling a message when the saga instance is changed concurrently
+const maxConcurrencyRetries = 3
+
+// Saga coordinates a saga definition, it is connected as the consumer handler of the saga messages and receives the saga timeouts
+type Saga interface {
+	consumer.ConsumerHandler
+	Name() string
+	// MessageTypes returns the messages which should be consumed by the saga
+	MessageTypes() []types.IMessage
+	HandleTimeout(ctx context.Context, timeout *Timeout) error
+}
+
+type sagaCoordinator[TData any] struct {
+	definition *Definition[TData]
+	store      SagaStore
+	producer   producer.Producer
+	scheduler  TimeoutScheduler
+	logger     logger.Logger
+}
+
+// step runs a handler of the saga definition with the loaded saga context
+type step func(ctx context.Context) error
+
+// NewSaga creates the coordinator of the saga definition, the messages are published with the producer in the transaction of
+// the store, so the producer should write them to the outbox of the same store (e.g. `persistmessage.NewOutboxProducer`),
+// otherwise they are published even when the transaction is rolled back or retried
+func NewSaga[TData any](
+	definition *Definition[TData],
+	store SagaStore,
+	producer producer.Producer,
+	scheduler TimeoutScheduler,
+	logger logger.Logger,
+) Saga {
+	return &sagaCoordinator[TData]{
+		definition: definition,
+		store:      store,
+		producer:   producer,
+		scheduler:  scheduler,
+		logger:     logger,
+	}
+}
+
+func (s *sagaCoordinator[TData]) Name() string {
+	return s.definition.name
+}
+
+func (s *sagaCoordinator[TData]) MessageTypes() []types.IMessage {
+	return s.definition.MessageTypes()
+}
+
+// Handle handles the message by the handler of the current saga state, the messages which are not expected in the current
+// state (e.g. the redelivered messages) are ignored
+func (s *sagaCoordinator[TData]) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	message := consumeContext.Message()
+	messageType := utils.GetMessageBaseReflectType(message)
+
+	correlate, ok := s.definition.correlations[messageType]
+	if !ok {
+		return errors.Errorf("message '%s' is not consumed by saga '%s'", messageType.Name(), s.definition.name)
+	}
+
+	correlationId := correlate(message)
+	if correlationId == "" {
+		return errors.Errorf(
+			"message '%s' has no correlation id for saga '%s'",
+			consumeContext.MessageId(),
+			s.definition.name,
+		)
+	}
+
+	return s.execute(ctx, correlationId, func(sagaContext *Context[TData]) (step, bool) {
+		handler, ok := s.definition.handler(sagaContext.state, messageType)
+		if !ok {
+			s.logger.Infof(
+				"message '%s' is ignored by saga '%s' with correlation id '%s' in state '%s'",
+				messageType.Name(),
+				s.definition.name,
+				correlationId,
+				sagaContext.state,
+			)
+
+			return nil, false
+		}
+
+		return func(ctx context.Context) error {
+			return handler(ctx, sagaContext, message)
+		}, true
+	})
+}
+
+// HandleTimeout handles the timeout when the saga is still in the state which scheduled it
+func (s *sagaCoordinator[TData]) HandleTimeout(ctx context.Context, timeout *Timeout) error {
+	return s.execute(ctx, timeout.CorrelationId, func(sagaContext *Context[TData]) (step, bool) {
+		if sagaContext.isNew() || sagaContext.state != timeout.State {
+			return nil, false
+		}
+
+		handler, ok := s.definition.timeoutHandler(timeout.State, timeout.Name)
+		if !ok {
+			return nil, false
+		}
+
+		s.logger.Infof(
+			"timeout '%s' of saga '%s' with correlation id '%s' is elapsed in state '%s'",
+			timeout.Name,
+			s.definition.name,
+			timeout.CorrelationId,
+			timeout.State,
+		)
+
+		return func(ctx context.Context) error {
+			return handler(ctx, sagaContext)
+		}, true
+	})
+}
+
+// execute loads the saga instance, runs the resolved step, saves the instance and writes the sent messages in a transaction
+// of the store, the step is retried with the new instance when it is changed concurrently. the timeouts of the new state are
+// scheduled after the commit
+func (s *sagaCoordinator[TData]) execute(
+	ctx context.Context,
+	correlationId string,
+	resolve func(sagaContext *Context[TData]) (step, bool),
+) error {
+	var err error
+	var committed *Context[TData]
+
+	for attempt := 1; attempt <= maxConcurrencyRetries; attempt++ {
+		err = s.store.ExecuteInTransaction(ctx, func(ctx context.Context) error {
+			// the store can run the action again when its transaction is retried
+			committed = nil
+
+			instance, err := s.store.Load(ctx, s.definition.name, correlationId)
+			if err != nil {
+				return err
+			}
+
+			if instance != nil && instance.Completed {
+				return nil
+			}
+
+			sagaContext, err := newContext(s.definition, correlationId, instance)
+			if err != nil {
+				return err
+			}
+
+			run, ok := resolve(sagaContext)
+			if !ok {
+				return nil
+			}
+
+			if err := run(ctx); err != nil {
+				return err
+			}
+
+			createdAt := time.Now()
+			if instance != nil {
+				createdAt = instance.CreatedAt
+			}
+
+			newInstance, err := sagaContext.toInstance(createdAt)
+			if err != nil {
+				return err
+			}
+
+			if err := s.store.Save(ctx, newInstance, sagaContext.version); err != nil {
+				return err
+			}
+
+			if err := s.publish(ctx, sagaContext); err != nil {
+				return err
+			}
+
+			committed = sagaContext
+
+			return nil
+		})
+		if !IsSagaConcurrencyConflict(err) {
+			break
+		}
+
+		s.logger.Warnf(
+			"saga '%s' with correlation id '%s' is changed concurrently, attempt %d",
+			s.definition.name,
+			correlationId,
+			attempt,
+		)
+	}
+
+	if err != nil {
+		return errors.WrapIff(
+			err,
+			"error in handling saga '%s' with correlation id '%s'",
+			s.definition.name,
+			correlationId,
+		)
+	}
+
+	if committed == nil {
+		return nil
+	}
+
+	// the timeouts are scheduled after the commit, so a rolled back or retried transaction doesn't leave a scheduled
+	// timeout behind. the message which changed the state is ignored when it is redelivered, so a failed scheduling is
+	// just logged and the saga waits for the reply of the step
+	if err := s.scheduleTimeouts(ctx, committed); err != nil {
+		s.logger.Errorf(
+			"error in scheduling the timeouts of saga '%s' with correlation id '%s' in state '%s': %v",
+			s.definition.name,
+			correlationId,
+			committed.state,
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (s *sagaCoordinator[TData]) scheduleTimeouts(ctx context.Context, sagaContext *Context[TData]) error {
+	if len(sagaContext.timeouts) == 0 || sagaContext.completed {
+		return nil
+	}
+
+	if s.scheduler == nil {
+		return errors.Errorf("saga '%s' has no timeout scheduler", s.definition.name)
+	}
+
+	for _, scheduled := range sagaContext.timeouts {
+		err := s.scheduler.Schedule(ctx, &Timeout{
+			SagaName:      s.definition.name,
+			CorrelationId: sagaContext.correlationId,
+			Name:          scheduled.name,
+			State:         sagaContext.state,
+			DueAt:         time.Now().Add(scheduled.after),
+		})
+		if err != nil {
+			return errors.WrapIff(err, "error in scheduling timeout '%s'", scheduled.name)
+		}
+	}
+
+	return nil
+}
+
+func (s *sagaCoordinator[TData]) publish(ctx context.Context, sagaContext *Context[TData]) error {
+	for _, message := range sagaContext.messages {
+		meta := metadata.Metadata{}
+		messageHeader.SetCorrelationId(meta, sagaContext.correlationId)
+
+		if err := s.producer.PublishMessage(ctx, message, meta); err != nil {
+			return errors.WrapIff(err, "error in publishing message '%s'", message.GeMessageId())
+		}
+	}
+
+	return nil
+}
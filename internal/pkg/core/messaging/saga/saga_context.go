@@ -0,0 +1,154 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+
+	"emperror.dev/errors"
+)
+
+type scheduledTimeout struct {
+	name  string
+	after time.Duration
+}
+
+// Context is the state of a saga instance while handling a message or a timeout, the sent messages are published after saving
+// the saga instance in the same transaction, and the timeouts are scheduled after its commit
+type Context[TData any] struct {
+	correlationId string
+	state         string
+	data          *TData
+	compensations []string
+	completed     bool
+	version       int64
+	messages      []types.IMessage
+	timeouts      []scheduledTimeout
+	definition    *Definition[TData]
+}
+
+func newContext[TData any](
+	definition *Definition[TData],
+	correlationId string,
+	instance *SagaInstance,
+) (*Context[TData], error) {
+	sagaContext := &Context[TData]{
+		correlationId: correlationId,
+		state:         InitialState,
+		data:          new(TData),
+		definition:    definition,
+	}
+
+	if instance == nil {
+		return sagaContext, nil
+	}
+
+	if len(instance.Data) > 0 {
+		if err := json.Unmarshal(instance.Data, sagaContext.data); err != nil {
+			return nil, errors.WrapIff(
+				err,
+				"error in deserializing the data of saga '%s' with correlation id '%s'",
+				instance.SagaName,
+				correlationId,
+			)
+		}
+	}
+
+	sagaContext.state = instance.State
+	sagaContext.compensations = append([]string(nil), instance.Compensations...)
+	sagaContext.completed = instance.Completed
+	sagaContext.version = instance.Version
+
+	return sagaContext, nil
+}
+
+func (c *Context[TData]) CorrelationId() string {
+	return c.correlationId
+}
+
+func (c *Context[TData]) State() string {
+	return c.state
+}
+
+// Data is the saga data, the changes are persisted with the saga instance
+func (c *Context[TData]) Data() *TData {
+	return c.data
+}
+
+func (c *Context[TData]) TransitionTo(state string) {
+	c.state = state
+}
+
+// Send publishes the message after saving the saga instance, the saga correlation id is set as the message correlation id
+func (c *Context[TData]) Send(message types.IMessage) {
+	c.messages = append(c.messages, message)
+}
+
+// ScheduleTimeout schedules the named timeout for the state of the saga after handling the current message
+func (c *Context[TData]) ScheduleTimeout(name string, after time.Duration) {
+	c.timeouts = append(c.timeouts, scheduledTimeout{name: name, after: after})
+}
+
+// AddCompensation registers a compensation of the definition for the completed step
+func (c *Context[TData]) AddCompensation(name string) {
+	c.compensations = append(c.compensations, name)
+}
+
+// Compensate runs the registered compensations in the reverse order of their registration
+func (c *Context[TData]) Compensate(ctx context.Context) error {
+	for i := len(c.compensations) - 1; i >= 0; i-- {
+		name := c.compensations[i]
+
+		compensation, ok := c.definition.compensations[name]
+		if !ok {
+			return errors.Errorf("compensation '%s' is not defined in saga '%s'", name, c.definition.name)
+		}
+
+		if err := compensation(ctx, c); err != nil {
+			return errors.WrapIff(err, "error in running compensation '%s' of saga '%s'", name, c.definition.name)
+		}
+	}
+
+	c.compensations = nil
+
+	return nil
+}
+
+// Complete finishes the saga, the next messages of the saga instance are ignored
+func (c *Context[TData]) Complete() {
+	c.completed = true
+}
+
+func (c *Context[TData]) IsCompleted() bool {
+	return c.completed
+}
+
+func (c *Context[TData]) isNew() bool {
+	return c.version == 0
+}
+
+func (c *Context[TData]) toInstance(createdAt time.Time) (*SagaInstance, error) {
+	data, err := json.Marshal(c.data)
+	if err != nil {
+		return nil, errors.WrapIff(
+			err,
+			"error in serializing the data of saga '%s' with correlation id '%s'",
+			c.definition.name,
+			c.correlationId,
+		)
+	}
+
+	return &SagaInstance{
+		CorrelationId: c.correlationId,
+		SagaName:      c.definition.name,
+		State:         c.state,
+		Data:          data,
+		Compensations: c.compensations,
+		Completed:     c.completed,
+		Version:       c.version + 1,
+		CreatedAt:     createdAt,
+		UpdatedAt:     time.Now(),
+	}, nil
+}
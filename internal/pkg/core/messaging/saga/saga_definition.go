@@ -0,0 +1,144 @@
+package saga
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/utils"
+
+	"emperror.dev/errors"
+)
+
+// InitialState is the state of a new saga before handling its starting message
+const InitialState = "Initial"
+
+// Handler handles a message of the saga in its current state
+type Handler[TData any] func(ctx context.Context, sagaContext *Context[TData], message types.IMessage) error
+
+// TimeoutHandler handles a timeout of the saga in its scheduled state
+type TimeoutHandler[TData any] func(ctx context.Context, sagaContext *Context[TData]) error
+
+// Compensation undoes a completed step of the saga, usually by sending a compensating message
+type Compensation[TData any] func(ctx context.Context, sagaContext *Context[TData]) error
+
+// Correlate returns the correlation id of the saga instance from a message
+type Correlate func(message types.IMessage) string
+
+// Definition is the state machine of a saga, the messages are handled by the handlers of the current saga state
+type Definition[TData any] struct {
+	name            string
+	correlations    map[reflect.Type]Correlate
+	messageTypes    []types.IMessage
+	handlers        map[string]map[reflect.Type]Handler[TData]
+	timeoutHandlers map[string]map[string]TimeoutHandler[TData]
+	compensations   map[string]Compensation[TData]
+}
+
+func NewDefinition[TData any](name string) *Definition[TData] {
+	return &Definition[TData]{
+		name:            name,
+		correlations:    make(map[reflect.Type]Correlate),
+		handlers:        make(map[string]map[reflect.Type]Handler[TData]),
+		timeoutHandlers: make(map[string]map[string]TimeoutHandler[TData]),
+		compensations:   make(map[string]Compensation[TData]),
+	}
+}
+
+func (d *Definition[TData]) Name() string {
+	return d.name
+}
+
+// Initially handles the message which starts a new saga instance
+func (d *Definition[TData]) Initially(
+	message types.IMessage,
+	correlate Correlate,
+	handler Handler[TData],
+) *Definition[TData] {
+	return d.During(InitialState, message, correlate, handler)
+}
+
+// During handles the message when the saga is in the state, the other states ignore the message
+func (d *Definition[TData]) During(
+	state string,
+	message types.IMessage,
+	correlate Correlate,
+	handler Handler[TData],
+) *Definition[TData] {
+	messageType := utils.GetMessageBaseReflectType(message)
+	if _, exists := d.correlations[messageType]; !exists {
+		d.correlations[messageType] = correlate
+		d.messageTypes = append(d.messageTypes, message)
+	}
+
+	if d.handlers[state] == nil {
+		d.handlers[state] = make(map[reflect.Type]Handler[TData])
+	}
+	d.handlers[state][messageType] = handler
+
+	return d
+}
+
+// OnTimeout handles the named timeout when the saga is still in the state which scheduled it
+func (d *Definition[TData]) OnTimeout(
+	state string,
+	name string,
+	handler TimeoutHandler[TData],
+) *Definition[TData] {
+	if d.timeoutHandlers[state] == nil {
+		d.timeoutHandlers[state] = make(map[string]TimeoutHandler[TData])
+	}
+	d.timeoutHandlers[state][name] = handler
+
+	return d
+}
+
+// Compensation registers a compensating action, it runs by `Context.Compensate` when it is added to the saga instance
+func (d *Definition[TData]) Compensation(name string, compensation Compensation[TData]) *Definition[TData] {
+	d.compensations[name] = compensation
+
+	return d
+}
+
+// MessageTypes returns the messages which are consumed by the saga
+func (d *Definition[TData]) MessageTypes() []types.IMessage {
+	return d.messageTypes
+}
+
+func (d *Definition[TData]) handler(state string, messageType reflect.Type) (Handler[TData], bool) {
+	handler, ok := d.handlers[state][messageType]
+
+	return handler, ok
+}
+
+func (d *Definition[TData]) timeoutHandler(state string, name string) (TimeoutHandler[TData], bool) {
+	handler, ok := d.timeoutHandlers[state][name]
+
+	return handler, ok
+}
+
+// Handle adapts a typed message handler to the saga handler
+func Handle[TData any, TMessage types.IMessage](
+	handler func(ctx context.Context, sagaContext *Context[TData], message TMessage) error,
+) Handler[TData] {
+	return func(ctx context.Context, sagaContext *Context[TData], message types.IMessage) error {
+		typedMessage, ok := message.(TMessage)
+		if !ok {
+			return errors.Errorf("saga message is '%T', expected '%T'", message, *new(TMessage))
+		}
+
+		return handler(ctx, sagaContext, typedMessage)
+	}
+}
+
+// CorrelateBy adapts a typed correlation func to the saga correlation
+func CorrelateBy[TMessage types.IMessage](correlate func(message TMessage) string) Correlate {
+	return func(message types.IMessage) string {
+		typedMessage, ok := message.(TMessage)
+		if !ok {
+			return ""
+		}
+
+		return correlate(typedMessage)
+	}
+}
@@ -0,0 +1,43 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// ErrSagaConcurrencyConflict is returned by the stores when the saga instance is changed by another consumer after loading it
+var ErrSagaConcurrencyConflict = errors.New("saga instance was changed concurrently")
+
+// SagaInstance is the persisted state of a saga, it is correlated by the saga name and the correlation id
+type SagaInstance struct {
+	CorrelationId string
+	SagaName      string
+	State         string
+	// Data is the json of the saga data
+	Data []byte
+	// Compensations are the names of the registered compensations, in their registration order
+	Compensations []string
+	Completed     bool
+	// Version is increased on each save and is used for the optimistic concurrency
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type SagaStore interface {
+	// Load returns the saga instance or nil if it doesn't exist
+	Load(ctx context.Context, sagaName string, correlationId string) (*SagaInstance, error)
+	// Save inserts the instance when the expected version is 0, otherwise it updates the instance when its stored version
+	// is the expected version. It returns `ErrSagaConcurrencyConflict` when the stored version is changed.
+	Save(ctx context.Context, instance *SagaInstance, expectedVersion int64) error
+	// ExecuteInTransaction runs the action in a transaction of the store, the outgoing messages of the saga are atomic with
+	// the saga instance only when the producer of the saga writes them to an outbox of the same store, the action can run
+	// again when the transaction is retried
+	ExecuteInTransaction(ctx context.Context, action func(ctx context.Context) error) error
+}
+
+func IsSagaConcurrencyConflict(err error) bool {
+	return errors.Is(err, ErrSagaConcurrencyConflict)
+}
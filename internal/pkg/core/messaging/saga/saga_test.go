@@ -0,0 +1,334 @@
+//go:build unit
+// +build unit
+
+package saga
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	messageHeader "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/messageheader"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	awaitingStock   = "AwaitingStock"
+	awaitingPayment = "AwaitingPayment"
+	failed          = "Failed"
+)
+
+func Test_Saga_Should_Start_On_Initial_Message(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemorySagaStore()
+	producer := &recordingProducer{}
+	scheduler := &recordingScheduler{}
+	s := NewSaga(newOrderDefinition(), store, producer, scheduler, empty.EmptyLogger)
+
+	require.NoError(t, s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"})))
+
+	instance, err := store.Load(ctx, "orders", "1")
+	require.NoError(t, err)
+	require.NotNil(t, instance)
+	assert.Equal(t, awaitingStock, instance.State)
+	assert.Equal(t, int64(1), instance.Version)
+	assert.JSONEq(t, `{"orderId":"1","attempts":1}`, string(instance.Data))
+
+	require.Len(t, producer.messages, 1)
+	assert.IsType(t, &ReserveStock{}, producer.messages[0])
+	assert.Equal(t, "1", producer.correlationIds[0])
+
+	require.Len(t, scheduler.timeouts, 1)
+	assert.Equal(t, "stock", scheduler.timeouts[0].Name)
+	assert.Equal(t, awaitingStock, scheduler.timeouts[0].State)
+}
+
+func Test_Saga_Should_Ignore_Messages_Which_Are_Not_Expected_In_Current_State(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemorySagaStore()
+	producer := &recordingProducer{}
+	s := NewSaga(newOrderDefinition(), store, producer, &recordingScheduler{}, empty.EmptyLogger)
+
+	// a step message without a started saga
+	require.NoError(t, s.Handle(ctx, consumeContext(&StockReserved{Message: newMessage(), OrderId: "1"})))
+	instance, err := store.Load(ctx, "orders", "1")
+	require.NoError(t, err)
+	assert.Nil(t, instance)
+
+	require.NoError(t, s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"})))
+	// the redelivered starting message
+	require.NoError(t, s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"})))
+
+	instance, err = store.Load(ctx, "orders", "1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), instance.Version)
+	assert.Len(t, producer.messages, 1)
+}
+
+func Test_Saga_Should_Run_Compensations_In_Reverse_Order(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemorySagaStore()
+	producer := &recordingProducer{}
+	s := NewSaga(newOrderDefinition(), store, producer, &recordingScheduler{}, empty.EmptyLogger)
+
+	require.NoError(t, s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"})))
+	require.NoError(t, s.Handle(ctx, consumeContext(&StockReserved{Message: newMessage(), OrderId: "1"})))
+	require.NoError(t, s.Handle(ctx, consumeContext(&PaymentFailed{Message: newMessage(), OrderId: "1"})))
+
+	instance, err := store.Load(ctx, "orders", "1")
+	require.NoError(t, err)
+	assert.Equal(t, failed, instance.State)
+	assert.True(t, instance.Completed)
+	assert.Empty(t, instance.Compensations)
+
+	require.Len(t, producer.messages, 3)
+	assert.Equal(t, "notify", producer.messages[1].(*Compensated).Name)
+	assert.Equal(t, "release-stock", producer.messages[2].(*Compensated).Name)
+
+	// a completed saga ignores the next messages
+	require.NoError(t, s.Handle(ctx, consumeContext(&StockReserved{Message: newMessage(), OrderId: "1"})))
+	assert.Len(t, producer.messages, 3)
+}
+
+func Test_Saga_Should_Handle_Timeout_Of_Current_State(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemorySagaStore()
+	producer := &recordingProducer{}
+	scheduler := &recordingScheduler{}
+	s := NewSaga(newOrderDefinition(), store, producer, scheduler, empty.EmptyLogger)
+
+	require.NoError(t, s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"})))
+	require.NoError(t, s.HandleTimeout(ctx, scheduler.timeouts[0]))
+
+	instance, err := store.Load(ctx, "orders", "1")
+	require.NoError(t, err)
+	assert.Equal(t, failed, instance.State)
+}
+
+func Test_Saga_Should_Ignore_Stale_Timeout(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemorySagaStore()
+	scheduler := &recordingScheduler{}
+	s := NewSaga(newOrderDefinition(), store, &recordingProducer{}, scheduler, empty.EmptyLogger)
+
+	require.NoError(t, s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"})))
+	require.NoError(t, s.Handle(ctx, consumeContext(&StockReserved{Message: newMessage(), OrderId: "1"})))
+
+	// the stock timeout is elapsed after reserving the stock
+	require.NoError(t, s.HandleTimeout(ctx, scheduler.timeouts[0]))
+
+	instance, err := store.Load(ctx, "orders", "1")
+	require.NoError(t, err)
+	assert.Equal(t, awaitingPayment, instance.State)
+	assert.Equal(t, int64(2), instance.Version)
+}
+
+func Test_Saga_Should_Retry_Handler_On_Concurrency_Conflict(t *testing.T) {
+	ctx := context.Background()
+	store := &conflictingStore{SagaStore: NewInMemorySagaStore(), conflicts: 1}
+	producer := &recordingProducer{}
+	scheduler := &recordingScheduler{}
+	s := NewSaga(newOrderDefinition(), store, producer, scheduler, empty.EmptyLogger)
+
+	require.NoError(t, s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"})))
+
+	instance, err := store.Load(ctx, "orders", "1")
+	require.NoError(t, err)
+	assert.Equal(t, awaitingStock, instance.State)
+	// the saga data is loaded again for the retry
+	assert.JSONEq(t, `{"orderId":"1","attempts":1}`, string(instance.Data))
+	assert.Len(t, producer.messages, 1)
+	// the timeouts are scheduled once after the commit of the retried transaction
+	assert.Len(t, scheduler.timeouts, 1)
+}
+
+func Test_Saga_Should_Not_Schedule_Timeouts_When_Transaction_Fails(t *testing.T) {
+	ctx := context.Background()
+	store := &conflictingStore{SagaStore: NewInMemorySagaStore(), conflicts: maxConcurrencyRetries}
+	scheduler := &recordingScheduler{}
+	s := NewSaga(newOrderDefinition(), store, &recordingProducer{}, scheduler, empty.EmptyLogger)
+
+	err := s.Handle(ctx, consumeContext(&OrderPlaced{Message: newMessage(), OrderId: "1"}))
+
+	assert.True(t, IsSagaConcurrencyConflict(err))
+	assert.Empty(t, scheduler.timeouts)
+}
+
+type orderData struct {
+	OrderId  string `json:"orderId"`
+	Attempts int    `json:"attempts"`
+}
+
+func newOrderDefinition() *Definition[orderData] {
+	return NewDefinition[orderData]("orders").
+		Initially(
+			&OrderPlaced{},
+			CorrelateBy(func(m *OrderPlaced) string { return m.OrderId }),
+			Handle(func(ctx context.Context, sagaContext *Context[orderData], m *OrderPlaced) error {
+				sagaContext.Data().OrderId = m.OrderId
+				sagaContext.Data().Attempts++
+				sagaContext.Send(&ReserveStock{Message: newMessage(), OrderId: m.OrderId})
+				sagaContext.TransitionTo(awaitingStock)
+				sagaContext.ScheduleTimeout("stock", time.Minute)
+
+				return nil
+			}),
+		).
+		During(
+			awaitingStock,
+			&StockReserved{},
+			CorrelateBy(func(m *StockReserved) string { return m.OrderId }),
+			Handle(func(ctx context.Context, sagaContext *Context[orderData], m *StockReserved) error {
+				sagaContext.AddCompensation("release-stock")
+				sagaContext.AddCompensation("notify")
+				sagaContext.TransitionTo(awaitingPayment)
+
+				return nil
+			}),
+		).
+		During(
+			awaitingPayment,
+			&PaymentFailed{},
+			CorrelateBy(func(m *PaymentFailed) string { return m.OrderId }),
+			Handle(func(ctx context.Context, sagaContext *Context[orderData], m *PaymentFailed) error {
+				if err := sagaContext.Compensate(ctx); err != nil {
+					return err
+				}
+				sagaContext.TransitionTo(failed)
+				sagaContext.Complete()
+
+				return nil
+			}),
+		).
+		OnTimeout(awaitingStock, "stock", func(ctx context.Context, sagaContext *Context[orderData]) error {
+			sagaContext.TransitionTo(failed)
+			sagaContext.Complete()
+
+			return nil
+		}).
+		Compensation("release-stock", func(ctx context.Context, sagaContext *Context[orderData]) error {
+			sagaContext.Send(&Compensated{Message: newMessage(), Name: "release-stock"})
+			return nil
+		}).
+		Compensation("notify", func(ctx context.Context, sagaContext *Context[orderData]) error {
+			sagaContext.Send(&Compensated{Message: newMessage(), Name: "notify"})
+			return nil
+		})
+}
+
+type OrderPlaced struct {
+	*types.Message
+	OrderId string
+}
+
+type StockReserved struct {
+	*types.Message
+	OrderId string
+}
+
+type PaymentFailed struct {
+	*types.Message
+	OrderId string
+}
+
+type ReserveStock struct {
+	*types.Message
+	OrderId string
+}
+
+type Compensated struct {
+	*types.Message
+	Name string
+}
+
+func newMessage() *types.Message {
+	return types.NewMessage(uuid.NewV4().String())
+}
+
+func consumeContext(message types.IMessage) types.MessageConsumeContext {
+	return types.NewMessageConsumeContext(
+		message,
+		metadata.Metadata{},
+		"application/json",
+		"",
+		time.Now(),
+		1,
+		message.GeMessageId(),
+		"",
+	)
+}
+
+type recordingProducer struct {
+	mu             sync.Mutex
+	messages       []types.IMessage
+	correlationIds []string
+}
+
+func (r *recordingProducer) PublishMessage(ctx context.Context, message types.IMessage, meta metadata.Metadata) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = append(r.messages, message)
+	r.correlationIds = append(r.correlationIds, messageHeader.GetCorrelationId(meta))
+
+	return nil
+}
+
+func (r *recordingProducer) PublishMessageWithTopicName(
+	ctx context.Context,
+	message types.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) error {
+	return r.PublishMessage(ctx, message, meta)
+}
+
+func (r *recordingProducer) PublishMessages(
+	ctx context.Context,
+	messages []types.IMessage,
+	meta metadata.Metadata,
+) error {
+	for _, message := range messages {
+		if err := r.PublishMessage(ctx, message, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *recordingProducer) IsProduced(func(message types.IMessage)) {
+}
+
+type recordingScheduler struct {
+	timeouts []*Timeout
+}
+
+func (r *recordingScheduler) Schedule(ctx context.Context, timeout *Timeout) error {
+	r.timeouts = append(r.timeouts, timeout)
+
+	return nil
+}
+
+// conflictingStore fails the first saves like a concurrent change of the instance
+type conflictingStore struct {
+	SagaStore
+	conflicts int
+}
+
+func (c *conflictingStore) Save(ctx context.Context, instance *SagaInstance, expectedVersion int64) error {
+	if c.conflicts > 0 {
+		c.conflicts--
+
+		return ErrSagaConcurrencyConflict
+	}
+
+	return c.SagaStore.Save(ctx, instance, expectedVersion)
+}
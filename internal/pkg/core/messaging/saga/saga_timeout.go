@@ -0,0 +1,20 @@
+package saga
+
+import (
+	"context"
+	"time"
+)
+
+// Timeout is a scheduled deadline of a saga state, it is ignored when the saga has left the state before its due time
+type Timeout struct {
+	SagaName      string    `json:"sagaName"`
+	CorrelationId string    `json:"correlationId"`
+	Name          string    `json:"name"`
+	State         string    `json:"state"`
+	DueAt         time.Time `json:"dueAt"`
+}
+
+// TimeoutScheduler delivers the timeouts back to their sagas after their due time
+type TimeoutScheduler interface {
+	Schedule(ctx context.Context, timeout *Timeout) error
+}
@@ -1,11 +1,20 @@
 package types
 
 import (
+	"context"
 	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+
+	"emperror.dev/errors"
 )
 
+// ErrNoReplyAddress is returned by `Respond` when the consumed message is not a request and has no reply address
+var ErrNoReplyAddress = errors.New("the consumed message has no reply address to respond")
+
+// Responder sends the response of a consumed request message to its reply address
+type Responder func(ctx context.Context, response IMessage) error
+
 type MessageConsumeContext interface {
 	MessageId() string
 	CorrelationId() string
@@ -15,6 +24,10 @@ type MessageConsumeContext interface {
 	DeliveryTag() uint64
 	Metadata() metadata.Metadata
 	Message() IMessage
+	// ReplyTo is the reply address of a request message, it is empty for the other messages
+	ReplyTo() string
+	// Respond sends the response of a request message to its requester with the correlation id of the request
+	Respond(ctx context.Context, response IMessage) error
 }
 
 type messageConsumeContext struct {
@@ -81,3 +94,46 @@ func (m *messageConsumeContext) Created() time.Time {
 func (m *messageConsumeContext) DeliveryTag() uint64 {
 	return m.tag
 }
+
+func (m *messageConsumeContext) ReplyTo() string {
+	return ""
+}
+
+func (m *messageConsumeContext) Respond(ctx context.Context, response IMessage) error {
+	return ErrNoReplyAddress
+}
+
+type requestConsumeContext struct {
+	MessageConsumeContext
+	replyTo   string
+	responder Responder
+}
+
+// NewRequestConsumeContext adds the reply address and the responder of a request message to its consume context
+func NewRequestConsumeContext(
+	consumeContext MessageConsumeContext,
+	replyTo string,
+	responder Responder,
+) MessageConsumeContext {
+	return &requestConsumeContext{
+		MessageConsumeContext: consumeContext,
+		replyTo:               replyTo,
+		responder:             responder,
+	}
+}
+
+func (r *requestConsumeContext) ReplyTo() string {
+	return r.replyTo
+}
+
+func (r *requestConsumeContext) Respond(ctx context.Context, response IMessage) error {
+	if response == nil {
+		return errors.New("response is nil")
+	}
+
+	if r.replyTo == "" || r.responder == nil {
+		return ErrNoReplyAddress
+	}
+
+	return r.responder(ctx, response)
+}
@@ -1,6 +1,7 @@
 package json
 
 import (
+	"encoding/json"
 	"reflect"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
@@ -14,6 +15,12 @@ type DefaultMessageJsonSerializer struct {
 	serializer serializer.Serializer
 }
 
+// messageEnvelopeData is the persisted shape of a message envelope, message payload is kept as raw json and will deserialize based on the message type
+type messageEnvelopeData struct {
+	Message json.RawMessage        `json:"message"`
+	Headers map[string]interface{} `json:"headers"`
+}
+
 func NewDefaultMessageJsonSerializer(s serializer.Serializer) serializer.MessageSerializer {
 	return &DefaultMessageJsonSerializer{serializer: s}
 }
@@ -45,8 +52,52 @@ func (m *DefaultMessageJsonSerializer) SerializeObject(
 func (m *DefaultMessageJsonSerializer) SerializeEnvelop(
 	messageEnvelop types.MessageEnvelope,
 ) (*serializer.EventSerializationResult, error) {
-	// TODO implement me
-	panic("implement me")
+	if messageEnvelop.Message == nil {
+		return nil, errors.New("message envelope doesn't have any message")
+	}
+
+	messageType := typeMapper.GetTypeName(messageEnvelop.Message)
+
+	messageData, err := m.serializer.Marshal(messageEnvelop.Message)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in Marshaling: `%s`", messageType)
+	}
+
+	data, err := m.serializer.Marshal(&messageEnvelopeData{
+		Message: messageData,
+		Headers: messageEnvelop.Headers,
+	})
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in Marshaling envelope of: `%s`", messageType)
+	}
+
+	return &serializer.EventSerializationResult{Data: data, ContentType: m.ContentType()}, nil
+}
+
+func (m *DefaultMessageJsonSerializer) DeserializeEnvelop(
+	data []byte,
+	messageType string,
+	contentType string,
+) (*types.MessageEnvelope, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	if contentType != m.ContentType() {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	envelopeData := &messageEnvelopeData{}
+	if err := m.serializer.Unmarshal(data, envelopeData); err != nil {
+		return nil, errors.WrapIff(err, "error in Unmarshaling envelope of: `%s`", messageType)
+	}
+
+	message, err := m.Deserialize(envelopeData.Message, messageType, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMessageEnvelope(message, envelopeData.Headers), nil
 }
 
 func (m *DefaultMessageJsonSerializer) Deserialize(
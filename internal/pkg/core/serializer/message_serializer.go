@@ -10,6 +10,7 @@ type MessageSerializer interface {
 	Serialize(message types.IMessage) (*EventSerializationResult, error)
 	SerializeObject(message interface{}) (*EventSerializationResult, error)
 	SerializeEnvelop(messageEnvelop types.MessageEnvelope) (*EventSerializationResult, error)
+	DeserializeEnvelop(data []byte, messageType string, contentType string) (*types.MessageEnvelope, error)
 	Deserialize(data []byte, messageType string, contentType string) (types.IMessage, error)
 	DeserializeObject(data []byte, messageType string, contentType string) (interface{}, error)
 	DeserializeType(data []byte, messageType reflect.Type, contentType string) (types.IMessage, error)
@@ -129,6 +129,66 @@ func (_c *MessageSerializer_Deserialize_Call) RunAndReturn(run func([]byte, stri
 	return _c
 }
 
+// DeserializeEnvelop provides a mock function with given fields: data, messageType, contentType
+func (_m *MessageSerializer) DeserializeEnvelop(data []byte, messageType string, contentType string) (*types.MessageEnvelope, error) {
+	ret := _m.Called(data, messageType, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeserializeEnvelop")
+	}
+
+	var r0 *types.MessageEnvelope
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte, string, string) (*types.MessageEnvelope, error)); ok {
+		return rf(data, messageType, contentType)
+	}
+	if rf, ok := ret.Get(0).(func([]byte, string, string) *types.MessageEnvelope); ok {
+		r0 = rf(data, messageType, contentType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MessageEnvelope)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte, string, string) error); ok {
+		r1 = rf(data, messageType, contentType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageSerializer_DeserializeEnvelop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeserializeEnvelop'
+type MessageSerializer_DeserializeEnvelop_Call struct {
+	*mock.Call
+}
+
+// DeserializeEnvelop is a helper method to define mock.On call
+//   - data []byte
+//   - messageType string
+//   - contentType string
+func (_e *MessageSerializer_Expecter) DeserializeEnvelop(data interface{}, messageType interface{}, contentType interface{}) *MessageSerializer_DeserializeEnvelop_Call {
+	return &MessageSerializer_DeserializeEnvelop_Call{Call: _e.mock.On("DeserializeEnvelop", data, messageType, contentType)}
+}
+
+func (_c *MessageSerializer_DeserializeEnvelop_Call) Run(run func(data []byte, messageType string, contentType string)) *MessageSerializer_DeserializeEnvelop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MessageSerializer_DeserializeEnvelop_Call) Return(_a0 *types.MessageEnvelope, _a1 error) *MessageSerializer_DeserializeEnvelop_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageSerializer_DeserializeEnvelop_Call) RunAndReturn(run func([]byte, string, string) (*types.MessageEnvelope, error)) *MessageSerializer_DeserializeEnvelop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeserializeObject provides a mock function with given fields: data, messageType, contentType
 func (_m *MessageSerializer) DeserializeObject(data []byte, messageType string, contentType string) (interface{}, error) {
 	ret := _m.Called(data, messageType, contentType)
@@ -0,0 +1,230 @@
+package elasticsearch
+
+import (
+	"strings"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	"emperror.dev/errors"
+)
+
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-bool-query.html
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/term-level-queries.html
+
+// SpecificationToQuery compiles the specification to an elasticsearch query with the term level queries, the document
+// names of the fields are used, and the keyword fields are the text fields with a `keyword` sub field which is used for
+// the exact comparisons instead of the analyzed text
+func SpecificationToQuery(
+	spec specification.Specification,
+	keywordFields ...string,
+) (map[string]interface{}, error) {
+	switch s := spec.(type) {
+	case nil:
+		return matchAll(), nil
+	case specification.AndSpecification:
+		if len(s.Specifications) == 0 {
+			return matchAll(), nil
+		}
+
+		queries, err := specificationsToQueries(s.Specifications, keywordFields)
+		if err != nil {
+			return nil, err
+		}
+
+		return boolQuery("filter", queries), nil
+	case specification.OrSpecification:
+		if len(s.Specifications) == 0 {
+			return nil, errors.New("or specification needs at least one specification")
+		}
+
+		queries, err := specificationsToQueries(s.Specifications, keywordFields)
+		if err != nil {
+			return nil, err
+		}
+
+		query := boolQuery("should", queries)
+		query["bool"].(map[string]interface{})["minimum_should_match"] = 1
+
+		return query, nil
+	case specification.NotSpecification:
+		query, err := SpecificationToQuery(s.Specification, keywordFields...)
+		if err != nil {
+			return nil, err
+		}
+
+		return boolQuery("must_not", []interface{}{query}), nil
+	case specification.FieldSpecification:
+		return fieldSpecificationToQuery(s, keywordFields)
+	default:
+		return nil, errors.Errorf("specification '%T' is not supported by elasticsearch", spec)
+	}
+}
+
+// SortsToSort converts the sorts of the list query to the elasticsearch sorts, in the order of the sorts
+func SortsToSort(sorts []listquery.Sort, keywordFields ...string) []interface{} {
+	items := make([]interface{}, 0, len(sorts))
+	for _, sort := range sorts {
+		order := "asc"
+		if sort.Descending {
+			order = "desc"
+		}
+
+		items = append(items, map[string]interface{}{
+			fieldKey(sort.Field, keywordFields): map[string]interface{}{"order": order},
+		})
+	}
+
+	return items
+}
+
+func specificationsToQueries(
+	specifications []specification.Specification,
+	keywordFields []string,
+) ([]interface{}, error) {
+	queries := make([]interface{}, 0, len(specifications))
+
+	for _, spec := range specifications {
+		query, err := SpecificationToQuery(spec, keywordFields...)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, query)
+	}
+
+	return queries, nil
+}
+
+func fieldSpecificationToQuery(
+	spec specification.FieldSpecification,
+	keywordFields []string,
+) (map[string]interface{}, error) {
+	if spec.Field.Document == "" {
+		return nil, errors.New("field specification needs a field")
+	}
+
+	key := fieldKey(spec.Field, keywordFields)
+
+	switch spec.Operator {
+	case specification.OperatorEqual, specification.OperatorContains:
+		// the term query matches the array fields which contain the value
+		return termQuery("term", key, spec.Value), nil
+	case specification.OperatorNotEqual:
+		return boolQuery("must_not", []interface{}{termQuery("term", key, spec.Value)}), nil
+	case specification.OperatorGreaterThan:
+		return rangeQuery(key, map[string]interface{}{"gt": spec.Value}), nil
+	case specification.OperatorGreaterOrEqual:
+		return rangeQuery(key, map[string]interface{}{"gte": spec.Value}), nil
+	case specification.OperatorLessThan:
+		return rangeQuery(key, map[string]interface{}{"lt": spec.Value}), nil
+	case specification.OperatorLessOrEqual:
+		return rangeQuery(key, map[string]interface{}{"lte": spec.Value}), nil
+	case specification.OperatorIn, specification.OperatorNotIn:
+		values, ok := spec.Value.([]any)
+		if !ok {
+			return nil, errors.Errorf("value of the '%s' operator of field '%s' should be a slice", spec.Operator, spec.Field)
+		}
+
+		query := termQuery("terms", key, values)
+		if spec.Operator == specification.OperatorNotIn {
+			return boolQuery("must_not", []interface{}{query}), nil
+		}
+
+		return query, nil
+	case specification.OperatorLike, specification.OperatorILike:
+		pattern, ok := spec.Value.(string)
+		if !ok {
+			return nil, errors.Errorf("value of the '%s' operator of field '%s' should be a string", spec.Operator, spec.Field)
+		}
+
+		return termQuery("wildcard", key, map[string]interface{}{
+			"value":            likeToWildcard(pattern),
+			"case_insensitive": spec.Operator == specification.OperatorILike,
+		}), nil
+	case specification.OperatorBetween:
+		valueRange, ok := spec.Value.(specification.Range)
+		if !ok {
+			return nil, errors.Errorf("value of the between operator of field '%s' should be a range", spec.Field)
+		}
+
+		return rangeQuery(key, map[string]interface{}{"gte": valueRange.From, "lte": valueRange.To}), nil
+	case specification.OperatorIsNull:
+		return boolQuery("must_not", []interface{}{existsQuery(key)}), nil
+	case specification.OperatorIsNotNull:
+		return existsQuery(key), nil
+	default:
+		return nil, errors.Errorf(
+			"operator '%s' of field '%s' is not supported by elasticsearch",
+			spec.Operator,
+			spec.Field,
+		)
+	}
+}
+
+// fieldKey returns the key of the field, the nested fields are queried with the dot notation
+func fieldKey(field specification.FieldName, keywordFields []string) string {
+	key := strings.Join(append([]string{field.Document}, field.Path...), ".")
+	for _, keywordField := range keywordFields {
+		if keywordField == key {
+			return key + ".keyword"
+		}
+	}
+
+	return key
+}
+
+// likeToWildcard converts the sql like pattern to a wildcard pattern, `%` matches any characters and `_` matches one
+// character, the characters after the `\` escape character are matched literally
+func likeToWildcard(pattern string) string {
+	var builder strings.Builder
+
+	escaped := false
+	for _, char := range pattern {
+		if escaped {
+			writeWildcardLiteral(&builder, char)
+			escaped = false
+
+			continue
+		}
+
+		switch char {
+		case '\\':
+			escaped = true
+		case '%':
+			builder.WriteRune('*')
+		case '_':
+			builder.WriteRune('?')
+		default:
+			writeWildcardLiteral(&builder, char)
+		}
+	}
+
+	return builder.String()
+}
+
+func writeWildcardLiteral(builder *strings.Builder, char rune) {
+	if char == '*' || char == '?' || char == '\\' {
+		builder.WriteRune('\\')
+	}
+	builder.WriteRune(char)
+}
+
+func matchAll() map[string]interface{} {
+	return map[string]interface{}{"match_all": map[string]interface{}{}}
+}
+
+func boolQuery(occurrence string, queries []interface{}) map[string]interface{} {
+	return map[string]interface{}{"bool": map[string]interface{}{occurrence: queries}}
+}
+
+func termQuery(query string, key string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{query: map[string]interface{}{key: value}}
+}
+
+func rangeQuery(key string, bounds map[string]interface{}) map[string]interface{} {
+	return termQuery("range", key, bounds)
+}
+
+func existsQuery(key string) map[string]interface{} {
+	return termQuery("exists", "field", key)
+}
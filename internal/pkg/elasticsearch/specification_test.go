@@ -0,0 +1,88 @@
+//go:build unit
+// +build unit
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Specification_To_Query_Should_Compile_Expression_Tree_To_Bool_Query(t *testing.T) {
+	query, err := SpecificationToQuery(specification.And(
+		specification.Equal("email", "user@test.com"),
+		specification.Or(
+			specification.GreaterThan("price", 10),
+			specification.Not(specification.LessOrEqual("price", 5)),
+		),
+		specification.IsNull("deliveredTime"),
+	), "email")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"bool": map[string]interface{}{"filter": []interface{}{
+		map[string]interface{}{"term": map[string]interface{}{"email.keyword": "user@test.com"}},
+		map[string]interface{}{"bool": map[string]interface{}{
+			"should": []interface{}{
+				map[string]interface{}{"range": map[string]interface{}{"price": map[string]interface{}{"gt": 10}}},
+				map[string]interface{}{"bool": map[string]interface{}{"must_not": []interface{}{
+					map[string]interface{}{"range": map[string]interface{}{"price": map[string]interface{}{"lte": 5}}},
+				}}},
+			},
+			"minimum_should_match": 1,
+		}},
+		map[string]interface{}{"bool": map[string]interface{}{"must_not": []interface{}{
+			map[string]interface{}{"exists": map[string]interface{}{"field": "deliveredTime"}},
+		}}},
+	}}}, query)
+}
+
+func Test_Specification_To_Query_Should_Compile_Operators(t *testing.T) {
+	query, err := SpecificationToQuery(specification.And(
+		specification.NotEqual("paid", true),
+		specification.In("status", "paid", "shipped"),
+		specification.ILike("email", "%"+specification.EscapeLike("user_1*")+"%"),
+		specification.Between("price", 10, 20),
+	), "email")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"bool": map[string]interface{}{"filter": []interface{}{
+		map[string]interface{}{"bool": map[string]interface{}{"must_not": []interface{}{
+			map[string]interface{}{"term": map[string]interface{}{"paid": true}},
+		}}},
+		map[string]interface{}{"terms": map[string]interface{}{"status": []any{"paid", "shipped"}}},
+		map[string]interface{}{"wildcard": map[string]interface{}{"email.keyword": map[string]interface{}{
+			"value":            `*user_1\**`,
+			"case_insensitive": true,
+		}}},
+		map[string]interface{}{"range": map[string]interface{}{"price": map[string]interface{}{"gte": 10, "lte": 20}}},
+	}}}, query)
+}
+
+func Test_Specification_To_Query_Should_Match_All_For_Empty_And(t *testing.T) {
+	query, err := SpecificationToQuery(specification.And())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"match_all": map[string]interface{}{}}, query)
+}
+
+func Test_Specification_To_Query_Should_Return_Error_For_Unsupported_Operator(t *testing.T) {
+	_, err := SpecificationToQuery(specification.FieldSpecification{Field: specification.NewFieldName("name"), Operator: "unknown"})
+	assert.ErrorContains(t, err, "operator 'unknown' of field 'name' is not supported by elasticsearch")
+}
+
+func Test_Sorts_To_Sort_Should_Convert_Sorts_To_Elasticsearch_Sorts(t *testing.T) {
+	sort := SortsToSort([]listquery.Sort{
+		{Field: specification.NewFieldName("email")},
+		{Field: specification.NewFieldName("createdAt"), Descending: true},
+	}, "email")
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"email.keyword": map[string]interface{}{"order": "asc"}},
+		map[string]interface{}{"createdAt": map[string]interface{}{"order": "desc"}},
+	}, sort)
+}
@@ -0,0 +1,32 @@
+package projection
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ParkedEvent is an event which a projection failed to process after all the retries, it is kept for inspecting and fixing the projection
+type ParkedEvent struct {
+	Id             uuid.UUID
+	ProjectionName string
+	EventId        uuid.UUID
+	EventType      string
+	AggregateId    uuid.UUID
+	Version        int64
+	Position       int64
+	// Data is the json of the event
+	Data     []byte
+	Error    string
+	Attempts int
+	ParkedAt time.Time
+}
+
+// IParkedEventStore is the dead-letter store of the projections
+type IParkedEventStore interface {
+	Park(ctx context.Context, parkedEvent *ParkedEvent) error
+	// GetParkedEvents gets the parked events of a projection, all parked events are returned for an empty projection name
+	GetParkedEvents(ctx context.Context, projectionName string) ([]*ParkedEvent, error)
+	Remove(ctx context.Context, id uuid.UUID) error
+}
@@ -9,3 +9,10 @@ import (
 type IProjection interface {
 	ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error
 }
+
+// ITransactionalProjection projections which just write to the store of the checkpoint repository with the ctx, their writes
+// are committed with their checkpoint in a transaction. the other projections (e.g. elasticsearch or publishing messages) run
+// outside of the transaction, because a retried transaction would repeat their side effects
+type ITransactionalProjection interface {
+	IsTransactional() bool
+}
@@ -0,0 +1,28 @@
+package projection
+
+import (
+	"context"
+)
+
+// INamedProjection projections with a name could be selected by their names for the rebuilds, otherwise their type names are used
+type INamedProjection interface {
+	ProjectionName() string
+}
+
+// IResettableProjection projections which could clear their read models before rebuilding them from the scratch
+type IResettableProjection interface {
+	Reset(ctx context.Context) error
+}
+
+type replayContextKey struct{}
+
+// WithReplay marks the context of the events which are replayed for rebuilding the projections
+func WithReplay(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replayContextKey{}, true)
+}
+
+// IsReplay checks the event is replayed for rebuilding the projections, the side effects like publishing the integration events should be skipped for them
+func IsReplay(ctx context.Context) bool {
+	replay, _ := ctx.Value(replayContextKey{}).(bool)
+	return replay
+}
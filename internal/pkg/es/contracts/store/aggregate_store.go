@@ -36,4 +36,7 @@ type AggregateStore[T models.IHaveEventSourcedAggregate] interface {
 
 	// Exists check aggregate exists by AggregateId.
 	Exists(ctx context.Context, aggregateId uuid.UUID) (bool, error)
+
+	// TakeSnapshot stores a snapshot of the aggregate committed state on demand, the aggregate should implement `models.IHaveSnapshot`.
+	TakeSnapshot(aggregate T, ctx context.Context) error
 }
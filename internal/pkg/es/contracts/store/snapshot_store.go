@@ -0,0 +1,23 @@
+package store
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
+)
+
+// SnapshotStore is responsible for loading and saving the aggregates snapshots.
+type SnapshotStore interface {
+	// Load loads the latest snapshot of an aggregate stream, it returns nil if there is no snapshot for the stream.
+	Load(streamName streamName.StreamName, ctx context.Context) (*models.Snapshot, error)
+
+	// Save stores a new snapshot for an aggregate stream.
+	Save(streamName streamName.StreamName, snapshot *models.Snapshot, ctx context.Context) error
+}
+
+// SnapshotStrategy decides when the aggregate store should take a snapshot of an aggregate.
+type SnapshotStrategy interface {
+	// ShouldTakeSnapshot checks the aggregate version before and after storing its new events.
+	ShouldTakeSnapshot(previousVersion int64, currentVersion int64) bool
+}
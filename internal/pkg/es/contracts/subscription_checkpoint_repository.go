@@ -6,3 +6,11 @@ type SubscriptionCheckpointRepository interface {
 	Load(subscriptionId string, ctx context.Context) (uint64, error)
 	Store(subscriptionId string, position uint64, ctx context.Context) error
 }
+
+// TransactionalSubscriptionCheckpointRepository is a checkpoint repository that stores the checkpoint in the same transaction (or session) as the writes of a
+// `projection.ITransactionalProjection`, so the read model and its checkpoint can't drift apart after a crash
+type TransactionalSubscriptionCheckpointRepository interface {
+	SubscriptionCheckpointRepository
+	// ExecuteInTransaction runs the action in a transaction, the transaction is available on the action context for the projections and the `Store` call
+	ExecuteInTransaction(ctx context.Context, action func(ctx context.Context) error) error
+}
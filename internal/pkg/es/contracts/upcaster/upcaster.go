@@ -0,0 +1,48 @@
+package upcaster
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+)
+
+// https://event-driven.io/en/simple_events_versioning_patterns/
+// https://leanpub.com/esversioning/read#leanpub-auto-upcasting
+
+// EventVersionMetadataKey is the metadata key of the stored events schema version, the events without this key have the version 1
+const EventVersionMetadataKey = "event_version"
+
+// UpcastingEvent is a stored event before deserializing to its go type
+type UpcastingEvent struct {
+	// EventType is the stored short type name of the event, like `*OrderCreatedV1`
+	EventType string
+	// Version is the schema version of the payload
+	Version  int
+	Payload  map[string]interface{}
+	Metadata metadata.Metadata
+}
+
+// Upcaster transforms an old stored event to its newer shape before reaching the aggregates and the projections.
+type Upcaster interface {
+	// EventType Gets the event type name which this upcaster handles.
+	EventType() string
+
+	// Version Gets the event schema version which this upcaster handles.
+	Version() int
+
+	// Upcast Transforms the event to the next version or to a new event type, the result should have a higher version or another event type.
+	Upcast(event *UpcastingEvent) (*UpcastingEvent, error)
+}
+
+// UpcasterRegistry keeps the upcasters by their event type and version and chains them for upcasting the stored events.
+type UpcasterRegistry interface {
+	// Register Registers upcasters, there should be one upcaster for an event type and version.
+	Register(upcasters ...Upcaster) error
+
+	// HasUpcasters Checks if there is any upcaster for the event type.
+	HasUpcasters(eventType string) bool
+
+	// CurrentVersion Gets the schema version of the new events of the event type, it is the version after the last registered upcaster.
+	CurrentVersion(eventType string) int
+
+	// Upcast Applies the upcasters chain on the event until there is no upcaster for its event type and version.
+	Upcast(event *UpcastingEvent) (*UpcastingEvent, error)
+}
@@ -0,0 +1,48 @@
+package errors
+
+import (
+	"fmt"
+
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+
+	"emperror.dev/errors"
+)
+
+type wrongExpectedVersionError struct {
+	customErrors.ConflictError
+}
+
+type WrongExpectedVersionError interface {
+	customErrors.ConflictError
+	IsWrongExpectedVersionError() bool
+}
+
+func NewWrongExpectedVersionError(streamId string, expectedVersion int64, actualVersion int64) error {
+	conflict := customErrors.NewConflictError(
+		fmt.Sprintf(
+			"wrong expected version for stream %s, expected version is %d but actual version is %d",
+			streamId,
+			expectedVersion,
+			actualVersion,
+		),
+	)
+	customErr := customErrors.GetCustomError(conflict)
+	br := &wrongExpectedVersionError{
+		ConflictError: customErr.(customErrors.ConflictError),
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *wrongExpectedVersionError) IsWrongExpectedVersionError() bool {
+	return true
+}
+
+func IsWrongExpectedVersionError(err error) bool {
+	var we WrongExpectedVersionError
+	if errors.As(err, &we) {
+		return we.IsWrongExpectedVersionError()
+	}
+
+	return false
+}
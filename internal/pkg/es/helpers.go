@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/upcaster"
 
 	"go.uber.org/fx"
 )
@@ -15,3 +16,11 @@ func AsProjection(handler interface{}) interface{} {
 		fx.ResultTags(fmt.Sprintf(`group:"projections"`)),
 	)
 }
+
+func AsUpcaster(handler interface{}) interface{} {
+	return fx.Annotate(
+		handler,
+		fx.As(new(upcaster.Upcaster)),
+		fx.ResultTags(fmt.Sprintf(`group:"upcasters"`)),
+	)
+}
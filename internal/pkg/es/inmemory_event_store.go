@@ -0,0 +1,401 @@
+package es
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	esErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/errors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	appendResult "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/append_result"
+	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
+	readPosition "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_position/read_position"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_position/truncatePosition"
+	expectedStreamVersion "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_version"
+)
+
+// SubscriptionHandler handles the events of a subscription to all, returning an error stops the subscription
+type SubscriptionHandler func(ctx context.Context, streamEvent *models.StreamEvent) error
+
+// InMemoryEventStore is an EventStore which keeps the streams in memory with the same semantics as the EventStoreDB,
+// it is useful for the fast tests without a running EventStoreDB.
+type InMemoryEventStore interface {
+	store.EventStore
+
+	// SubscribeAll Subscribes to the events of all streams with the given prefixes after the global position,
+	// `0` is the start of the `$all` stream. It blocks until the context is done or the handler returns an error.
+	SubscribeAll(
+		ctx context.Context,
+		fromPosition uint64,
+		prefixes []string,
+		handler SubscriptionHandler,
+	) error
+}
+
+type inMemoryStream struct {
+	events []*models.StreamEvent
+	// truncateBefore hides the events with a lower version, like the `$tb` metadata of EventStoreDB
+	truncateBefore int64
+	// metadataVersion is the version of the stream metadata, it is used for the expected version of `TruncateStream`
+	metadataVersion int64
+	deleted         bool
+}
+
+type inMemoryRecordedEvent struct {
+	streamName  string
+	streamEvent *models.StreamEvent
+}
+
+// https://developers.eventstore.com/server/v21.10/streams.html#deleting-streams-and-events
+type inMemoryEventStore struct {
+	streams map[string]*inMemoryStream
+	// all is the `$all` stream, the global position of an event is its index plus one
+	all []*inMemoryRecordedEvent
+	// appended is closed and replaced on every append for waking up the subscriptions
+	appended chan struct{}
+	mu       sync.RWMutex
+}
+
+func NewInMemoryEventStore() InMemoryEventStore {
+	return &inMemoryEventStore{
+		streams:  make(map[string]*inMemoryStream),
+		appended: make(chan struct{}),
+	}
+}
+
+func (i *inMemoryEventStore) StreamExists(
+	streamName streamName.StreamName,
+	ctx context.Context,
+) (bool, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	stream, ok := i.streams[streamName.String()]
+
+	return ok && !stream.deleted, nil
+}
+
+func (i *inMemoryEventStore) ReadEventsFromStart(
+	streamName streamName.StreamName,
+	count uint64,
+	ctx context.Context,
+) ([]*models.StreamEvent, error) {
+	return i.ReadEvents(streamName, readPosition.Start, count, ctx)
+}
+
+func (i *inMemoryEventStore) ReadEvents(
+	streamName streamName.StreamName,
+	readPosition readPosition.StreamReadPosition,
+	count uint64,
+	ctx context.Context,
+) ([]*models.StreamEvent, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	stream, err := i.getStream(streamName)
+	if err != nil {
+		return nil, err
+	}
+
+	// reading forward from the end of the stream returns nothing
+	if readPosition.IsEnd() {
+		return []*models.StreamEvent{}, nil
+	}
+
+	from := max(readPosition.Value(), stream.truncateBefore)
+
+	events := make([]*models.StreamEvent, 0)
+	for _, event := range stream.events {
+		if uint64(len(events)) >= count {
+			break
+		}
+		if event.Version >= from {
+			events = append(events, copyStreamEvent(event))
+		}
+	}
+
+	return events, nil
+}
+
+func (i *inMemoryEventStore) ReadEventsWithMaxCount(
+	streamName streamName.StreamName,
+	readPosition readPosition.StreamReadPosition,
+	ctx context.Context,
+) ([]*models.StreamEvent, error) {
+	return i.ReadEvents(streamName, readPosition, uint64(math.MaxUint64), ctx)
+}
+
+func (i *inMemoryEventStore) ReadEventsBackwards(
+	streamName streamName.StreamName,
+	readPosition readPosition.StreamReadPosition,
+	count uint64,
+	ctx context.Context,
+) ([]*models.StreamEvent, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	stream, err := i.getStream(streamName)
+	if err != nil {
+		return nil, err
+	}
+
+	from := readPosition.Value()
+	if readPosition.IsEnd() {
+		from = math.MaxInt64
+	}
+
+	events := make([]*models.StreamEvent, 0)
+	for index := len(stream.events) - 1; index >= 0; index-- {
+		event := stream.events[index]
+		if uint64(len(events)) >= count || event.Version < stream.truncateBefore {
+			break
+		}
+		if event.Version <= from {
+			events = append(events, copyStreamEvent(event))
+		}
+	}
+
+	return events, nil
+}
+
+func (i *inMemoryEventStore) ReadEventsBackwardsWithMaxCount(
+	streamName streamName.StreamName,
+	readPosition readPosition.StreamReadPosition,
+	ctx context.Context,
+) ([]*models.StreamEvent, error) {
+	return i.ReadEventsBackwards(streamName, readPosition, uint64(math.MaxUint64), ctx)
+}
+
+func (i *inMemoryEventStore) ReadEventsBackwardsFromEnd(
+	streamName streamName.StreamName,
+	count uint64,
+	ctx context.Context,
+) ([]*models.StreamEvent, error) {
+	return i.ReadEventsBackwards(streamName, readPosition.End, count, ctx)
+}
+
+func (i *inMemoryEventStore) AppendNewEvents(
+	streamName streamName.StreamName,
+	events []*models.StreamEvent,
+	ctx context.Context,
+) (*appendResult.AppendEventsResult, error) {
+	return i.AppendEvents(streamName, expectedStreamVersion.NoStream, events, ctx)
+}
+
+func (i *inMemoryEventStore) AppendEvents(
+	streamName streamName.StreamName,
+	expectedVersion expectedStreamVersion.ExpectedStreamVersion,
+	events []*models.StreamEvent,
+	ctx context.Context,
+) (*appendResult.AppendEventsResult, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	stream, ok := i.streams[streamName.String()]
+	if !ok {
+		stream = &inMemoryStream{metadataVersion: expectedStreamVersion.NoStream.Value()}
+	}
+
+	err := checkExpectedVersion(streamName, expectedVersion, stream, ok && !stream.deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return appendResult.NoOp, nil
+	}
+
+	// appending to a deleted stream recreates it, the versions continue from the deleted events
+	stream.deleted = false
+	i.streams[streamName.String()] = stream
+
+	for _, event := range events {
+		recorded := copyStreamEvent(event)
+		recorded.Version = stream.lastVersion() + 1
+		recorded.Position = int64(len(i.all) + 1)
+
+		stream.events = append(stream.events, recorded)
+		i.all = append(i.all, &inMemoryRecordedEvent{streamName: streamName.String(), streamEvent: recorded})
+	}
+
+	i.notifySubscriptions()
+
+	return appendResult.From(uint64(len(i.all)), uint64(stream.lastVersion())), nil
+}
+
+// TruncateStream hides the events before the truncate position, the expected version belongs to the stream metadata like EventStoreDB
+func (i *inMemoryEventStore) TruncateStream(
+	streamName streamName.StreamName,
+	truncatePosition truncatePosition.StreamTruncatePosition,
+	expectedVersion expectedStreamVersion.ExpectedStreamVersion,
+	ctx context.Context,
+) (*appendResult.AppendEventsResult, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	stream, ok := i.streams[streamName.String()]
+	if !ok {
+		stream = &inMemoryStream{metadataVersion: expectedStreamVersion.NoStream.Value()}
+		i.streams[streamName.String()] = stream
+	}
+
+	if !expectedVersion.IsAny() && !metadataVersionMatches(expectedVersion, stream.metadataVersion) {
+		return nil, esErrors.NewWrongExpectedVersionError(
+			streamName.String(),
+			expectedVersion.Value(),
+			stream.metadataVersion,
+		)
+	}
+
+	stream.truncateBefore = max(stream.truncateBefore, truncatePosition.Value())
+	stream.metadataVersion++
+
+	return appendResult.From(uint64(len(i.all)), uint64(stream.metadataVersion)), nil
+}
+
+// DeleteStream soft deletes the stream, the stream could be recreated by appending new events with `NoStream` or `Any` expected version
+func (i *inMemoryEventStore) DeleteStream(
+	streamName streamName.StreamName,
+	expectedVersion expectedStreamVersion.ExpectedStreamVersion,
+	ctx context.Context,
+) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	stream, ok := i.streams[streamName.String()]
+	if !ok || stream.deleted {
+		return esErrors.NewStreamNotFoundError(streamName.String())
+	}
+
+	err := checkExpectedVersion(streamName, expectedVersion, stream, true)
+	if err != nil {
+		return err
+	}
+
+	stream.deleted = true
+	stream.truncateBefore = stream.lastVersion() + 1
+
+	return nil
+}
+
+func (i *inMemoryEventStore) SubscribeAll(
+	ctx context.Context,
+	fromPosition uint64,
+	prefixes []string,
+	handler SubscriptionHandler,
+) error {
+	position := fromPosition
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		i.mu.RLock()
+		var events []*inMemoryRecordedEvent
+		if position < uint64(len(i.all)) {
+			events = i.all[position:]
+		}
+		appended := i.appended
+		i.mu.RUnlock()
+
+		for _, event := range events {
+			position = uint64(event.streamEvent.Position)
+			if !hasAnyPrefix(event.streamName, prefixes) {
+				continue
+			}
+
+			err := handler(ctx, copyStreamEvent(event.streamEvent))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(events) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-appended:
+		}
+	}
+}
+
+func (i *inMemoryEventStore) getStream(streamName streamName.StreamName) (*inMemoryStream, error) {
+	stream, ok := i.streams[streamName.String()]
+	if !ok || stream.deleted {
+		return nil, esErrors.NewStreamNotFoundError(streamName.String())
+	}
+
+	return stream, nil
+}
+
+func (i *inMemoryEventStore) notifySubscriptions() {
+	close(i.appended)
+	i.appended = make(chan struct{})
+}
+
+func (s *inMemoryStream) lastVersion() int64 {
+	if len(s.events) == 0 {
+		return expectedStreamVersion.NoStream.Value()
+	}
+
+	return s.events[len(s.events)-1].Version
+}
+
+func checkExpectedVersion(
+	streamName streamName.StreamName,
+	expectedVersion expectedStreamVersion.ExpectedStreamVersion,
+	stream *inMemoryStream,
+	exists bool,
+) error {
+	actualVersion := expectedStreamVersion.NoStream.Value()
+	if exists {
+		actualVersion = stream.lastVersion()
+	}
+
+	switch {
+	case expectedVersion.IsAny():
+		return nil
+	case expectedVersion.IsNoStream() && !exists:
+		return nil
+	case expectedVersion.IsStreamExists() && exists:
+		return nil
+	case exists && expectedVersion.Value() >= 0 && expectedVersion.Value() == actualVersion:
+		return nil
+	}
+
+	return esErrors.NewWrongExpectedVersionError(streamName.String(), expectedVersion.Value(), actualVersion)
+}
+
+func metadataVersionMatches(expectedVersion expectedStreamVersion.ExpectedStreamVersion, metadataVersion int64) bool {
+	if expectedVersion.IsStreamExists() {
+		return metadataVersion != expectedStreamVersion.NoStream.Value()
+	}
+
+	return expectedVersion.Value() == metadataVersion
+}
+
+func hasAnyPrefix(streamName string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(streamName, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyStreamEvent(streamEvent *models.StreamEvent) *models.StreamEvent {
+	event := *streamEvent
+
+	return &event
+}
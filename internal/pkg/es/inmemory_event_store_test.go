@@ -0,0 +1,193 @@
+//go:build unit
+// +build unit
+
+package es
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	esErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/errors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
+	readPosition "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_position/read_position"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_position/truncatePosition"
+	expectedStreamVersion "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_version"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InMemory_Event_Store_Append_With_Expected_Version(t *testing.T) {
+	ctx := context.Background()
+	eventStore := NewInMemoryEventStore()
+	stream := newTestStreamName()
+
+	result, err := eventStore.AppendEvents(stream, expectedStreamVersion.NoStream, newTestStreamEvents(2), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), result.NextExpectedVersion)
+	assert.Equal(t, uint64(2), result.GlobalPosition)
+
+	// stream exists already
+	_, err = eventStore.AppendEvents(stream, expectedStreamVersion.NoStream, newTestStreamEvents(1), ctx)
+	assert.True(t, esErrors.IsWrongExpectedVersionError(err))
+
+	// stale version
+	_, err = eventStore.AppendEvents(stream, expectedStreamVersion.FromInt64(0), newTestStreamEvents(1), ctx)
+	assert.True(t, esErrors.IsWrongExpectedVersionError(err))
+
+	result, err = eventStore.AppendEvents(stream, expectedStreamVersion.FromInt64(1), newTestStreamEvents(1), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), result.NextExpectedVersion)
+
+	result, err = eventStore.AppendEvents(stream, expectedStreamVersion.Any, newTestStreamEvents(1), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), result.NextExpectedVersion)
+
+	_, err = eventStore.AppendEvents(
+		newTestStreamName(),
+		expectedStreamVersion.StreamExists,
+		newTestStreamEvents(1),
+		ctx,
+	)
+	assert.True(t, esErrors.IsWrongExpectedVersionError(err))
+}
+
+func Test_InMemory_Event_Store_Read_Events(t *testing.T) {
+	ctx := context.Background()
+	eventStore := NewInMemoryEventStore()
+	stream := newTestStreamName()
+
+	_, err := eventStore.AppendNewEvents(stream, newTestStreamEvents(5), ctx)
+	require.NoError(t, err)
+
+	events, err := eventStore.ReadEvents(stream, readPosition.FromInt64(1), 3, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, versionsOf(events))
+
+	events, err = eventStore.ReadEventsBackwardsFromEnd(stream, 2, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 3}, versionsOf(events))
+
+	events, err = eventStore.ReadEventsBackwardsWithMaxCount(stream, readPosition.FromInt64(2), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 1, 0}, versionsOf(events))
+
+	_, err = eventStore.ReadEventsFromStart(newTestStreamName(), 10, ctx)
+	assert.True(t, esErrors.IsStreamNotFoundError(err))
+}
+
+func Test_InMemory_Event_Store_Truncate_Stream(t *testing.T) {
+	ctx := context.Background()
+	eventStore := NewInMemoryEventStore()
+	stream := newTestStreamName()
+
+	_, err := eventStore.AppendNewEvents(stream, newTestStreamEvents(4), ctx)
+	require.NoError(t, err)
+
+	_, err = eventStore.TruncateStream(stream, truncatePosition.FromInt64(2), expectedStreamVersion.NoStream, ctx)
+	require.NoError(t, err)
+
+	events, err := eventStore.ReadEventsWithMaxCount(stream, readPosition.Start, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, versionsOf(events))
+
+	events, err = eventStore.ReadEventsBackwardsWithMaxCount(stream, readPosition.End, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, versionsOf(events))
+
+	// the expected version of truncate belongs to the stream metadata
+	_, err = eventStore.TruncateStream(stream, truncatePosition.FromInt64(3), expectedStreamVersion.NoStream, ctx)
+	assert.True(t, esErrors.IsWrongExpectedVersionError(err))
+}
+
+func Test_InMemory_Event_Store_Delete_Stream(t *testing.T) {
+	ctx := context.Background()
+	eventStore := NewInMemoryEventStore()
+	stream := newTestStreamName()
+
+	_, err := eventStore.AppendNewEvents(stream, newTestStreamEvents(2), ctx)
+	require.NoError(t, err)
+
+	err = eventStore.DeleteStream(stream, expectedStreamVersion.FromInt64(0), ctx)
+	assert.True(t, esErrors.IsWrongExpectedVersionError(err))
+
+	err = eventStore.DeleteStream(stream, expectedStreamVersion.FromInt64(1), ctx)
+	require.NoError(t, err)
+
+	exists, err := eventStore.StreamExists(stream, ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = eventStore.ReadEventsFromStart(stream, 10, ctx)
+	assert.True(t, esErrors.IsStreamNotFoundError(err))
+
+	// a deleted stream is recreated with the next versions
+	_, err = eventStore.AppendNewEvents(stream, newTestStreamEvents(1), ctx)
+	require.NoError(t, err)
+
+	events, err := eventStore.ReadEventsFromStart(stream, 10, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, versionsOf(events))
+}
+
+func Test_InMemory_Event_Store_Subscribe_All(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	eventStore := NewInMemoryEventStore()
+	orderStream := streamName.StreamName("order-" + uuid.NewV4().String())
+
+	_, err := eventStore.AppendNewEvents(orderStream, newTestStreamEvents(2), ctx)
+	require.NoError(t, err)
+	_, err = eventStore.AppendNewEvents(newTestStreamName(), newTestStreamEvents(1), ctx)
+	require.NoError(t, err)
+
+	received := make(chan *models.StreamEvent)
+	subscriptionErr := make(chan error, 1)
+	go func() {
+		subscriptionErr <- eventStore.SubscribeAll(
+			ctx,
+			1,
+			[]string{"order-"},
+			func(ctx context.Context, streamEvent *models.StreamEvent) error {
+				received <- streamEvent
+				return nil
+			},
+		)
+	}()
+
+	// events after the subscription start should also be received
+	_, err = eventStore.AppendEvents(orderStream, expectedStreamVersion.FromInt64(1), newTestStreamEvents(1), ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), (<-received).Position)
+	assert.Equal(t, int64(4), (<-received).Position)
+
+	cancel()
+	assert.ErrorIs(t, <-subscriptionErr, context.Canceled)
+}
+
+func newTestStreamName() streamName.StreamName {
+	return streamName.StreamName("test-" + uuid.NewV4().String())
+}
+
+func newTestStreamEvents(count int) []*models.StreamEvent {
+	var events []*models.StreamEvent
+	for i := 0; i < count; i++ {
+		events = append(events, &models.StreamEvent{EventID: uuid.NewV4()})
+	}
+
+	return events
+}
+
+func versionsOf(events []*models.StreamEvent) []int64 {
+	var versions []int64
+	for _, event := range events {
+		versions = append(versions, event.Version)
+	}
+
+	return versions
+}
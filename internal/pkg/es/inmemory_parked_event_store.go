@@ -0,0 +1,59 @@
+package es
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type inMemoryParkedEventStore struct {
+	parkedEvents []*projection.ParkedEvent
+	mu           sync.RWMutex
+}
+
+func NewInMemoryParkedEventStore() projection.IParkedEventStore {
+	return &inMemoryParkedEventStore{}
+}
+
+func (i *inMemoryParkedEventStore) Park(ctx context.Context, parkedEvent *projection.ParkedEvent) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.parkedEvents = append(i.parkedEvents, parkedEvent)
+
+	return nil
+}
+
+func (i *inMemoryParkedEventStore) GetParkedEvents(
+	ctx context.Context,
+	projectionName string,
+) ([]*projection.ParkedEvent, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var parkedEvents []*projection.ParkedEvent
+	for _, parkedEvent := range i.parkedEvents {
+		if projectionName == "" || parkedEvent.ProjectionName == projectionName {
+			parkedEvents = append(parkedEvents, parkedEvent)
+		}
+	}
+
+	return parkedEvents, nil
+}
+
+func (i *inMemoryParkedEventStore) Remove(ctx context.Context, id uuid.UUID) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for index, parkedEvent := range i.parkedEvents {
+		if uuid.Equal(parkedEvent.Id, id) {
+			i.parkedEvents = append(i.parkedEvents[:index], i.parkedEvents[index+1:]...)
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package es
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
+)
+
+type inMemorySnapshotStore struct {
+	snapshots map[string]*models.Snapshot
+	mu        sync.RWMutex
+}
+
+func NewInMemorySnapshotStore() store.SnapshotStore {
+	return &inMemorySnapshotStore{snapshots: make(map[string]*models.Snapshot)}
+}
+
+func (i *inMemorySnapshotStore) Load(
+	streamName streamName.StreamName,
+	ctx context.Context,
+) (*models.Snapshot, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.snapshots[streamName.String()], nil
+}
+
+func (i *inMemorySnapshotStore) Save(
+	streamName streamName.StreamName,
+	snapshot *models.Snapshot,
+	ctx context.Context,
+) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	// we just keep the latest snapshot of a stream like the snapshot streams max count
+	i.snapshots[streamName.String()] = snapshot
+
+	return nil
+}
@@ -2,31 +2,36 @@ package es
 
 import (
 	"context"
+	"sync"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
 )
 
 type inMemorySubscriptionCheckpointRepository struct {
 	checkpoints map[string]uint64
+	mu          sync.RWMutex
 }
 
 func NewInMemorySubscriptionCheckpointRepository() contracts.SubscriptionCheckpointRepository {
 	return &inMemorySubscriptionCheckpointRepository{checkpoints: make(map[string]uint64)}
 }
 
-func (i inMemorySubscriptionCheckpointRepository) Load(subscriptionId string, ctx context.Context) (uint64, error) {
-	checkpoint := i.checkpoints[subscriptionId]
-	if checkpoint == 0 {
-		return 0, nil
-	}
-	return checkpoint, nil
+func (i *inMemorySubscriptionCheckpointRepository) Load(subscriptionId string, ctx context.Context) (uint64, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.checkpoints[subscriptionId], nil
 }
 
-func (i inMemorySubscriptionCheckpointRepository) Store(
+func (i *inMemorySubscriptionCheckpointRepository) Store(
 	subscriptionId string,
 	position uint64,
 	ctx context.Context,
 ) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
 	i.checkpoints[subscriptionId] = position
+
 	return nil
 }
@@ -106,7 +106,9 @@ func (_m *AggregateStore[T]) Load(ctx context.Context, aggregateId uuid.UUID) (T
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) T); ok {
 		r0 = rf(ctx, aggregateId)
 	} else {
-		r0 = ret.Get(0).(T)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(T)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
@@ -163,7 +165,9 @@ func (_m *AggregateStore[T]) LoadWithReadPosition(ctx context.Context, aggregate
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, readPosition.StreamReadPosition) T); ok {
 		r0 = rf(ctx, aggregateId, position)
 	} else {
-		r0 = ret.Get(0).(T)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(T)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, readPosition.StreamReadPosition) error); ok {
@@ -326,6 +330,53 @@ func (_c *AggregateStore_StoreWithVersion_Call[T]) RunAndReturn(run func(T, meta
 	return _c
 }
 
+// TakeSnapshot provides a mock function with given fields: aggregate, ctx
+func (_m *AggregateStore[T]) TakeSnapshot(aggregate T, ctx context.Context) error {
+	ret := _m.Called(aggregate, ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeSnapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(T, context.Context) error); ok {
+		r0 = rf(aggregate, ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AggregateStore_TakeSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeSnapshot'
+type AggregateStore_TakeSnapshot_Call[T models.IHaveEventSourcedAggregate] struct {
+	*mock.Call
+}
+
+// TakeSnapshot is a helper method to define mock.On call
+//   - aggregate T
+//   - ctx context.Context
+func (_e *AggregateStore_Expecter[T]) TakeSnapshot(aggregate interface{}, ctx interface{}) *AggregateStore_TakeSnapshot_Call[T] {
+	return &AggregateStore_TakeSnapshot_Call[T]{Call: _e.mock.On("TakeSnapshot", aggregate, ctx)}
+}
+
+func (_c *AggregateStore_TakeSnapshot_Call[T]) Run(run func(aggregate T, ctx context.Context)) *AggregateStore_TakeSnapshot_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(T), args[1].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AggregateStore_TakeSnapshot_Call[T]) Return(_a0 error) *AggregateStore_TakeSnapshot_Call[T] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AggregateStore_TakeSnapshot_Call[T]) RunAndReturn(run func(T, context.Context) error) *AggregateStore_TakeSnapshot_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAggregateStore creates a new instance of AggregateStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAggregateStore[T models.IHaveEventSourcedAggregate](t interface {
@@ -0,0 +1,79 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// SnapshotStrategy is an autogenerated mock type for the SnapshotStrategy type
+type SnapshotStrategy struct {
+	mock.Mock
+}
+
+type SnapshotStrategy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SnapshotStrategy) EXPECT() *SnapshotStrategy_Expecter {
+	return &SnapshotStrategy_Expecter{mock: &_m.Mock}
+}
+
+// ShouldTakeSnapshot provides a mock function with given fields: previousVersion, currentVersion
+func (_m *SnapshotStrategy) ShouldTakeSnapshot(previousVersion int64, currentVersion int64) bool {
+	ret := _m.Called(previousVersion, currentVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShouldTakeSnapshot")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int64, int64) bool); ok {
+		r0 = rf(previousVersion, currentVersion)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// SnapshotStrategy_ShouldTakeSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldTakeSnapshot'
+type SnapshotStrategy_ShouldTakeSnapshot_Call struct {
+	*mock.Call
+}
+
+// ShouldTakeSnapshot is a helper method to define mock.On call
+//   - previousVersion int64
+//   - currentVersion int64
+func (_e *SnapshotStrategy_Expecter) ShouldTakeSnapshot(previousVersion interface{}, currentVersion interface{}) *SnapshotStrategy_ShouldTakeSnapshot_Call {
+	return &SnapshotStrategy_ShouldTakeSnapshot_Call{Call: _e.mock.On("ShouldTakeSnapshot", previousVersion, currentVersion)}
+}
+
+func (_c *SnapshotStrategy_ShouldTakeSnapshot_Call) Run(run func(previousVersion int64, currentVersion int64)) *SnapshotStrategy_ShouldTakeSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *SnapshotStrategy_ShouldTakeSnapshot_Call) Return(_a0 bool) *SnapshotStrategy_ShouldTakeSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotStrategy_ShouldTakeSnapshot_Call) RunAndReturn(run func(int64, int64) bool) *SnapshotStrategy_ShouldTakeSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSnapshotStrategy creates a new instance of SnapshotStrategy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSnapshotStrategy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SnapshotStrategy {
+	mock := &SnapshotStrategy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// https://www.eventstore.com/blog/snapshots-in-event-sourcing
+
+// IHaveSnapshot this interface should implement by the aggregates which support snapshotting
+type IHaveSnapshot interface {
+	IHaveEventSourcedAggregate
+
+	// SnapshotSchemaVersion Gets the schema version of the snapshot state. It should increase with changing the snapshot state structure,
+	// so the stale snapshots will be discarded and the aggregate will be rebuilt from its events.
+	SnapshotSchemaVersion() int
+
+	// CreateSnapshot Gets the current state of the aggregate for storing in a snapshot.
+	CreateSnapshot() (interface{}, error)
+
+	// RestoreSnapshot Restores the aggregate state from a stored snapshot state.
+	RestoreSnapshot(state interface{}) error
+
+	// RestoreVersion Restores the original and the current version of the aggregate from the snapshot version.
+	RestoreVersion(version int64)
+}
+
+// Snapshot the state of an aggregate at a specific version
+type Snapshot struct {
+	AggregateId   uuid.UUID
+	AggregateType string
+	// Version is the aggregate version of the snapshot, events after this version should replay on the snapshot state
+	Version int64
+	// SchemaVersion is the schema version of the snapshot state
+	SchemaVersion int
+	State         interface{}
+	CreatedAt     time.Time
+}
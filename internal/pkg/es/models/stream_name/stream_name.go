@@ -48,3 +48,9 @@ func ForID[T models.IHaveEventSourcedAggregate](aggregateID uuid.UUID) StreamNam
 
 	return StreamName(fmt.Sprintf("%s-%s", strings.ToLower(aggregateName), aggregateID.String()))
 }
+
+// SnapshotFor gets the side stream name for storing the snapshots of an aggregate stream,
+// it doesn't start with the aggregate stream prefix, so it is not delivered to the aggregate stream subscriptions
+func SnapshotFor(streamName StreamName) StreamName {
+	return StreamName(fmt.Sprintf("snapshot-%s", streamName.String()))
+}
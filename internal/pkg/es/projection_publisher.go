@@ -2,36 +2,248 @@ package es
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	defaultLogger "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/defaultlogger"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 
 	"emperror.dev/errors"
+	"github.com/avast/retry-go"
+	uuid "github.com/satori/go.uuid"
 )
 
+type ProjectionPublisherOptions struct {
+	SubscriptionId string
+	// CheckpointRepository stores the checkpoint of each projection, the events before the checkpoint of a projection are skipped for it.
+	// a transactional repository runs each attempt of a `projection.ITransactionalProjection` in its own transaction with its checkpoint
+	CheckpointRepository contracts.SubscriptionCheckpointRepository
+	// ParkedEventStore keeps the events which a projection failed to process after all the retries, an in-memory store is used when it is nil
+	ParkedEventStore projection.IParkedEventStore
+	// RetryAttempts is the number of processing attempts of an event by a projection before parking it
+	RetryAttempts uint
+	// RetryDelay is the first retry delay, next delays grow exponentially up to MaxRetryDelay
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+	Logger        logger.Logger
+}
+
+// projectionPublisher publishes the events to each projection independently, a failing projection doesn't block the other projections
+// and its poison events are parked after the retries
 type projectionPublisher struct {
 	projections []projection.IProjection
+	options     *ProjectionPublisherOptions
 }
 
 func NewProjectionPublisher(projections []projection.IProjection) projection.IProjectionPublisher {
-	return &projectionPublisher{projections: projections}
+	return NewProjectionPublisherWithOptions(projections, &ProjectionPublisherOptions{})
 }
 
-func (p projectionPublisher) Publish(ctx context.Context, streamEvent *models.StreamEvent) error {
-	if streamEvent == nil {
-		return nil
+func NewProjectionPublisherWithOptions(
+	projections []projection.IProjection,
+	options *ProjectionPublisherOptions,
+) projection.IProjectionPublisher {
+	publisherOptions := *options
+	if publisherOptions.RetryAttempts == 0 {
+		publisherOptions.RetryAttempts = 1
+	}
+	if publisherOptions.ParkedEventStore == nil {
+		publisherOptions.ParkedEventStore = NewInMemoryParkedEventStore()
 	}
+	if publisherOptions.Logger == nil {
+		publisherOptions.Logger = defaultLogger.GetLogger()
+	}
+
+	return &projectionPublisher{projections: projections, options: &publisherOptions}
+}
 
-	if p.projections == nil {
+// GetProjectionName gets the name of a projection for its checkpoint, parked events and selecting it in the rebuilds
+func GetProjectionName(p projection.IProjection) string {
+	if named, ok := p.(projection.INamedProjection); ok {
+		return named.ProjectionName()
+	}
+
+	return strings.TrimPrefix(typeMapper.GetTypeName(p), "*")
+}
+
+// ProjectionCheckpointId is the id of a projection checkpoint in the subscription checkpoint repository
+func ProjectionCheckpointId(subscriptionId string, projectionName string) string {
+	return fmt.Sprintf("%s-%s", subscriptionId, projectionName)
+}
+
+func (p *projectionPublisher) Publish(ctx context.Context, streamEvent *models.StreamEvent) error {
+	if streamEvent == nil {
 		return nil
 	}
 
 	for _, pj := range p.projections {
-		err := pj.ProcessEvent(ctx, streamEvent)
+		err := p.publishToProjection(ctx, pj, streamEvent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *projectionPublisher) publishToProjection(
+	ctx context.Context,
+	pj projection.IProjection,
+	streamEvent *models.StreamEvent,
+) error {
+	projectionName := GetProjectionName(pj)
+	checkpointId := ProjectionCheckpointId(p.options.SubscriptionId, projectionName)
+	position := uint64(streamEvent.Position)
+
+	if p.options.CheckpointRepository != nil {
+		checkpoint, err := p.options.CheckpointRepository.Load(checkpointId, ctx)
+		if err != nil {
+			return errors.WrapIff(err, "failed to load checkpoint of projection '%s'", projectionName)
+		}
+
+		// the event is processed already by the projection
+		if checkpoint > 0 && position <= checkpoint {
+			return nil
+		}
+	}
+
+	// each attempt of the projection runs in its own transaction with its checkpoint, so a failed statement of a projection
+	// doesn't abort the writes of the other projections, and the failed transaction is not used for parking the event
+	checkpointed := false
+	var attempts int
+	err := retry.Do(
+		func() error {
+			attempts++
+
+			var err error
+			checkpointed, err = p.processEvent(ctx, pj, projectionName, checkpointId, streamEvent)
+
+			return err
+		},
+		retry.Attempts(p.options.RetryAttempts),
+		retry.Delay(p.options.RetryDelay),
+		retry.MaxDelay(p.options.MaxRetryDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.LastErrorOnly(true),
+		retry.Context(ctx),
+	)
+	if err != nil {
+		if ctx.Err() != nil {
+			return errors.WrapIff(ctx.Err(), "processing event in projection '%s' is canceled", projectionName)
+		}
+
+		err = p.park(ctx, projectionName, streamEvent, err, attempts)
 		if err != nil {
-			return errors.WrapIf(err, "error in processing projection")
+			return err
 		}
 	}
 
+	if checkpointed {
+		return nil
+	}
+
+	return p.storeCheckpoint(ctx, projectionName, checkpointId, position)
+}
+
+// processEvent runs an attempt of the projection, the event and the checkpoint of a transactional projection are written in a
+// transaction of the attempt when the checkpoint repository is transactional, it reports whether the checkpoint is stored
+func (p *projectionPublisher) processEvent(
+	ctx context.Context,
+	pj projection.IProjection,
+	projectionName string,
+	checkpointId string,
+	streamEvent *models.StreamEvent,
+) (bool, error) {
+	txRepository, ok := p.options.CheckpointRepository.(contracts.TransactionalSubscriptionCheckpointRepository)
+	if !ok || !isTransactional(pj) {
+		return false, pj.ProcessEvent(ctx, streamEvent)
+	}
+
+	err := txRepository.ExecuteInTransaction(ctx, func(ctx context.Context) error {
+		if err := pj.ProcessEvent(ctx, streamEvent); err != nil {
+			return err
+		}
+
+		return p.storeCheckpoint(ctx, projectionName, checkpointId, uint64(streamEvent.Position))
+	})
+
+	return err == nil, err
+}
+
+func isTransactional(pj projection.IProjection) bool {
+	transactional, ok := pj.(projection.ITransactionalProjection)
+
+	return ok && transactional.IsTransactional()
+}
+
+func (p *projectionPublisher) storeCheckpoint(
+	ctx context.Context,
+	projectionName string,
+	checkpointId string,
+	position uint64,
+) error {
+	if p.options.CheckpointRepository == nil {
+		return nil
+	}
+
+	err := p.options.CheckpointRepository.Store(checkpointId, position, ctx)
+	if err != nil {
+		return errors.WrapIff(err, "failed to store checkpoint of projection '%s'", projectionName)
+	}
+
+	return nil
+}
+
+func (p *projectionPublisher) park(
+	ctx context.Context,
+	projectionName string,
+	streamEvent *models.StreamEvent,
+	processErr error,
+	attempts int,
+) error {
+	parkedEvent := &projection.ParkedEvent{
+		Id:             uuid.NewV4(),
+		ProjectionName: projectionName,
+		EventId:        streamEvent.EventID,
+		Version:        streamEvent.Version,
+		Position:       streamEvent.Position,
+		Error:          processErr.Error(),
+		Attempts:       attempts,
+		ParkedAt:       time.Now(),
+	}
+
+	if streamEvent.Event != nil {
+		parkedEvent.EventType = typeMapper.GetTypeName(streamEvent.Event)
+		parkedEvent.AggregateId = streamEvent.Event.GetAggregateId()
+
+		data, err := json.Marshal(streamEvent.Event)
+		if err == nil {
+			parkedEvent.Data = data
+		}
+	}
+
+	p.options.Logger.Errorw(
+		fmt.Sprintf(
+			"event '%s' at position %d parked for projection '%s' after %d attempts: %v",
+			parkedEvent.EventType,
+			streamEvent.Position,
+			projectionName,
+			attempts,
+			processErr,
+		),
+		logger.Fields{"EventId": streamEvent.EventID, "Projection": projectionName, "Position": streamEvent.Position},
+	)
+
+	err := p.options.ParkedEventStore.Park(ctx, parkedEvent)
+	if err != nil {
+		return errors.WrapIff(err, "failed to park event for projection '%s'", projectionName)
+	}
+
 	return nil
 }
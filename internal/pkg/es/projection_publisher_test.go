@@ -0,0 +1,235 @@
+//go:build unit
+// +build unit
+
+package es
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Projection_Publisher_Should_Park_Failed_Event_And_Continue_Other_Projections(t *testing.T) {
+	ctx := context.Background()
+	parkedEventStore := NewInMemoryParkedEventStore()
+	checkpointRepository := NewInMemorySubscriptionCheckpointRepository()
+
+	failing := &testProjection{name: "elastic", failures: 10}
+	healthy := &testProjection{name: "mongo"}
+
+	publisher := NewProjectionPublisherWithOptions(
+		[]projection.IProjection{failing, healthy},
+		&ProjectionPublisherOptions{
+			SubscriptionId:       "orders",
+			CheckpointRepository: checkpointRepository,
+			ParkedEventStore:     parkedEventStore,
+			RetryAttempts:        3,
+			Logger:               empty.EmptyLogger,
+		},
+	)
+
+	streamEvent := &models.StreamEvent{EventID: uuid.NewV4(), Position: 5}
+	err := publisher.Publish(ctx, streamEvent)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, failing.calls)
+	assert.Equal(t, 1, healthy.calls)
+
+	parkedEvents, err := parkedEventStore.GetParkedEvents(ctx, "elastic")
+	require.NoError(t, err)
+	require.Len(t, parkedEvents, 1)
+	assert.Equal(t, streamEvent.EventID, parkedEvents[0].EventId)
+	assert.Equal(t, 3, parkedEvents[0].Attempts)
+	assert.Equal(t, int64(5), parkedEvents[0].Position)
+
+	// the parked event is not retried by the projection
+	checkpoint, err := checkpointRepository.Load(ProjectionCheckpointId("orders", "elastic"), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), checkpoint)
+}
+
+func Test_Projection_Publisher_Should_Retry_Transient_Errors(t *testing.T) {
+	ctx := context.Background()
+	parkedEventStore := NewInMemoryParkedEventStore()
+
+	pj := &testProjection{name: "elastic", failures: 2}
+	publisher := NewProjectionPublisherWithOptions(
+		[]projection.IProjection{pj},
+		&ProjectionPublisherOptions{ParkedEventStore: parkedEventStore, RetryAttempts: 3, Logger: empty.EmptyLogger},
+	)
+
+	err := publisher.Publish(ctx, &models.StreamEvent{EventID: uuid.NewV4(), Position: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, pj.calls)
+	parkedEvents, err := parkedEventStore.GetParkedEvents(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, parkedEvents)
+}
+
+func Test_Projection_Publisher_Should_Skip_Events_Before_Projection_Checkpoint(t *testing.T) {
+	ctx := context.Background()
+	checkpointRepository := NewInMemorySubscriptionCheckpointRepository()
+	require.NoError(t, checkpointRepository.Store(ProjectionCheckpointId("orders", "mongo"), 10, ctx))
+
+	mongoProjection := &testProjection{name: "mongo"}
+	elasticProjection := &testProjection{name: "elastic"}
+	publisher := NewProjectionPublisherWithOptions(
+		[]projection.IProjection{mongoProjection, elasticProjection},
+		&ProjectionPublisherOptions{
+			SubscriptionId:       "orders",
+			CheckpointRepository: checkpointRepository,
+			Logger:               empty.EmptyLogger,
+		},
+	)
+
+	require.NoError(t, publisher.Publish(ctx, &models.StreamEvent{EventID: uuid.NewV4(), Position: 8}))
+	require.NoError(t, publisher.Publish(ctx, &models.StreamEvent{EventID: uuid.NewV4(), Position: 12}))
+
+	assert.Equal(t, 1, mongoProjection.calls)
+	assert.Equal(t, 2, elasticProjection.calls)
+}
+
+func Test_Projection_Publisher_Should_Process_Each_Projection_In_Its_Own_Transaction(t *testing.T) {
+	ctx := context.Background()
+	parkedEventStore := NewInMemoryParkedEventStore()
+	checkpointRepository := newTransactionalCheckpointRepository()
+
+	failing := &testProjection{name: "postgres", failures: 10, transactional: true}
+	healthy := &testProjection{name: "mongo", transactional: true}
+
+	publisher := NewProjectionPublisherWithOptions(
+		[]projection.IProjection{failing, healthy},
+		&ProjectionPublisherOptions{
+			SubscriptionId:       "orders",
+			CheckpointRepository: checkpointRepository,
+			ParkedEventStore:     parkedEventStore,
+			RetryAttempts:        2,
+			Logger:               empty.EmptyLogger,
+		},
+	)
+
+	require.NoError(t, publisher.Publish(ctx, &models.StreamEvent{EventID: uuid.NewV4(), Position: 7}))
+
+	// the failed transactions of the postgres projection don't abort the transaction of the mongo projection
+	assert.Equal(t, 2, checkpointRepository.rolledBack)
+	assert.Equal(t, 1, healthy.calls)
+	assert.True(t, healthy.inTransaction)
+
+	parkedEvents, err := parkedEventStore.GetParkedEvents(ctx, "postgres")
+	require.NoError(t, err)
+	require.Len(t, parkedEvents, 1)
+
+	for _, name := range []string{"postgres", "mongo"} {
+		checkpoint, err := checkpointRepository.Load(ProjectionCheckpointId("orders", name), ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), checkpoint)
+	}
+}
+
+func Test_Projection_Publisher_Should_Process_Non_Transactional_Projection_Outside_Of_Transaction(t *testing.T) {
+	ctx := context.Background()
+	checkpointRepository := newTransactionalCheckpointRepository()
+	elasticProjection := &testProjection{name: "elastic"}
+
+	publisher := NewProjectionPublisherWithOptions(
+		[]projection.IProjection{elasticProjection},
+		&ProjectionPublisherOptions{
+			SubscriptionId:       "orders",
+			CheckpointRepository: checkpointRepository,
+			Logger:               empty.EmptyLogger,
+		},
+	)
+
+	require.NoError(t, publisher.Publish(ctx, &models.StreamEvent{EventID: uuid.NewV4(), Position: 3}))
+
+	assert.Equal(t, 1, elasticProjection.calls)
+	assert.False(t, elasticProjection.inTransaction)
+
+	checkpoint, err := checkpointRepository.Load(ProjectionCheckpointId("orders", "elastic"), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), checkpoint)
+}
+
+type testTransaction struct {
+	aborted bool
+}
+
+type testTransactionKey struct{}
+
+// transactionalCheckpointRepository fails the writes of an aborted transaction like postgres
+type transactionalCheckpointRepository struct {
+	checkpoints map[string]uint64
+	rolledBack  int
+}
+
+func newTransactionalCheckpointRepository() *transactionalCheckpointRepository {
+	return &transactionalCheckpointRepository{checkpoints: make(map[string]uint64)}
+}
+
+func (r *transactionalCheckpointRepository) Load(subscriptionId string, ctx context.Context) (uint64, error) {
+	return r.checkpoints[subscriptionId], nil
+}
+
+func (r *transactionalCheckpointRepository) Store(subscriptionId string, position uint64, ctx context.Context) error {
+	if tx, ok := ctx.Value(testTransactionKey{}).(*testTransaction); ok && tx.aborted {
+		return errors.New("current transaction is aborted")
+	}
+
+	r.checkpoints[subscriptionId] = position
+
+	return nil
+}
+
+func (r *transactionalCheckpointRepository) ExecuteInTransaction(
+	ctx context.Context,
+	action func(ctx context.Context) error,
+) error {
+	if _, ok := ctx.Value(testTransactionKey{}).(*testTransaction); ok {
+		return action(ctx)
+	}
+
+	tx := &testTransaction{}
+	if err := action(context.WithValue(ctx, testTransactionKey{}, tx)); err != nil {
+		tx.aborted = true
+		r.rolledBack++
+
+		return err
+	}
+
+	return nil
+}
+
+type testProjection struct {
+	name          string
+	failures      int
+	calls         int
+	transactional bool
+	inTransaction bool
+}
+
+func (t *testProjection) IsTransactional() bool {
+	return t.transactional
+}
+
+func (t *testProjection) ProjectionName() string {
+	return t.name
+}
+
+func (t *testProjection) ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	t.calls++
+	_, t.inTransaction = ctx.Value(testTransactionKey{}).(*testTransaction)
+	if t.calls <= t.failures {
+		return errors.New("projection failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package es
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+)
+
+type everyNEventsSnapshotStrategy struct {
+	frequency int64
+}
+
+// NewEveryNEventsSnapshotStrategy takes a snapshot whenever the aggregate stream passes a multiple of `frequency` events
+func NewEveryNEventsSnapshotStrategy(frequency int64) store.SnapshotStrategy {
+	return &everyNEventsSnapshotStrategy{frequency: frequency}
+}
+
+func (s *everyNEventsSnapshotStrategy) ShouldTakeSnapshot(previousVersion int64, currentVersion int64) bool {
+	if s.frequency <= 0 {
+		return false
+	}
+
+	// versions are zero-based, so the events count of a stream is its version plus one
+	return (currentVersion+1)/s.frequency > (previousVersion+1)/s.frequency
+}
+
+type onDemandSnapshotStrategy struct{}
+
+// NewOnDemandSnapshotStrategy never takes a snapshot automatically, snapshots are only taken with `AggregateStore.TakeSnapshot`
+func NewOnDemandSnapshotStrategy() store.SnapshotStrategy {
+	return &onDemandSnapshotStrategy{}
+}
+
+func (s *onDemandSnapshotStrategy) ShouldTakeSnapshot(previousVersion int64, currentVersion int64) bool {
+	return false
+}
@@ -0,0 +1,34 @@
+//go:build unit
+// +build unit
+
+package es
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Every_N_Events_Snapshot_Strategy(t *testing.T) {
+	strategy := NewEveryNEventsSnapshotStrategy(3)
+
+	// new stream with 2 events
+	assert.False(t, strategy.ShouldTakeSnapshot(-1, 1))
+	// reaching the third event
+	assert.True(t, strategy.ShouldTakeSnapshot(1, 2))
+	// passing the sixth event in a batch
+	assert.True(t, strategy.ShouldTakeSnapshot(3, 6))
+	assert.False(t, strategy.ShouldTakeSnapshot(5, 6))
+}
+
+func Test_Every_N_Events_Snapshot_Strategy_With_Invalid_Frequency(t *testing.T) {
+	strategy := NewEveryNEventsSnapshotStrategy(0)
+
+	assert.False(t, strategy.ShouldTakeSnapshot(-1, 100))
+}
+
+func Test_On_Demand_Snapshot_Strategy(t *testing.T) {
+	strategy := NewOnDemandSnapshotStrategy()
+
+	assert.False(t, strategy.ShouldTakeSnapshot(-1, 100))
+}
@@ -0,0 +1,233 @@
+package es
+
+import (
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/upcaster"
+
+	"emperror.dev/errors"
+)
+
+type upcasterKey struct {
+	eventType string
+	version   int
+}
+
+type upcasterRegistry struct {
+	upcasters map[upcasterKey]upcaster.Upcaster
+	// currentVersions keeps the version after the last upcaster of every event type
+	currentVersions map[string]int
+	mu              sync.RWMutex
+}
+
+func NewUpcasterRegistry(upcasters ...upcaster.Upcaster) (upcaster.UpcasterRegistry, error) {
+	registry := &upcasterRegistry{
+		upcasters:       make(map[upcasterKey]upcaster.Upcaster),
+		currentVersions: make(map[string]int),
+	}
+
+	err := registry.Register(upcasters...)
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+func (r *upcasterRegistry) Register(upcasters ...upcaster.Upcaster) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range upcasters {
+		if u.Version() < 1 {
+			return errors.Errorf(
+				"upcaster version for event type `%s` should be greater than zero, but it is %d",
+				u.EventType(),
+				u.Version(),
+			)
+		}
+
+		key := upcasterKey{eventType: u.EventType(), version: u.Version()}
+		if _, exists := r.upcasters[key]; exists {
+			return errors.Errorf(
+				"upcaster for event type `%s` and version %d is already registered",
+				u.EventType(),
+				u.Version(),
+			)
+		}
+
+		r.upcasters[key] = u
+		r.currentVersions[u.EventType()] = max(r.currentVersions[u.EventType()], u.Version()+1)
+	}
+
+	return nil
+}
+
+func (r *upcasterRegistry) HasUpcasters(eventType string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.currentVersions[eventType]
+
+	return exists
+}
+
+func (r *upcasterRegistry) CurrentVersion(eventType string) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if version, exists := r.currentVersions[eventType]; exists {
+		return version
+	}
+
+	return 1
+}
+
+func (r *upcasterRegistry) Upcast(event *upcaster.UpcastingEvent) (*upcaster.UpcastingEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	visited := make(map[upcasterKey]bool)
+
+	for {
+		key := upcasterKey{eventType: event.EventType, version: event.Version}
+
+		u, exists := r.upcasters[key]
+		if !exists {
+			return event, nil
+		}
+
+		// a chain which returns to an upcasted version never ends
+		if visited[key] {
+			return nil, errors.Errorf(
+				"upcasters chain of event type `%s` has a cycle in version %d",
+				event.EventType,
+				event.Version,
+			)
+		}
+		visited[key] = true
+
+		upcasted, err := u.Upcast(event)
+		if err != nil {
+			return nil, errors.WrapIff(
+				err,
+				"error in upcasting event type `%s` with version %d",
+				event.EventType,
+				event.Version,
+			)
+		}
+
+		if upcasted.EventType == event.EventType && upcasted.Version <= event.Version {
+			return nil, errors.Errorf(
+				"upcaster of event type `%s` with version %d should increase the version",
+				event.EventType,
+				event.Version,
+			)
+		}
+
+		if upcasted.Metadata == nil {
+			upcasted.Metadata = metadata.Metadata{}
+		}
+		upcasted.Metadata.Set(upcaster.EventVersionMetadataKey, upcasted.Version)
+
+		event = upcasted
+	}
+}
+
+// GetEventVersion gets the schema version of a stored event from its metadata
+func GetEventVersion(meta metadata.Metadata) int {
+	if meta == nil {
+		return 1
+	}
+
+	switch version := meta.Get(upcaster.EventVersionMetadataKey).(type) {
+	case int:
+		return version
+	case int64:
+		return int(version)
+	case float64:
+		// json numbers are deserialized to float64 in the metadata
+		return int(version)
+	default:
+		return 1
+	}
+}
+
+type funcUpcaster struct {
+	eventType string
+	version   int
+	upcast    func(event *upcaster.UpcastingEvent) (*upcaster.UpcastingEvent, error)
+}
+
+func (f *funcUpcaster) EventType() string {
+	return f.eventType
+}
+
+func (f *funcUpcaster) Version() int {
+	return f.version
+}
+
+func (f *funcUpcaster) Upcast(event *upcaster.UpcastingEvent) (*upcaster.UpcastingEvent, error) {
+	return f.upcast(event)
+}
+
+// NewPayloadUpcaster creates an upcaster which transforms the payload of an event type from the version to the next version
+func NewPayloadUpcaster(
+	eventType string,
+	version int,
+	transform func(payload map[string]interface{}) (map[string]interface{}, error),
+) upcaster.Upcaster {
+	return &funcUpcaster{
+		eventType: eventType,
+		version:   version,
+		upcast: func(event *upcaster.UpcastingEvent) (*upcaster.UpcastingEvent, error) {
+			payload, err := transform(event.Payload)
+			if err != nil {
+				return nil, err
+			}
+
+			return &upcaster.UpcastingEvent{
+				EventType: event.EventType,
+				Version:   event.Version + 1,
+				Payload:   payload,
+				Metadata:  event.Metadata,
+			}, nil
+		},
+	}
+}
+
+// NewEventTypeUpcaster creates an upcaster which maps a version of an event type to the first version of a new event type,
+// like `*OrderCreatedV1` to `*OrderCreatedV2`, the payload transform is optional.
+func NewEventTypeUpcaster(
+	fromEventType string,
+	version int,
+	toEventType string,
+	transform func(payload map[string]interface{}) (map[string]interface{}, error),
+) upcaster.Upcaster {
+	return &funcUpcaster{
+		eventType: fromEventType,
+		version:   version,
+		upcast: func(event *upcaster.UpcastingEvent) (*upcaster.UpcastingEvent, error) {
+			if toEventType == fromEventType {
+				return nil, errors.Errorf("event type upcaster should map `%s` to another event type", fromEventType)
+			}
+
+			payload := event.Payload
+			if transform != nil {
+				var err error
+				payload, err = transform(event.Payload)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return &upcaster.UpcastingEvent{
+				EventType: toEventType,
+				Version:   1,
+				Payload:   payload,
+				Metadata:  event.Metadata,
+			}, nil
+		},
+	}
+}
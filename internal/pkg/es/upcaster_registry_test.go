@@ -0,0 +1,99 @@
+//go:build unit
+// +build unit
+
+package es
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/upcaster"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Upcaster_Registry_Should_Chain_Upcasters(t *testing.T) {
+	registry, err := NewUpcasterRegistry(
+		NewEventTypeUpcaster(
+			"*OrderCreatedV1",
+			1,
+			"*OrderCreatedV2",
+			func(payload map[string]interface{}) (map[string]interface{}, error) {
+				payload["deliveryAddress"] = payload["address"]
+				delete(payload, "address")
+				return payload, nil
+			},
+		),
+		NewPayloadUpcaster("*OrderCreatedV2", 1, func(payload map[string]interface{}) (map[string]interface{}, error) {
+			payload["currency"] = "USD"
+			return payload, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	event, err := registry.Upcast(&upcaster.UpcastingEvent{
+		EventType: "*OrderCreatedV1",
+		Version:   1,
+		Payload:   map[string]interface{}{"address": "street"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "*OrderCreatedV2", event.EventType)
+	assert.Equal(t, 2, event.Version)
+	assert.Equal(t, map[string]interface{}{"deliveryAddress": "street", "currency": "USD"}, event.Payload)
+	assert.Equal(t, 2, GetEventVersion(event.Metadata))
+}
+
+func Test_Upcaster_Registry_Should_Skip_Current_Version(t *testing.T) {
+	registry, err := NewUpcasterRegistry(
+		NewPayloadUpcaster("*OrderCreatedV2", 1, func(payload map[string]interface{}) (map[string]interface{}, error) {
+			payload["currency"] = "USD"
+			return payload, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	assert.True(t, registry.HasUpcasters("*OrderCreatedV2"))
+	assert.Equal(t, 2, registry.CurrentVersion("*OrderCreatedV2"))
+	assert.Equal(t, 1, registry.CurrentVersion("*OrderUpdatedV1"))
+
+	event, err := registry.Upcast(&upcaster.UpcastingEvent{
+		EventType: "*OrderCreatedV2",
+		Version:   2,
+		Payload:   map[string]interface{}{"currency": "EUR"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "EUR", event.Payload["currency"])
+}
+
+func Test_Upcaster_Registry_Should_Reject_Duplicate_Upcasters(t *testing.T) {
+	identity := func(payload map[string]interface{}) (map[string]interface{}, error) {
+		return payload, nil
+	}
+
+	_, err := NewUpcasterRegistry(
+		NewPayloadUpcaster("*OrderCreatedV1", 1, identity),
+		NewPayloadUpcaster("*OrderCreatedV1", 1, identity),
+	)
+	assert.Error(t, err)
+}
+
+func Test_Upcaster_Registry_Should_Detect_Cycles(t *testing.T) {
+	registry, err := NewUpcasterRegistry(
+		NewEventTypeUpcaster("*OrderCreatedV1", 1, "*OrderCreatedV2", nil),
+		NewEventTypeUpcaster("*OrderCreatedV2", 1, "*OrderCreatedV1", nil),
+	)
+	require.NoError(t, err)
+
+	_, err = registry.Upcast(&upcaster.UpcastingEvent{EventType: "*OrderCreatedV1", Version: 1})
+	assert.Error(t, err)
+}
+
+func Test_Get_Event_Version(t *testing.T) {
+	assert.Equal(t, 1, GetEventVersion(nil))
+	assert.Equal(t, 1, GetEventVersion(metadata.Metadata{}))
+	// json numbers
+	assert.Equal(t, 3, GetEventVersion(metadata.Metadata{upcaster.EventVersionMetadataKey: float64(3)}))
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	esErrors2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/errors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
 	appendResult "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/append_result"
 	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
 	readPosition "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_position/read_position"
 	expectedStreamVersion "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_version"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/config"
 	esErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/errors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/attribute"
@@ -28,10 +32,12 @@ import (
 )
 
 type esdbAggregateStore[T models.IHaveEventSourcedAggregate] struct {
-	log        logger.Logger
-	eventStore store.EventStore
-	serializer *EsdbSerializer
-	tracer     trace.Tracer
+	log              logger.Logger
+	eventStore       store.EventStore
+	serializer       *EsdbSerializer
+	tracer           trace.Tracer
+	snapshotStore    store.SnapshotStore
+	snapshotStrategy store.SnapshotStrategy
 }
 
 func NewEventStoreAggregateStore[T models.IHaveEventSourcedAggregate](
@@ -48,6 +54,50 @@ func NewEventStoreAggregateStore[T models.IHaveEventSourcedAggregate](
 	}
 }
 
+// NewEventStoreAggregateStoreWithSnapshot creates an aggregate store which loads the aggregates from their latest snapshot,
+// the snapshots are taken every `Snapshot.Frequency` events when `Snapshot.Enabled` is true, and on demand with `TakeSnapshot`.
+func NewEventStoreAggregateStoreWithSnapshot[T models.IHaveSnapshot](
+	log logger.Logger,
+	eventStore store.EventStore,
+	serializer *EsdbSerializer,
+	snapshotStore store.SnapshotStore,
+	cfg *config.EventStoreDbOptions,
+	tracer trace.Tracer,
+) store.AggregateStore[T] {
+	snapshotStrategy := es.NewOnDemandSnapshotStrategy()
+	if cfg.Snapshot.Enabled {
+		snapshotStrategy = es.NewEveryNEventsSnapshotStrategy(cfg.Snapshot.Frequency)
+	}
+
+	return NewEventStoreAggregateStoreWithSnapshotStrategy[T](
+		log,
+		eventStore,
+		serializer,
+		snapshotStore,
+		snapshotStrategy,
+		tracer,
+	)
+}
+
+// NewEventStoreAggregateStoreWithSnapshotStrategy creates an aggregate store which takes the snapshots with a custom strategy.
+func NewEventStoreAggregateStoreWithSnapshotStrategy[T models.IHaveSnapshot](
+	log logger.Logger,
+	eventStore store.EventStore,
+	serializer *EsdbSerializer,
+	snapshotStore store.SnapshotStore,
+	snapshotStrategy store.SnapshotStrategy,
+	tracer trace.Tracer,
+) store.AggregateStore[T] {
+	return &esdbAggregateStore[T]{
+		log:              log,
+		eventStore:       eventStore,
+		serializer:       serializer,
+		tracer:           tracer,
+		snapshotStore:    snapshotStore,
+		snapshotStrategy: snapshotStrategy,
+	}
+}
+
 func (a *esdbAggregateStore[T]) StoreWithVersion(
 	aggregate T,
 	metadata metadata.Metadata,
@@ -74,6 +124,8 @@ func (a *esdbAggregateStore[T]) StoreWithVersion(
 	streamId := streamName.For[T](aggregate)
 	span.SetAttributes(attribute2.String("StreamId", streamId.String()))
 
+	previousVersion := aggregate.CurrentVersion() - int64(len(aggregate.UncommittedEvents()))
+
 	var streamEvents []*models.StreamEvent
 
 	linq.From(aggregate.UncommittedEvents()).
@@ -111,6 +163,18 @@ func (a *esdbAggregateStore[T]) StoreWithVersion(
 
 	aggregate.MarkUncommittedEventAsCommitted()
 
+	if a.snapshotStrategy != nil &&
+		a.snapshotStrategy.ShouldTakeSnapshot(previousVersion, aggregate.CurrentVersion()) {
+		// events are stored already, failing in taking the snapshot just makes the next loads slower
+		if err := a.saveSnapshot(aggregate, ctx); err != nil {
+			a.log.Warnf(
+				"[esdbAggregateStore.StoreWithVersion] error in taking snapshot of aggregate with id %s: %v",
+				aggregate.Id(),
+				err,
+			)
+		}
+	}
+
 	span.SetAttributes(attribute.Object("Aggregate", aggregate))
 
 	a.log.Infow(
@@ -163,6 +227,10 @@ func (a *esdbAggregateStore[T]) Load(
 	ctx, span := a.tracer.Start(ctx, "esdbAggregateStore.Load")
 	defer span.End()
 
+	if a.snapshotStore != nil {
+		return a.loadFromSnapshot(ctx, aggregateId)
+	}
+
 	position := readPosition.Start
 
 	return a.LoadWithReadPosition(ctx, aggregateId, position)
@@ -177,6 +245,224 @@ func (a *esdbAggregateStore[T]) LoadWithReadPosition(
 	span.SetAttributes(attribute2.String("AggregateID", aggregateId.String()))
 	defer span.End()
 
+	aggregate, err := a.newEmptyAggregate()
+	if err != nil {
+		return *new(T), utils.TraceErrStatusFromSpan(span, err)
+	}
+	aggregate.SetId(aggregateId)
+
+	streamId := streamName.ForID[T](aggregateId)
+	span.SetAttributes(attribute2.String("StreamId", streamId.String()))
+
+	err = a.loadEvents(aggregate, streamId, position, false, ctx)
+	if err != nil {
+		return *new(T), utils.TraceStatusFromSpan(span, err)
+	}
+
+	a.log.Infow(
+		fmt.Sprintf("Loaded aggregate with streamId {%s} and aggregateId {%s}",
+			streamId.String(),
+			aggregateId.String()),
+		logger.Fields{"Aggregate": aggregate, "StreamId": streamId.String()},
+	)
+
+	span.SetAttributes(attribute.Object("Aggregate", aggregate))
+
+	return aggregate, nil
+}
+
+func (a *esdbAggregateStore[T]) Exists(
+	ctx context.Context,
+	aggregateId uuid.UUID,
+) (bool, error) {
+	ctx, span := a.tracer.Start(ctx, "esdbAggregateStore.Exists")
+	span.SetAttributes(attribute2.String("AggregateID", aggregateId.String()))
+	defer span.End()
+
+	streamId := streamName.ForID[T](aggregateId)
+	span.SetAttributes(attribute2.String("StreamId", streamId.String()))
+
+	return a.eventStore.StreamExists(streamId, ctx)
+}
+
+func (a *esdbAggregateStore[T]) TakeSnapshot(aggregate T, ctx context.Context) error {
+	ctx, span := a.tracer.Start(ctx, "esdbAggregateStore.TakeSnapshot")
+	span.SetAttributes(attribute2.String("AggregateID", aggregate.Id().String()))
+	defer span.End()
+
+	if a.snapshotStore == nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.New("[esdbAggregateStore_TakeSnapshot] snapshot store is not configured for the aggregate store"),
+		)
+	}
+
+	// a snapshot should contain only the stored state of the aggregate
+	if aggregate.HasUncommittedEvents() {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.Errorf(
+				"[esdbAggregateStore_TakeSnapshot] aggregate with id %s has uncommitted events",
+				aggregate.Id(),
+			),
+		)
+	}
+
+	if err := a.saveSnapshot(aggregate, ctx); err != nil {
+		return utils.TraceErrStatusFromSpan(span, err)
+	}
+
+	return nil
+}
+
+// loadFromSnapshot restores the aggregate from its latest snapshot and replays the events after the snapshot,
+// a stale or broken snapshot is discarded and the aggregate is rebuilt from the whole stream and snapshotted again
+func (a *esdbAggregateStore[T]) loadFromSnapshot(
+	ctx context.Context,
+	aggregateId uuid.UUID,
+) (T, error) {
+	ctx, span := a.tracer.Start(ctx, "esdbAggregateStore.loadFromSnapshot")
+	span.SetAttributes(attribute2.String("AggregateID", aggregateId.String()))
+	defer span.End()
+
+	streamId := streamName.ForID[T](aggregateId)
+	span.SetAttributes(attribute2.String("StreamId", streamId.String()))
+
+	snapshot, loadSnapshotErr := a.snapshotStore.Load(streamId, ctx)
+	if loadSnapshotErr != nil {
+		a.log.Warnf(
+			"[esdbAggregateStore.loadFromSnapshot] error in loading snapshot of stream {%s}, loading aggregate from the stream: %v",
+			streamId,
+			loadSnapshotErr,
+		)
+	}
+
+	aggregate, err := a.newEmptyAggregate()
+	if err != nil {
+		return *new(T), utils.TraceErrStatusFromSpan(span, err)
+	}
+	aggregate.SetId(aggregateId)
+
+	position := readPosition.Start
+	restored := false
+	rebuildSnapshot := loadSnapshotErr != nil
+
+	if snapshot != nil {
+		restored, err = a.restoreSnapshot(aggregate, snapshot)
+		if err != nil {
+			a.log.Warnf(
+				"[esdbAggregateStore.loadFromSnapshot] error in restoring snapshot of stream {%s}, loading aggregate from the stream: %v",
+				streamId,
+				err,
+			)
+
+			// the aggregate state could be changed partially by the snapshot
+			aggregate, err = a.newEmptyAggregate()
+			if err != nil {
+				return *new(T), utils.TraceErrStatusFromSpan(span, err)
+			}
+			aggregate.SetId(aggregateId)
+		}
+
+		if restored {
+			position = readPosition.FromInt64(snapshot.Version + 1)
+		} else {
+			rebuildSnapshot = true
+		}
+	}
+	span.SetAttributes(attribute2.Bool("RestoredFromSnapshot", restored))
+
+	err = a.loadEvents(aggregate, streamId, position, restored, ctx)
+	if err != nil {
+		return *new(T), utils.TraceStatusFromSpan(span, err)
+	}
+
+	if rebuildSnapshot {
+		if err := a.saveSnapshot(aggregate, ctx); err != nil {
+			a.log.Warnf(
+				"[esdbAggregateStore.loadFromSnapshot] error in rebuilding snapshot of stream {%s}: %v",
+				streamId,
+				err,
+			)
+		}
+	}
+
+	a.log.Infow(
+		fmt.Sprintf("Loaded aggregate with streamId {%s} and aggregateId {%s}, restored from snapshot: %v",
+			streamId.String(),
+			aggregateId.String(),
+			restored),
+		logger.Fields{"Aggregate": aggregate, "StreamId": streamId.String()},
+	)
+
+	span.SetAttributes(attribute.Object("Aggregate", aggregate))
+
+	return aggregate, nil
+}
+
+// restoreSnapshot restores the aggregate state and version from the snapshot, it returns false for the stale snapshots
+func (a *esdbAggregateStore[T]) restoreSnapshot(aggregate T, snapshot *models.Snapshot) (bool, error) {
+	snapshotAggregate, ok := any(aggregate).(models.IHaveSnapshot)
+	if !ok {
+		return false, nil
+	}
+
+	if snapshot.SchemaVersion != snapshotAggregate.SnapshotSchemaVersion() {
+		a.log.Infow(
+			fmt.Sprintf(
+				"[esdbAggregateStore.restoreSnapshot] snapshot schema version %d is stale, current schema version is %d",
+				snapshot.SchemaVersion,
+				snapshotAggregate.SnapshotSchemaVersion(),
+			),
+			logger.Fields{"AggregateID": snapshot.AggregateId, "Version": snapshot.Version},
+		)
+
+		return false, nil
+	}
+
+	err := snapshotAggregate.RestoreSnapshot(snapshot.State)
+	if err != nil {
+		return false, errors.WrapIf(
+			err,
+			"[esdbAggregateStore_restoreSnapshot:RestoreSnapshot] error in restoring aggregate state from snapshot",
+		)
+	}
+
+	snapshotAggregate.RestoreVersion(snapshot.Version)
+
+	return true, nil
+}
+
+func (a *esdbAggregateStore[T]) saveSnapshot(aggregate T, ctx context.Context) error {
+	snapshotAggregate, ok := any(aggregate).(models.IHaveSnapshot)
+	if !ok {
+		return errors.Errorf(
+			"[esdbAggregateStore_saveSnapshot] aggregate %s doesn't implement IHaveSnapshot",
+			typeMapper.GetFullTypeName(aggregate),
+		)
+	}
+
+	state, err := snapshotAggregate.CreateSnapshot()
+	if err != nil {
+		return errors.WrapIf(
+			err,
+			"[esdbAggregateStore_saveSnapshot:CreateSnapshot] error in creating snapshot state",
+		)
+	}
+
+	snapshot := &models.Snapshot{
+		AggregateId:   aggregate.Id(),
+		AggregateType: typeMapper.GetTypeName(aggregate),
+		Version:       aggregate.CurrentVersion(),
+		SchemaVersion: snapshotAggregate.SnapshotSchemaVersion(),
+		State:         state,
+		CreatedAt:     time.Now(),
+	}
+
+	return a.snapshotStore.Save(streamName.For[T](aggregate), snapshot, ctx)
+}
+
+func (a *esdbAggregateStore[T]) newEmptyAggregate() (T, error) {
 	var typeNameType T
 	aggregateInstance := typeMapper.InstancePointerByTypeName(
 		typeMapper.GetFullTypeName(typeNameType),
@@ -185,7 +471,7 @@ func (a *esdbAggregateStore[T]) LoadWithReadPosition(
 	if !ok {
 		return *new(T), errors.New(
 			fmt.Sprintf(
-				"[esdbAggregateStore_LoadWithReadPosition] aggregate is not a %s",
+				"[esdbAggregateStore_newEmptyAggregate] aggregate is not a %s",
 				typeMapper.GetFullTypeName(typeNameType),
 			),
 		)
@@ -193,38 +479,39 @@ func (a *esdbAggregateStore[T]) LoadWithReadPosition(
 
 	method := reflect.ValueOf(aggregate).MethodByName("NewEmptyAggregate")
 	if !method.IsValid() {
-		return *new(T), utils.TraceErrStatusFromSpan(
-			span,
-			errors.New(
-				"[esdbAggregateStore_LoadWithReadPosition:MethodByName] aggregate does not have a `NewEmptyAggregate` method",
-			),
+		return *new(T), errors.New(
+			"[esdbAggregateStore_newEmptyAggregate:MethodByName] aggregate does not have a `NewEmptyAggregate` method",
 		)
 	}
 
 	method.Call([]reflect.Value{})
 
-	streamId := streamName.ForID[T](aggregateId)
-	span.SetAttributes(attribute2.String("StreamId", streamId.String()))
+	return aggregate, nil
+}
 
+// loadEvents replays the stream events from the position on the aggregate, an aggregate restored from a snapshot could have no events after the snapshot
+func (a *esdbAggregateStore[T]) loadEvents(
+	aggregate T,
+	streamId streamName.StreamName,
+	position readPosition.StreamReadPosition,
+	allowEmpty bool,
+	ctx context.Context,
+) error {
 	streamEvents, err := a.getStreamEvents(streamId, position, ctx)
-	if errors.Is(err, esdb.ErrStreamNotFound) || len(streamEvents) == 0 {
-		return *new(T), utils.TraceErrStatusFromSpan(
-			span,
-			errors.WithMessage(
-				esErrors.NewAggregateNotFoundError(err, aggregateId),
-				"[esdbAggregateStore.LoadWithReadPosition] error in loading aggregate",
-			),
+	// the in-memory event store returns its own stream not found error
+	if errors.Is(err, esdb.ErrStreamNotFound) || esErrors2.IsStreamNotFoundError(err) ||
+		(len(streamEvents) == 0 && !allowEmpty) {
+		return errors.WithMessage(
+			esErrors.NewAggregateNotFoundError(err, streamId.GetId()),
+			"[esdbAggregateStore.loadEvents] error in loading aggregate",
 		)
 	}
 
 	if err != nil {
-		return *new(T), utils.TraceErrStatusFromSpan(
-			span,
-			errors.WrapIff(
-				err,
-				"[esdbAggregateStore.LoadWithReadPosition:MethodByName] error in loading aggregate {%s}",
-				aggregateId.String(),
-			),
+		return errors.WrapIff(
+			err,
+			"[esdbAggregateStore.loadEvents:getStreamEvents] error in loading aggregate {%s}",
+			streamId.GetId().String(),
 		)
 	}
 
@@ -239,35 +526,7 @@ func (a *esdbAggregateStore[T]) LoadWithReadPosition(
 		}).
 		ToSlice(&domainEvents)
 
-	err = aggregate.LoadFromHistory(domainEvents, meta)
-	if err != nil {
-		return *new(T), utils.TraceStatusFromSpan(span, err)
-	}
-
-	a.log.Infow(
-		fmt.Sprintf("Loaded aggregate with streamId {%s} and aggregateId {%s}",
-			streamId.String(),
-			aggregateId.String()),
-		logger.Fields{"Aggregate": aggregate, "StreamId": streamId.String()},
-	)
-
-	span.SetAttributes(attribute.Object("Aggregate", aggregate))
-
-	return aggregate, nil
-}
-
-func (a *esdbAggregateStore[T]) Exists(
-	ctx context.Context,
-	aggregateId uuid.UUID,
-) (bool, error) {
-	ctx, span := a.tracer.Start(ctx, "esdbAggregateStore.Exists")
-	span.SetAttributes(attribute2.String("AggregateID", aggregateId.String()))
-	defer span.End()
-
-	streamId := streamName.ForID[T](aggregateId)
-	span.SetAttributes(attribute2.String("StreamId", streamId.String()))
-
-	return a.eventStore.StreamExists(streamId, ctx)
+	return aggregate.LoadFromHistory(domainEvents, meta)
 }
 
 func (a *esdbAggregateStore[T]) getStreamEvents(
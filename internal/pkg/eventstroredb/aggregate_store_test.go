@@ -0,0 +1,273 @@
+//go:build unit
+// +build unit
+
+package eventstroredb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer/json"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/errors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/mocks"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	appendResult "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/append_result"
+	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
+	readPosition "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_position/read_position"
+	esErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/errors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type AggregateStoreTestSuite struct {
+	suite.Suite
+	eventStore    *mocks.EventStore
+	snapshotStore *mocks.SnapshotStore
+	serializer    *EsdbSerializer
+}
+
+func TestAggregateStore(t *testing.T) {
+	suite.Run(t, new(AggregateStoreTestSuite))
+}
+
+func (s *AggregateStoreTestSuite) SetupTest() {
+	s.eventStore = mocks.NewEventStore(s.T())
+	s.snapshotStore = mocks.NewSnapshotStore(s.T())
+
+	jsonSerializer := json.NewDefaultJsonSerializer()
+	s.serializer = NewEsdbSerializer(
+		json.NewDefaultMetadataJsonSerializer(jsonSerializer),
+		json.NewDefaultEventJsonSerializer(jsonSerializer),
+	)
+}
+
+func (s *AggregateStoreTestSuite) Test_Load_Should_Restore_From_Snapshot_And_Replay_Next_Events() {
+	id := uuid.NewV4()
+	streamId := streamName.ForID[*counter](id)
+
+	s.snapshotStore.EXPECT().Load(streamId, mock.Anything).Return(&models.Snapshot{
+		AggregateId:   id,
+		Version:       9,
+		SchemaVersion: counterSnapshotSchemaVersion,
+		State:         &counterSnapshot{Count: 10},
+	}, nil)
+	s.eventStore.EXPECT().
+		ReadEvents(streamId, readPosition.FromInt64(10), mock.Anything, mock.Anything).
+		Return(s.streamEvents(id, 10, 2), nil)
+
+	aggregate, err := s.aggregateStore(es.NewOnDemandSnapshotStrategy()).Load(context.Background(), id)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(id, aggregate.Id())
+	s.Assert().Equal(12, aggregate.count)
+	s.Assert().Equal(int64(11), aggregate.OriginalVersion())
+	s.Assert().Equal(int64(11), aggregate.CurrentVersion())
+}
+
+func (s *AggregateStoreTestSuite) Test_Load_Should_Restore_From_Snapshot_Without_Next_Events() {
+	id := uuid.NewV4()
+	streamId := streamName.ForID[*counter](id)
+
+	s.snapshotStore.EXPECT().Load(streamId, mock.Anything).Return(&models.Snapshot{
+		AggregateId:   id,
+		Version:       4,
+		SchemaVersion: counterSnapshotSchemaVersion,
+		State:         &counterSnapshot{Count: 5},
+	}, nil)
+	s.eventStore.EXPECT().
+		ReadEvents(streamId, readPosition.FromInt64(5), mock.Anything, mock.Anything).
+		Return(nil, nil)
+
+	aggregate, err := s.aggregateStore(es.NewOnDemandSnapshotStrategy()).Load(context.Background(), id)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(5, aggregate.count)
+	s.Assert().Equal(int64(4), aggregate.CurrentVersion())
+}
+
+func (s *AggregateStoreTestSuite) Test_Load_Should_Rebuild_Stale_Snapshot() {
+	id := uuid.NewV4()
+	streamId := streamName.ForID[*counter](id)
+
+	s.snapshotStore.EXPECT().Load(streamId, mock.Anything).Return(&models.Snapshot{
+		AggregateId:   id,
+		Version:       1,
+		SchemaVersion: counterSnapshotSchemaVersion - 1,
+		State:         &counterSnapshot{Count: 100},
+	}, nil)
+	s.eventStore.EXPECT().
+		ReadEvents(streamId, readPosition.Start, mock.Anything, mock.Anything).
+		Return(s.streamEvents(id, 0, 3), nil)
+	s.snapshotStore.EXPECT().
+		Save(streamId, mock.MatchedBy(func(snapshot *models.Snapshot) bool {
+			return snapshot.Version == 2 &&
+				snapshot.SchemaVersion == counterSnapshotSchemaVersion &&
+				snapshot.State.(*counterSnapshot).Count == 3
+		}), mock.Anything).
+		Return(nil)
+
+	aggregate, err := s.aggregateStore(es.NewOnDemandSnapshotStrategy()).Load(context.Background(), id)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(3, aggregate.count)
+}
+
+func (s *AggregateStoreTestSuite) Test_Store_Should_Take_Snapshot_Every_N_Events() {
+	aggregate := newCounter(uuid.NewV4())
+	streamId := streamName.For[*counter](aggregate)
+
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(aggregate.Increment())
+	}
+
+	s.eventStore.EXPECT().
+		AppendEvents(streamId, mock.Anything, mock.Anything, mock.Anything).
+		Return(appendResult.NoOp, nil)
+	s.snapshotStore.EXPECT().
+		Save(streamId, mock.MatchedBy(func(snapshot *models.Snapshot) bool {
+			return snapshot.Version == 2 && snapshot.State.(*counterSnapshot).Count == 3
+		}), mock.Anything).
+		Return(nil).
+		Once()
+
+	_, err := s.aggregateStore(es.NewEveryNEventsSnapshotStrategy(3)).
+		Store(aggregate, nil, context.Background())
+	s.Require().NoError(err)
+}
+
+func (s *AggregateStoreTestSuite) Test_TakeSnapshot_Should_Fail_With_Uncommitted_Events() {
+	aggregate := newCounter(uuid.NewV4())
+	s.Require().NoError(aggregate.Increment())
+
+	err := s.aggregateStore(es.NewOnDemandSnapshotStrategy()).TakeSnapshot(aggregate, context.Background())
+	s.Assert().Error(err)
+}
+
+func (s *AggregateStoreTestSuite) Test_Store_And_Load_With_InMemory_Event_Store() {
+	ctx := context.Background()
+	aggregateStore := NewEventStoreAggregateStoreWithSnapshotStrategy[*counter](
+		empty.EmptyLogger,
+		es.NewInMemoryEventStore(),
+		s.serializer,
+		es.NewInMemorySnapshotStore(),
+		es.NewEveryNEventsSnapshotStrategy(2),
+		trace.NewNoopTracerProvider().Tracer("test"),
+	)
+
+	aggregate := newCounter(uuid.NewV4())
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(aggregate.Increment())
+	}
+	_, err := aggregateStore.Store(aggregate, nil, ctx)
+	s.Require().NoError(err)
+
+	loaded, err := aggregateStore.Load(ctx, aggregate.Id())
+	s.Require().NoError(err)
+	s.Assert().Equal(3, loaded.count)
+	s.Assert().Equal(int64(2), loaded.CurrentVersion())
+
+	// a concurrent change with a stale version should be rejected
+	s.Require().NoError(aggregate.Increment())
+	s.Require().NoError(loaded.Increment())
+	_, err = aggregateStore.Store(loaded, nil, ctx)
+	s.Require().NoError(err)
+	_, err = aggregateStore.Store(aggregate, nil, ctx)
+	s.Assert().True(errors.IsWrongExpectedVersionError(err))
+
+	_, err = aggregateStore.Load(ctx, uuid.NewV4())
+	s.Assert().True(esErrors.IsAggregateNotFoundError(err))
+}
+
+func (s *AggregateStoreTestSuite) aggregateStore(strategy store.SnapshotStrategy) *esdbAggregateStore[*counter] {
+	return NewEventStoreAggregateStoreWithSnapshotStrategy[*counter](
+		empty.EmptyLogger,
+		s.eventStore,
+		s.serializer,
+		s.snapshotStore,
+		strategy,
+		trace.NewNoopTracerProvider().Tracer("test"),
+	).(*esdbAggregateStore[*counter])
+}
+
+func (s *AggregateStoreTestSuite) streamEvents(id uuid.UUID, fromVersion int64, count int) []*models.StreamEvent {
+	var streamEvents []*models.StreamEvent
+	for i := 0; i < count; i++ {
+		event := newCounterIncremented()
+		event.WithAggregate(id, fromVersion+int64(i))
+
+		streamEvents = append(
+			streamEvents,
+			s.serializer.DomainEventToStreamEvent(event, metadata.Metadata{}, fromVersion+int64(i)),
+		)
+	}
+
+	return streamEvents
+}
+
+const counterSnapshotSchemaVersion = 2
+
+type counter struct {
+	*models.EventSourcedAggregateRoot
+	count int
+}
+
+type counterSnapshot struct {
+	Count int
+}
+
+type counterIncremented struct {
+	*domain.DomainEvent
+}
+
+func newCounterIncremented() *counterIncremented {
+	return &counterIncremented{DomainEvent: domain.NewDomainEvent(typeMapper.GetTypeName(&counterIncremented{}))}
+}
+
+func newCounter(id uuid.UUID) *counter {
+	c := &counter{}
+	c.NewEmptyAggregate()
+	c.SetId(id)
+
+	return c
+}
+
+func (c *counter) NewEmptyAggregate() {
+	c.EventSourcedAggregateRoot = models.NewEventSourcedAggregateRoot(typeMapper.GetFullTypeName(c), c.When)
+}
+
+func (c *counter) Increment() error {
+	return c.Apply(newCounterIncremented(), true)
+}
+
+func (c *counter) When(event domain.IDomainEvent) error {
+	switch event.(type) {
+	case *counterIncremented:
+		c.count++
+		return nil
+	default:
+		return errors.InvalidEventTypeError
+	}
+}
+
+func (c *counter) SnapshotSchemaVersion() int {
+	return counterSnapshotSchemaVersion
+}
+
+func (c *counter) CreateSnapshot() (interface{}, error) {
+	return &counterSnapshot{Count: c.count}, nil
+}
+
+func (c *counter) RestoreSnapshot(state interface{}) error {
+	c.count = state.(*counterSnapshot).Count
+
+	return nil
+}
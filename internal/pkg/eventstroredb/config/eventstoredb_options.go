@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config/environment"
@@ -16,11 +17,17 @@ import (
 // https://developers.eventstore.com/server/v20.10/networking.html#http-configuration
 
 type EventStoreDbOptions struct {
-	Host    string `mapstructure:"host"`
-	TcpPort int    `mapstructure:"tcpPort"`
+	// UseInMemory uses the in-memory event store instead of the EventStoreDB, it is useful for the fast tests
+	UseInMemory bool   `mapstructure:"useInMemory"`
+	Host        string `mapstructure:"host"`
+	TcpPort     int    `mapstructure:"tcpPort"`
 	// HTTP is the primary protocol for EventStoreDB. It is used in gRPC communication and HTTP APIs (management, gossip and diagnostics).
 	HttpPort     int           `mapstructure:"httpPort"`
 	Subscription *Subscription `mapstructure:"subscription"`
+	// Snapshot is used by the aggregate stores which support snapshotting
+	Snapshot SnapshotOptions `mapstructure:"snapshot"`
+	// Projections is the retry policy of the projections in the subscription workers
+	Projections ProjectionsOptions `mapstructure:"projections"`
 }
 
 // https://developers.eventstore.com/server/v20.10/networking.html#http-configuration
@@ -41,7 +48,7 @@ func (e *EventStoreDbOptions) TcpEndPoint() string {
 // https://developers.eventstore.com/clients/http-api/v5
 
 func (e *EventStoreDbOptions) HttpEndPoint() string {
-	return fmt.Sprintf("http://%s:%d", e.Host, e.Host)
+	return fmt.Sprintf("http://%s:%d", e.Host, e.HttpPort)
 }
 
 type Subscription struct {
@@ -49,6 +56,19 @@ type Subscription struct {
 	SubscriptionId string   `mapstructure:"subscriptionId" validate:"required"`
 }
 
+type SnapshotOptions struct {
+	// Enabled takes the snapshots automatically every `Frequency` events, snapshots can be taken on demand also when it is disabled
+	Enabled   bool  `mapstructure:"enabled"   default:"false"`
+	Frequency int64 `mapstructure:"frequency" default:"100"`
+}
+
+type ProjectionsOptions struct {
+	// RetryAttempts is the number of processing attempts of an event by a projection before parking it in the parked events store
+	RetryAttempts uint          `mapstructure:"retryAttempts" default:"3"`
+	RetryDelay    time.Duration `mapstructure:"retryDelay"    default:"300ms"`
+	MaxRetryDelay time.Duration `mapstructure:"maxRetryDelay" default:"5s"`
+}
+
 func ProvideConfig(environment environment.Environment) (*EventStoreDbOptions, error) {
 	optionName := strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[EventStoreDbOptions]())
 	return config.BindConfigKey[*EventStoreDbOptions](optionName, environment)
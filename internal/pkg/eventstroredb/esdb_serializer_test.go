@@ -0,0 +1,86 @@
+//go:build unit
+// +build unit
+
+package eventstroredb
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer/json"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/upcaster"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Esdb_Serializer_Should_Upcast_Old_Events(t *testing.T) {
+	registry, err := es.NewUpcasterRegistry(
+		// an old event type which doesn't exist anymore
+		es.NewEventTypeUpcaster(
+			"*counterIncrementedV0",
+			1,
+			typeMapper.GetTypeName(&counterIncremented{}),
+			func(payload map[string]interface{}) (map[string]interface{}, error) {
+				payload["aggregate_id"] = payload["counter_id"]
+				delete(payload, "counter_id")
+				return payload, nil
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	serializer := newUpcastingEsdbSerializer(registry)
+	id := uuid.Must(uuid.NewV4())
+
+	streamEvent, err := serializer.ResolvedEventToStreamEvent(&esdb.ResolvedEvent{
+		Event: &esdb.RecordedEvent{
+			EventID:     id,
+			EventType:   "*counterIncrementedV0",
+			ContentType: "application/json",
+			Data:        []byte(`{"counter_id": "` + id.String() + `"}`),
+		},
+	})
+	require.NoError(t, err)
+
+	event, ok := streamEvent.Event.(*counterIncremented)
+	require.True(t, ok)
+	assert.Equal(t, id.String(), event.GetAggregateId().String())
+	assert.Equal(t, 1, es.GetEventVersion(streamEvent.Metadata))
+}
+
+func Test_Esdb_Serializer_Should_Store_Current_Event_Version(t *testing.T) {
+	eventType := typeMapper.GetTypeName(&counterIncremented{})
+	registry, err := es.NewUpcasterRegistry(
+		es.NewPayloadUpcaster(eventType, 1, func(payload map[string]interface{}) (map[string]interface{}, error) {
+			return payload, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	serializer := newUpcastingEsdbSerializer(registry)
+	meta := metadata.Metadata{}
+
+	eventData, err := serializer.Serialize(newCounterIncremented(), meta)
+	require.NoError(t, err)
+
+	storedMeta, err := serializer.metadataSerializer.Deserialize(eventData.Metadata)
+	require.NoError(t, err)
+	assert.Equal(t, 2, es.GetEventVersion(storedMeta))
+	// the metadata of the caller should not change
+	assert.False(t, meta.ExistsKey(upcaster.EventVersionMetadataKey))
+}
+
+func newUpcastingEsdbSerializer(registry upcaster.UpcasterRegistry) *EsdbSerializer {
+	jsonSerializer := json.NewDefaultJsonSerializer()
+
+	return NewEsdbSerializerWithUpcasters(
+		json.NewDefaultMetadataJsonSerializer(jsonSerializer),
+		json.NewDefaultEventJsonSerializer(jsonSerializer),
+		registry,
+	)
+}
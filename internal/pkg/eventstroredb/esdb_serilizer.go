@@ -7,6 +7,8 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/upcaster"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
 	appendResult "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/append_result"
 	readPosition "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_position/read_position"
@@ -25,6 +27,7 @@ import (
 type EsdbSerializer struct {
 	metadataSerializer serializer.MetadataSerializer
 	eventSerializer    serializer.EventSerializer
+	upcasterRegistry   upcaster.UpcasterRegistry
 }
 
 func NewEsdbSerializer(
@@ -37,6 +40,19 @@ func NewEsdbSerializer(
 	}
 }
 
+// NewEsdbSerializerWithUpcasters creates a serializer which upcasts the old stored events before deserializing them to their go types
+func NewEsdbSerializerWithUpcasters(
+	metadataSerializer serializer.MetadataSerializer,
+	eventSerializer serializer.EventSerializer,
+	upcasterRegistry upcaster.UpcasterRegistry,
+) *EsdbSerializer {
+	return &EsdbSerializer{
+		metadataSerializer: metadataSerializer,
+		eventSerializer:    eventSerializer,
+		upcasterRegistry:   upcasterRegistry,
+	}
+}
+
 func (e *EsdbSerializer) StreamEventToEventData(
 	streamEvent *models.StreamEvent,
 ) (esdb.EventData, error) {
@@ -45,7 +61,9 @@ func (e *EsdbSerializer) StreamEventToEventData(
 		return *new(esdb.EventData), err
 	}
 
-	metadataSerializationResult, err := e.metadataSerializer.Serialize(streamEvent.Metadata)
+	metadataSerializationResult, err := e.metadataSerializer.Serialize(
+		e.withEventVersion(streamEvent.Event, streamEvent.Metadata),
+	)
 	if err != nil {
 		return *new(esdb.EventData), err
 	}
@@ -98,7 +116,7 @@ func (e *EsdbSerializer) StreamReadPositionToStreamPosition(
 		return esdb.Start{}
 	}
 
-	return esdb.Revision(1)
+	return esdb.Revision(uint64(readPosition.Value()))
 }
 
 func (e *EsdbSerializer) StreamTruncatePositionToInt64(
@@ -115,7 +133,11 @@ func (e *EsdbSerializer) EsdbReadStreamToResolvedEvents(
 	for {
 		event, err := stream.Recv()
 		if errors.Is(err, esdb.ErrStreamNotFound) {
-			return nil, esErrors.NewStreamNotFoundError(err, event.Event.StreamID)
+			var streamId string
+			if event != nil && event.Event != nil {
+				streamId = event.Event.StreamID
+			}
+			return nil, esErrors.NewStreamNotFoundError(err, streamId)
 		}
 		if errors.Is(err, io.EOF) {
 			break
@@ -139,16 +161,25 @@ func (e *EsdbSerializer) EsdbPositionToStreamReadPosition(
 func (e *EsdbSerializer) ResolvedEventToStreamEvent(
 	resolveEvent *esdb.ResolvedEvent,
 ) (*models.StreamEvent, error) {
-	deserializedEvent, err := e.eventSerializer.Deserialize(
-		resolveEvent.Event.Data,
+	deserializedMeta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType, data, deserializedMeta, err := e.upcast(
 		resolveEvent.Event.EventType,
-		resolveEvent.Event.ContentType,
+		resolveEvent.Event.Data,
+		deserializedMeta,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	deserializedMeta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	deserializedEvent, err := e.eventSerializer.Deserialize(
+		data,
+		eventType,
+		resolveEvent.Event.ContentType,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +231,7 @@ func (e *EsdbSerializer) Serialize(
 		return nil, err
 	}
 
-	serializedMeta, err := e.metadataSerializer.Serialize(meta)
+	serializedMeta, err := e.metadataSerializer.Serialize(e.withEventVersion(data, meta))
 	if err != nil {
 		return nil, err
 	}
@@ -242,9 +273,15 @@ func (e *EsdbSerializer) SerializeObject(
 func (e *EsdbSerializer) Deserialize(
 	resolveEvent *esdb.ResolvedEvent,
 ) (domain.IDomainEvent, metadata.Metadata, error) {
-	eventType := resolveEvent.Event.EventType
-	data := resolveEvent.Event.Data
-	userMeta := resolveEvent.Event.UserMetadata
+	meta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eventType, data, meta, err := e.upcast(resolveEvent.Event.EventType, resolveEvent.Event.Data, meta)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	payload, err := e.eventSerializer.Deserialize(
 		data,
@@ -255,20 +292,21 @@ func (e *EsdbSerializer) Deserialize(
 		return nil, nil, err
 	}
 
-	meta, err := e.metadataSerializer.Deserialize(userMeta)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	return payload, meta, nil
 }
 
 func (e *EsdbSerializer) DeserializeObject(
 	resolveEvent *esdb.ResolvedEvent,
 ) (interface{}, metadata.Metadata, error) {
-	eventType := resolveEvent.Event.EventType
-	data := resolveEvent.Event.Data
-	userMeta := resolveEvent.Event.UserMetadata
+	meta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eventType, data, meta, err := e.upcast(resolveEvent.Event.EventType, resolveEvent.Event.Data, meta)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	payload, err := e.eventSerializer.Deserialize(
 		data,
@@ -279,11 +317,6 @@ func (e *EsdbSerializer) DeserializeObject(
 		return nil, nil, err
 	}
 
-	meta, err := e.metadataSerializer.Deserialize(userMeta)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	return payload, meta, nil
 }
 
@@ -300,3 +333,61 @@ func (e *EsdbSerializer) DomainEventToStreamEvent(
 		Position: position,
 	}
 }
+
+// withEventVersion adds the current schema version of the event to a copy of the metadata, so the new events will not be upcasted
+func (e *EsdbSerializer) withEventVersion(event interface{}, meta metadata.Metadata) metadata.Metadata {
+	if e.upcasterRegistry == nil {
+		return meta
+	}
+
+	eventType := typeMapper.GetTypeName(event)
+	if !e.upcasterRegistry.HasUpcasters(eventType) {
+		return meta
+	}
+
+	versionedMeta := metadata.Metadata{}
+	for key, value := range meta {
+		versionedMeta.Set(key, value)
+	}
+	versionedMeta.Set(upcaster.EventVersionMetadataKey, e.upcasterRegistry.CurrentVersion(eventType))
+
+	return versionedMeta
+}
+
+// upcast transforms the stored event payload with the upcasters of its event type and version before deserializing it
+func (e *EsdbSerializer) upcast(
+	eventType string,
+	data []byte,
+	meta metadata.Metadata,
+) (string, []byte, metadata.Metadata, error) {
+	if e.upcasterRegistry == nil || !e.upcasterRegistry.HasUpcasters(eventType) || data == nil {
+		return eventType, data, meta, nil
+	}
+
+	var payload map[string]interface{}
+	err := e.eventSerializer.Serializer().Unmarshal(data, &payload)
+	if err != nil {
+		return "", nil, nil, errors.WrapIff(err, "error in unmarshaling event `%s` for upcasting", eventType)
+	}
+
+	upcastedEvent, err := e.upcasterRegistry.Upcast(&upcaster.UpcastingEvent{
+		EventType: eventType,
+		Version:   es.GetEventVersion(meta),
+		Payload:   payload,
+		Metadata:  meta,
+	})
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	upcastedData, err := e.eventSerializer.Serializer().Marshal(upcastedEvent.Payload)
+	if err != nil {
+		return "", nil, nil, errors.WrapIff(
+			err,
+			"error in marshaling upcasted event `%s`",
+			upcastedEvent.EventType,
+		)
+	}
+
+	return upcastedEvent.EventType, upcastedData, upcastedEvent.Metadata, nil
+}
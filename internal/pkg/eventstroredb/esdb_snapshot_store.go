@@ -0,0 +1,263 @@
+package eventstroredb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
+	esErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/errors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/utils"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"emperror.dev/errors"
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+	"github.com/gofrs/uuid"
+	uuid2 "github.com/satori/go.uuid"
+	attribute2 "go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	snapshotEventType = "AggregateSnapshot"
+	// we just need the latest snapshot, older snapshots will be scavenged by the eventstoredb
+	snapshotStreamMaxCount = 1
+)
+
+// snapshotData is the stored event data of a snapshot in the snapshot side stream
+type snapshotData struct {
+	AggregateId   uuid2.UUID      `json:"aggregateId"`
+	AggregateType string          `json:"aggregateType"`
+	Version       int64           `json:"version"`
+	SchemaVersion int             `json:"schemaVersion"`
+	StateType     string          `json:"stateType"`
+	State         json.RawMessage `json:"state"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
+
+// https://developers.eventstore.com/clients/grpc/reading-events.html#reading-backwards
+// https://developers.eventstore.com/server/v21.10/streams.html#max-count
+type esdbSnapshotStore struct {
+	log        logger.Logger
+	client     *esdb.Client
+	serializer *EsdbSerializer
+	tracer     trace.Tracer
+}
+
+func NewEsdbSnapshotStore(
+	log logger.Logger,
+	client *esdb.Client,
+	serializer *EsdbSerializer,
+	tracer trace.Tracer,
+) store.SnapshotStore {
+	return &esdbSnapshotStore{
+		log:        log,
+		client:     client,
+		serializer: serializer,
+		tracer:     tracer,
+	}
+}
+
+func (s *esdbSnapshotStore) Load(
+	streamName streamName.StreamName,
+	ctx context.Context,
+) (*models.Snapshot, error) {
+	snapshotStreamName := streamNameForSnapshot(streamName)
+
+	ctx, span := s.tracer.Start(ctx, "esdbSnapshotStore.Load")
+	span.SetAttributes(attribute2.String("StreamName", snapshotStreamName))
+	defer span.End()
+
+	readStream, err := s.client.ReadStream(
+		ctx,
+		snapshotStreamName,
+		esdb.ReadStreamOptions{
+			Direction: esdb.Backwards,
+			From:      esdb.End{},
+		},
+		1,
+	)
+	if errors.Is(err, esdb.ErrStreamNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				esErrors.NewReadStreamError(err),
+				"[esdbSnapshotStore_Load:ReadStream] error in reading snapshot stream",
+			),
+		)
+	}
+	defer readStream.Close()
+
+	resolvedEvent, err := readStream.Recv()
+	if errors.Is(err, io.EOF) || errors.Is(err, esdb.ErrStreamNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				esErrors.NewReadStreamError(err),
+				"[esdbSnapshotStore_Load:Recv] error in reading snapshot stream",
+			),
+		)
+	}
+
+	var data snapshotData
+	err = s.serializer.eventSerializer.Serializer().Unmarshal(resolvedEvent.Event.Data, &data)
+	if err != nil {
+		return nil, utils.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[esdbSnapshotStore_Load:Unmarshal] error in unmarshaling snapshot",
+			),
+		)
+	}
+
+	state, err := s.serializer.eventSerializer.DeserializeObject(
+		data.State,
+		data.StateType,
+		resolvedEvent.Event.ContentType,
+	)
+	if err != nil {
+		return nil, utils.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIff(
+				err,
+				"[esdbSnapshotStore_Load:DeserializeObject] error in deserializing snapshot state `%s`",
+				data.StateType,
+			),
+		)
+	}
+
+	snapshot := &models.Snapshot{
+		AggregateId:   data.AggregateId,
+		AggregateType: data.AggregateType,
+		Version:       data.Version,
+		SchemaVersion: data.SchemaVersion,
+		State:         state,
+		CreatedAt:     data.CreatedAt,
+	}
+
+	s.log.Infow(
+		fmt.Sprintf(
+			"[esdbSnapshotStore.Load] snapshot with version %d loaded from stream {%s}",
+			snapshot.Version,
+			snapshotStreamName,
+		),
+		logger.Fields{"StreamId": snapshotStreamName, "Version": snapshot.Version},
+	)
+
+	return snapshot, nil
+}
+
+func (s *esdbSnapshotStore) Save(
+	streamName streamName.StreamName,
+	snapshot *models.Snapshot,
+	ctx context.Context,
+) error {
+	snapshotStreamName := streamNameForSnapshot(streamName)
+
+	ctx, span := s.tracer.Start(ctx, "esdbSnapshotStore.Save")
+	span.SetAttributes(attribute2.String("StreamName", snapshotStreamName))
+	span.SetAttributes(attribute2.Int64("Version", snapshot.Version))
+	defer span.End()
+
+	serializedState, err := s.serializer.eventSerializer.SerializeObject(snapshot.State)
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[esdbSnapshotStore_Save:SerializeObject] error in serializing snapshot state",
+			),
+		)
+	}
+
+	data, err := s.serializer.eventSerializer.Serializer().Marshal(&snapshotData{
+		AggregateId:   snapshot.AggregateId,
+		AggregateType: snapshot.AggregateType,
+		Version:       snapshot.Version,
+		SchemaVersion: snapshot.SchemaVersion,
+		StateType:     typeMapper.GetFullTypeName(snapshot.State),
+		State:         serializedState.Data,
+		CreatedAt:     snapshot.CreatedAt,
+	})
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(err, "[esdbSnapshotStore_Save:Marshal] error in marshaling snapshot"),
+		)
+	}
+
+	eventId, err := uuid.NewV4()
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(span, err)
+	}
+
+	writeResult, err := s.client.AppendToStream(
+		ctx,
+		snapshotStreamName,
+		esdb.AppendToStreamOptions{ExpectedRevision: esdb.Any{}},
+		esdb.EventData{
+			EventID:     eventId,
+			EventType:   snapshotEventType,
+			ContentType: esdb.JsonContentType,
+			Data:        data,
+		},
+	)
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				esErrors.NewAppendToStreamError(err, snapshotStreamName),
+				"[esdbSnapshotStore_Save:AppendToStream] error in appending snapshot to stream",
+			),
+		)
+	}
+
+	// setting the max count of the snapshot stream for the first snapshot
+	if writeResult.NextExpectedVersion == 0 {
+		metadata := esdb.StreamMetadata{}
+		metadata.SetMaxCount(snapshotStreamMaxCount)
+
+		_, err = s.client.SetStreamMetadata(
+			ctx,
+			snapshotStreamName,
+			esdb.AppendToStreamOptions{ExpectedRevision: esdb.Any{}},
+			metadata,
+		)
+		if err != nil {
+			return utils.TraceErrStatusFromSpan(
+				span,
+				errors.WrapIf(
+					err,
+					"[esdbSnapshotStore_Save:SetStreamMetadata] error in setting snapshot stream metadata",
+				),
+			)
+		}
+	}
+
+	s.log.Infow(
+		fmt.Sprintf(
+			"[esdbSnapshotStore.Save] snapshot with version %d stored in stream {%s}",
+			snapshot.Version,
+			snapshotStreamName,
+		),
+		logger.Fields{"StreamId": snapshotStreamName, "Version": snapshot.Version},
+	)
+
+	return nil
+}
+
+func streamNameForSnapshot(name streamName.StreamName) string {
+	return streamName.SnapshotFor(name).String()
+}
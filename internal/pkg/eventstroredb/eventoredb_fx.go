@@ -4,10 +4,16 @@ import (
 	"context"
 	"time"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/upcaster"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 
 	"github.com/EventStore/EventStore-Client-Go/esdb"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 )
 
@@ -28,11 +34,15 @@ var (
 	// - execute its func only if it requested
 	eventstoreProviders = fx.Options(fx.Provide( //nolint:gochecknoglobals
 		config.ProvideConfig,
-		NewEsdbSerializer,
+		provideUpcasterRegistry,
+		NewEsdbSerializerWithUpcasters,
 		NewEventStoreDB,
-		NewEventStoreDbEventStore,
-		NewEsdbSubscriptionCheckpointRepository,
-		NewEsdbSubscriptionAllWorker,
+		es.NewInMemoryEventStore,
+		provideEventStore,
+		provideSnapshotStore,
+		provideSubscriptionCheckpointRepository,
+		es.NewInMemoryParkedEventStore,
+		provideSubscriptionAllWorker,
 	))
 
 	// FiberInvokes - execute after registering all of our provided
@@ -86,3 +96,90 @@ func registerHooks(
 		},
 	})
 }
+
+type upcastersParams struct {
+	fx.In
+
+	Upcasters []upcaster.Upcaster `group:"upcasters"`
+}
+
+// provideUpcasterRegistry registers the upcasters which are provided with `es.AsUpcaster`
+func provideUpcasterRegistry(params upcastersParams) (upcaster.UpcasterRegistry, error) {
+	return es.NewUpcasterRegistry(params.Upcasters...)
+}
+
+// `UseInMemory` option replaces the EventStoreDB dependencies with their in-memory versions, the rest of the app stays the same
+
+func provideEventStore(
+	cfg *config.EventStoreDbOptions,
+	log logger.Logger,
+	client *esdb.Client,
+	serializer *EsdbSerializer,
+	inMemoryEventStore es.InMemoryEventStore,
+	tracer trace.Tracer,
+) store.EventStore {
+	if cfg.UseInMemory {
+		return inMemoryEventStore
+	}
+
+	return NewEventStoreDbEventStore(log, client, serializer, tracer)
+}
+
+func provideSnapshotStore(
+	cfg *config.EventStoreDbOptions,
+	log logger.Logger,
+	client *esdb.Client,
+	serializer *EsdbSerializer,
+	tracer trace.Tracer,
+) store.SnapshotStore {
+	if cfg.UseInMemory {
+		return es.NewInMemorySnapshotStore()
+	}
+
+	return NewEsdbSnapshotStore(log, client, serializer, tracer)
+}
+
+func provideSubscriptionCheckpointRepository(
+	cfg *config.EventStoreDbOptions,
+	client *esdb.Client,
+	log logger.Logger,
+	serializer *EsdbSerializer,
+) contracts.SubscriptionCheckpointRepository {
+	if cfg.UseInMemory {
+		return es.NewInMemorySubscriptionCheckpointRepository()
+	}
+
+	return NewEsdbSubscriptionCheckpointRepository(client, log, serializer)
+}
+
+func provideSubscriptionAllWorker(
+	cfg *config.EventStoreDbOptions,
+	log logger.Logger,
+	client *esdb.Client,
+	serializer *EsdbSerializer,
+	inMemoryEventStore es.InMemoryEventStore,
+	subscriptionRepository contracts.SubscriptionCheckpointRepository,
+	parkedEventStore projection.IParkedEventStore,
+	projectionBuilderFunc ProjectionBuilderFuc,
+) EsdbSubscriptionAllWorker {
+	if cfg.UseInMemory {
+		return NewInMemorySubscriptionAllWorker(
+			log,
+			cfg,
+			inMemoryEventStore,
+			subscriptionRepository,
+			parkedEventStore,
+			projectionBuilderFunc,
+		)
+	}
+
+	return NewEsdbSubscriptionAllWorker(
+		log,
+		client,
+		cfg,
+		serializer,
+		subscriptionRepository,
+		parkedEventStore,
+		projectionBuilderFunc,
+	)
+}
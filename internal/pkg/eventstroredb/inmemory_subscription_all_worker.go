@@ -0,0 +1,243 @@
+package eventstroredb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+
+	"emperror.dev/errors"
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+// inMemorySubscriptionAllWorker drives the projections with the `$all` feed of the in-memory event store, the same way as esdbSubscriptionAllWorker
+type inMemorySubscriptionAllWorker struct {
+	log                              logger.Logger
+	cfg                              *config.EventStoreDbOptions
+	eventStore                       es.InMemoryEventStore
+	subscriptionCheckpointRepository contracts.SubscriptionCheckpointRepository
+	parkedEventStore                 projection.IParkedEventStore
+	projectionPublisher              projection.IProjectionPublisher
+	projections                      []projection.IProjection
+	subscriptionId                   string
+	prefixes                         []string
+	liveSwitch                       liveSubscriptionSwitch
+}
+
+// errReplayCompleted stops the replay subscription after reaching the live checkpoint
+var errReplayCompleted = errors.Sentinel("replay completed")
+
+func NewInMemorySubscriptionAllWorker(
+	log logger.Logger,
+	cfg *config.EventStoreDbOptions,
+	eventStore es.InMemoryEventStore,
+	subscriptionRepository contracts.SubscriptionCheckpointRepository,
+	parkedEventStore projection.IParkedEventStore,
+	projectionBuilderFunc ProjectionBuilderFuc,
+) EsdbSubscriptionAllWorker {
+	builder := NewProjectionsBuilder()
+	if projectionBuilderFunc != nil {
+		projectionBuilderFunc(builder)
+	}
+	projectionConfigurations := builder.Build()
+
+	return &inMemorySubscriptionAllWorker{
+		log:                              log,
+		cfg:                              cfg,
+		eventStore:                       eventStore,
+		subscriptionCheckpointRepository: subscriptionRepository,
+		parkedEventStore:                 parkedEventStore,
+		projections:                      projectionConfigurations.Projections,
+	}
+}
+
+func (s *inMemorySubscriptionAllWorker) SubscribeAll(
+	ctx context.Context,
+	subscriptionOption *EventStoreDBSubscriptionToAllOptions,
+) error {
+	if subscriptionOption.SubscriptionId == "" {
+		subscriptionOption.SubscriptionId = "defaultLogger"
+	}
+
+	s.subscriptionId = subscriptionOption.SubscriptionId
+	s.projectionPublisher = newProjectionPublisher(
+		s.log,
+		s.cfg,
+		s.subscriptionId,
+		s.projections,
+		s.subscriptionCheckpointRepository,
+		s.parkedEventStore,
+	)
+
+	// the in-memory event store just supports the stream prefix filters
+	var prefixes []string
+	if subscriptionOption.FilterOptions != nil &&
+		subscriptionOption.FilterOptions.Type == esdb.StreamFilterType {
+		prefixes = subscriptionOption.FilterOptions.Prefixes
+	}
+	s.prefixes = prefixes
+
+	s.log.Info(fmt.Sprintf("starting in-memory subscription to all '%s'.", s.subscriptionId))
+
+	for {
+		liveCtx, err := s.liveSwitch.start(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = s.subscribeLive(liveCtx)
+		s.liveSwitch.stop()
+
+		// the live subscription is paused for rebuilding the projections, it continues from the checkpoint after the rebuild
+		if ctx.Err() == nil && liveCtx.Err() != nil {
+			s.log.Info(fmt.Sprintf("in-memory subscription to all '%s' paused.", s.subscriptionId))
+			continue
+		}
+
+		return err
+	}
+}
+
+func (s *inMemorySubscriptionAllWorker) subscribeLive(ctx context.Context) error {
+	checkpoint, err := s.subscriptionCheckpointRepository.Load(s.subscriptionId, ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.eventStore.SubscribeAll(ctx, checkpoint, s.prefixes, s.handleEvent)
+}
+
+func (s *inMemorySubscriptionAllWorker) RebuildProjections(
+	ctx context.Context,
+	options *RebuildProjectionsOptions,
+) (*RebuildProjectionsResult, error) {
+	subscriptionId, prefixes := s.rebuildSubscription(options)
+
+	rebuild, err := newProjectionsRebuild(
+		s.log,
+		s.subscriptionCheckpointRepository,
+		s.projections,
+		subscriptionId,
+		options,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resume, err := s.liveSwitch.pause(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	err = rebuild.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rebuild.complete(ctx, s.replay(ctx, rebuild, prefixes))
+}
+
+// rebuildSubscription gets the subscription id and the stream prefixes of the rebuild, from the running live subscription or the configuration
+func (s *inMemorySubscriptionAllWorker) rebuildSubscription(options *RebuildProjectionsOptions) (string, []string) {
+	subscriptionId := options.SubscriptionId
+	prefixes := s.prefixes
+
+	if s.subscriptionId == "" && s.cfg != nil && s.cfg.Subscription != nil {
+		if subscriptionId == "" {
+			subscriptionId = s.cfg.Subscription.SubscriptionId
+		}
+		prefixes = s.cfg.Subscription.Prefix
+	} else if subscriptionId == "" {
+		subscriptionId = s.subscriptionId
+	}
+
+	if subscriptionId == "" {
+		subscriptionId = "defaultLogger"
+	}
+
+	return subscriptionId, prefixes
+}
+
+// replay subscribes to `$all` from the start and stops the subscription after reaching the live checkpoint
+func (s *inMemorySubscriptionAllWorker) replay(
+	ctx context.Context,
+	rebuild *projectionsRebuild,
+	prefixes []string,
+) error {
+	if rebuild.progress.LivePosition == 0 {
+		return nil
+	}
+
+	err := s.eventStore.SubscribeAll(
+		ctx,
+		0,
+		prefixes,
+		func(ctx context.Context, streamEvent *models.StreamEvent) error {
+			position := uint64(streamEvent.Position)
+			if !rebuild.isReplayed(position) {
+				return errReplayCompleted
+			}
+
+			err := rebuild.replayEvent(ctx, streamEvent, position)
+			if err != nil {
+				return err
+			}
+
+			if position == rebuild.progress.LivePosition {
+				return errReplayCompleted
+			}
+
+			return nil
+		},
+	)
+	if errors.Is(err, errReplayCompleted) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *inMemorySubscriptionAllWorker) handleEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	s.log.Info(
+		fmt.Sprintf(
+			"event appeared in in-memory subscription to all '%s'. position: %d, revision: %d",
+			s.subscriptionId,
+			streamEvent.Position,
+			streamEvent.Version,
+		),
+	)
+
+	// the event is not handled in a shared transaction, each transactional projection writes its read model with its
+	// checkpoint in its own transaction
+	return s.projectEvent(ctx, streamEvent)
+}
+
+func (s *inMemorySubscriptionAllWorker) projectEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	// publish to internal event bus - for handling event and project it manually tp corresponding read model
+	err := mediatr.Publish(ctx, streamEvent)
+	if err != nil {
+		return errors.WrapIf(
+			err,
+			"failed to publish stream event for the mediatr (internal event bus for handling event)",
+		)
+	}
+
+	err = s.projectionPublisher.Publish(ctx, streamEvent)
+	if err != nil {
+		return errors.WrapIf(err, "failed to publish stream event in the handle event")
+	}
+
+	err = s.subscriptionCheckpointRepository.Store(s.subscriptionId, uint64(streamEvent.Position), ctx)
+	if err != nil {
+		return errors.WrapIf(err, "failed to store subscription checkpoint")
+	}
+
+	return nil
+}
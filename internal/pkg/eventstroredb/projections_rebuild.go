@@ -0,0 +1,357 @@
+package eventstroredb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+
+	"emperror.dev/errors"
+)
+
+const (
+	// progressReportInterval is the number of the replayed events between the progress reports
+	progressReportInterval = 100
+	replayPageSize         = 500
+)
+
+// RebuildProjectionsOptions are the options of rebuilding the projections of a subscription from the start of `$all`
+type RebuildProjectionsOptions struct {
+	// SubscriptionId is the subscription which its checkpoint will reset, the configured subscription is used when it is empty
+	SubscriptionId string
+	// Projections are the names of the projections for replaying, all projections are replayed when it is empty
+	Projections []string
+	// TruncateReadModels resets the read models of the projections which implement `projection.IResettableProjection` before replaying
+	TruncateReadModels bool
+	// OnProgress is called periodically during the replay and after finishing it
+	OnProgress func(progress *RebuildProjectionsProgress)
+}
+
+type RebuildProjectionsProgress struct {
+	SubscriptionId string
+	// Position is the global position of the last replayed event
+	Position uint64
+	// LivePosition is the checkpoint of the live subscription before the rebuild, the replay stops at this position
+	LivePosition    uint64
+	ProcessedEvents int64
+	Completed       bool
+}
+
+type RebuildProjectionsResult struct {
+	SubscriptionId  string
+	Projections     []string
+	Position        uint64
+	ProcessedEvents int64
+}
+
+// projectionsRebuild replays the events before the live checkpoint through the selected projections, the live subscription is paused
+// during the rebuild and continues from its previous checkpoint afterward. The replay doesn't publish the events to the mediatr, because
+// they are handled already in the live mode.
+type projectionsRebuild struct {
+	log                              logger.Logger
+	subscriptionCheckpointRepository contracts.SubscriptionCheckpointRepository
+	options                          *RebuildProjectionsOptions
+	projections                      []projection.IProjection
+	progress                         *RebuildProjectionsProgress
+}
+
+func newProjectionsRebuild(
+	log logger.Logger,
+	subscriptionCheckpointRepository contracts.SubscriptionCheckpointRepository,
+	allProjections []projection.IProjection,
+	subscriptionId string,
+	options *RebuildProjectionsOptions,
+) (*projectionsRebuild, error) {
+	projections, err := selectProjections(allProjections, options.Projections)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectionsRebuild{
+		log:                              log,
+		subscriptionCheckpointRepository: subscriptionCheckpointRepository,
+		options:                          options,
+		projections:                      projections,
+		progress:                         &RebuildProjectionsProgress{SubscriptionId: subscriptionId},
+	}, nil
+}
+
+// start truncates the read models if requested and resets the subscription checkpoint
+func (r *projectionsRebuild) start(ctx context.Context) error {
+	livePosition, err := r.subscriptionCheckpointRepository.Load(r.progress.SubscriptionId, ctx)
+	if err != nil {
+		return errors.WrapIf(err, "failed to load subscription checkpoint")
+	}
+	r.progress.LivePosition = livePosition
+
+	r.log.Info(
+		fmt.Sprintf(
+			"rebuilding projections %v of subscription '%s' up to position %d",
+			r.projectionNames(),
+			r.progress.SubscriptionId,
+			livePosition,
+		),
+	)
+
+	if r.options.TruncateReadModels {
+		for _, p := range r.projections {
+			resettable, ok := p.(projection.IResettableProjection)
+			if !ok {
+				continue
+			}
+
+			err := resettable.Reset(ctx)
+			if err != nil {
+				return errors.WrapIff(err, "failed to reset read models of projection '%s'", es.GetProjectionName(p))
+			}
+		}
+	}
+
+	err = r.storeProjectionCheckpoints(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	err = r.subscriptionCheckpointRepository.Store(r.progress.SubscriptionId, 0, ctx)
+	if err != nil {
+		return errors.WrapIf(err, "failed to reset subscription checkpoint")
+	}
+
+	return nil
+}
+
+// storeProjectionCheckpoints stores the checkpoints of the rebuilding projections, which are used by the projections publisher of the live subscription
+func (r *projectionsRebuild) storeProjectionCheckpoints(ctx context.Context, position uint64) error {
+	for _, p := range r.projections {
+		projectionName := es.GetProjectionName(p)
+
+		err := r.subscriptionCheckpointRepository.Store(
+			es.ProjectionCheckpointId(r.progress.SubscriptionId, projectionName),
+			position,
+			ctx,
+		)
+		if err != nil {
+			return errors.WrapIff(err, "failed to store checkpoint of projection '%s'", projectionName)
+		}
+	}
+
+	return nil
+}
+
+// isReplayed checks the event is already handled by the live subscription before the rebuild
+func (r *projectionsRebuild) isReplayed(position uint64) bool {
+	return position <= r.progress.LivePosition
+}
+
+func (r *projectionsRebuild) replayEvent(ctx context.Context, streamEvent *models.StreamEvent, position uint64) error {
+	for _, p := range r.projections {
+		err := p.ProcessEvent(projection.WithReplay(ctx), streamEvent)
+		if err != nil {
+			return errors.WrapIff(
+				err,
+				"failed to replay event at position %d in projection '%s'",
+				position,
+				es.GetProjectionName(p),
+			)
+		}
+	}
+
+	r.progress.ProcessedEvents++
+	r.moveTo(position)
+
+	err := r.subscriptionCheckpointRepository.Store(r.progress.SubscriptionId, position, ctx)
+	if err != nil {
+		return errors.WrapIf(err, "failed to store subscription checkpoint")
+	}
+
+	if r.progress.ProcessedEvents%progressReportInterval == 0 {
+		r.report()
+	}
+
+	return nil
+}
+
+// moveTo moves the replay position for the events which are skipped in the replay
+func (r *projectionsRebuild) moveTo(position uint64) {
+	r.progress.Position = position
+}
+
+// complete restores the live checkpoint, on failure the projections should be rebuilt again
+func (r *projectionsRebuild) complete(ctx context.Context, replayErr error) (*RebuildProjectionsResult, error) {
+	// the checkpoint should be restored even when the rebuild is canceled
+	err := r.subscriptionCheckpointRepository.Store(
+		r.progress.SubscriptionId,
+		r.progress.LivePosition,
+		context.WithoutCancel(ctx),
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to restore subscription checkpoint")
+	}
+
+	if replayErr != nil {
+		return nil, replayErr
+	}
+
+	err = r.storeProjectionCheckpoints(context.WithoutCancel(ctx), r.progress.LivePosition)
+	if err != nil {
+		return nil, err
+	}
+
+	r.progress.Position = r.progress.LivePosition
+	r.progress.Completed = true
+	r.report()
+
+	return &RebuildProjectionsResult{
+		SubscriptionId:  r.progress.SubscriptionId,
+		Projections:     r.projectionNames(),
+		Position:        r.progress.Position,
+		ProcessedEvents: r.progress.ProcessedEvents,
+	}, nil
+}
+
+func (r *projectionsRebuild) report() {
+	r.log.Info(
+		fmt.Sprintf(
+			"rebuilding projections of subscription '%s': %d events replayed, position %d of %d",
+			r.progress.SubscriptionId,
+			r.progress.ProcessedEvents,
+			r.progress.Position,
+			r.progress.LivePosition,
+		),
+	)
+
+	if r.options.OnProgress != nil {
+		progress := *r.progress
+		r.options.OnProgress(&progress)
+	}
+}
+
+func (r *projectionsRebuild) projectionNames() []string {
+	names := make([]string, 0, len(r.projections))
+	for _, p := range r.projections {
+		names = append(names, es.GetProjectionName(p))
+	}
+
+	return names
+}
+
+func selectProjections(allProjections []projection.IProjection, names []string) ([]projection.IProjection, error) {
+	if len(names) == 0 {
+		return allProjections, nil
+	}
+
+	var projections []projection.IProjection
+	for _, name := range names {
+		var found projection.IProjection
+		for _, p := range allProjections {
+			if strings.EqualFold(es.GetProjectionName(p), name) {
+				found = p
+				break
+			}
+		}
+
+		if found == nil {
+			return nil, errors.Errorf("projection '%s' is not registered", name)
+		}
+
+		projections = append(projections, found)
+	}
+
+	return projections, nil
+}
+
+func hasStreamPrefix(streamId string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(streamId, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// liveSubscriptionSwitch pauses the live subscription during the projections rebuild and resumes it afterward
+type liveSubscriptionSwitch struct {
+	mu sync.Mutex
+	// cancel and stopped belong to the running live subscription
+	cancel  context.CancelFunc
+	stopped chan struct{}
+	// resumed is not nil during a rebuild, and it is closed when the rebuild is finished
+	resumed chan struct{}
+}
+
+// start waits for the running rebuild and creates the context of the live subscription
+func (l *liveSubscriptionSwitch) start(ctx context.Context) (context.Context, error) {
+	for {
+		l.mu.Lock()
+		resumed := l.resumed
+		if resumed == nil {
+			liveCtx, cancel := context.WithCancel(ctx)
+			l.cancel = cancel
+			l.stopped = make(chan struct{})
+			l.mu.Unlock()
+
+			return liveCtx, nil
+		}
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-resumed:
+		}
+	}
+}
+
+// stop should be called when the live subscription is finished
+func (l *liveSubscriptionSwitch) stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.stopped != nil {
+		l.cancel()
+		close(l.stopped)
+		l.cancel = nil
+		l.stopped = nil
+	}
+}
+
+// pause stops the live subscription and waits for it, the returned func resumes the live subscription
+func (l *liveSubscriptionSwitch) pause(ctx context.Context) (func(), error) {
+	l.mu.Lock()
+	if l.resumed != nil {
+		l.mu.Unlock()
+		return nil, errors.New("projections rebuild is already running")
+	}
+
+	l.resumed = make(chan struct{})
+	cancel, stopped := l.cancel, l.stopped
+	l.mu.Unlock()
+
+	resume := func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		close(l.resumed)
+		l.resumed = nil
+	}
+
+	if cancel != nil {
+		cancel()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			resume()
+			return nil, ctx.Err()
+		}
+	}
+
+	return resume, nil
+}
@@ -0,0 +1,175 @@
+//go:build unit
+// +build unit
+
+package eventstroredb
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	streamName "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_name"
+	expectedStreamVersion "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models/stream_version"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rebuildSubscriptionId = "orders-subscription"
+
+func Test_Rebuild_Projections_Should_Replay_Selected_Projections(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	eventStore := es.NewInMemoryEventStore()
+	checkpointRepository := es.NewInMemorySubscriptionCheckpointRepository()
+	ordersProjection := &recordingProjection{name: "orders"}
+	auditProjection := &recordingProjection{name: "audit"}
+
+	worker := NewInMemorySubscriptionAllWorker(
+		empty.EmptyLogger,
+		&config.EventStoreDbOptions{UseInMemory: true},
+		eventStore,
+		checkpointRepository,
+		es.NewInMemoryParkedEventStore(),
+		func(builder ProjectionsBuilder) {
+			builder.AddProjections([]projection.IProjection{ordersProjection, auditProjection})
+		},
+	)
+
+	orderStream := streamName.StreamName("order-" + uuid.NewV4().String())
+	_, err := eventStore.AppendNewEvents(orderStream, newRebuildTestEvents(3), ctx)
+	require.NoError(t, err)
+	_, err = eventStore.AppendNewEvents(
+		streamName.StreamName("customer-"+uuid.NewV4().String()),
+		newRebuildTestEvents(2),
+		ctx,
+	)
+	require.NoError(t, err)
+
+	go func() {
+		_ = worker.SubscribeAll(ctx, &EventStoreDBSubscriptionToAllOptions{
+			SubscriptionId: rebuildSubscriptionId,
+			FilterOptions:  &esdb.SubscriptionFilter{Type: esdb.StreamFilterType, Prefixes: []string{"order-"}},
+		})
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(ordersProjection.processedPositions()) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var progresses []*RebuildProjectionsProgress
+	result, err := worker.RebuildProjections(ctx, &RebuildProjectionsOptions{
+		Projections:        []string{"orders"},
+		TruncateReadModels: true,
+		OnProgress: func(progress *RebuildProjectionsProgress) {
+			progresses = append(progresses, progress)
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, rebuildSubscriptionId, result.SubscriptionId)
+	assert.Equal(t, []string{"orders"}, result.Projections)
+	assert.Equal(t, int64(3), result.ProcessedEvents)
+	assert.Equal(t, uint64(3), result.Position)
+	assert.True(t, progresses[len(progresses)-1].Completed)
+
+	// just the selected projection is reset and replayed
+	assert.Equal(t, 1, ordersProjection.resetCount())
+	assert.Equal(t, []int64{1, 2, 3}, ordersProjection.processedPositions())
+	assert.Equal(t, 0, auditProjection.resetCount())
+	assert.Equal(t, []int64{1, 2, 3}, auditProjection.processedPositions())
+
+	checkpoint, err := checkpointRepository.Load(rebuildSubscriptionId, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), checkpoint)
+
+	// the live subscription continues after the rebuild
+	_, err = eventStore.AppendEvents(orderStream, expectedStreamVersion.FromInt64(2), newRebuildTestEvents(1), ctx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(auditProjection.processedPositions()) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int64{1, 2, 3, 6}, ordersProjection.processedPositions())
+}
+
+func Test_Rebuild_Projections_Should_Fail_For_Unknown_Projection(t *testing.T) {
+	worker := NewInMemorySubscriptionAllWorker(
+		empty.EmptyLogger,
+		&config.EventStoreDbOptions{UseInMemory: true},
+		es.NewInMemoryEventStore(),
+		es.NewInMemorySubscriptionCheckpointRepository(),
+		es.NewInMemoryParkedEventStore(),
+		func(builder ProjectionsBuilder) {
+			builder.AddProjection(&recordingProjection{name: "orders"})
+		},
+	)
+
+	_, err := worker.RebuildProjections(
+		context.Background(),
+		&RebuildProjectionsOptions{Projections: []string{"products"}},
+	)
+	assert.Error(t, err)
+}
+
+type recordingProjection struct {
+	mu        sync.Mutex
+	name      string
+	positions []int64
+	resets    int
+}
+
+func (p *recordingProjection) ProjectionName() string {
+	return p.name
+}
+
+func (p *recordingProjection) ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.positions = append(p.positions, streamEvent.Position)
+
+	return nil
+}
+
+func (p *recordingProjection) Reset(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.positions = nil
+	p.resets++
+
+	return nil
+}
+
+func (p *recordingProjection) processedPositions() []int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]int64(nil), p.positions...)
+}
+
+func (p *recordingProjection) resetCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.resets
+}
+
+func newRebuildTestEvents(count int) []*models.StreamEvent {
+	var events []*models.StreamEvent
+	for i := 0; i < count; i++ {
+		events = append(events, &models.StreamEvent{EventID: uuid.NewV4()})
+	}
+
+	return events
+}
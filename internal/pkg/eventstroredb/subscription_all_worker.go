@@ -3,11 +3,14 @@ package eventstroredb
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
@@ -25,7 +28,10 @@ type esdbSubscriptionAllWorker struct {
 	esdbSerializer                   *EsdbSerializer
 	subscriptionCheckpointRepository contracts.SubscriptionCheckpointRepository
 	subscriptionId                   string
+	parkedEventStore                 projection.IParkedEventStore
 	projectionPublisher              projection.IProjectionPublisher
+	projections                      []projection.IProjection
+	liveSwitch                       liveSubscriptionSwitch
 }
 
 type EsdbSubscriptionAllWorker interface {
@@ -33,6 +39,12 @@ type EsdbSubscriptionAllWorker interface {
 		ctx context.Context,
 		subscriptionOption *EventStoreDBSubscriptionToAllOptions,
 	) error
+	// RebuildProjections resets the subscription checkpoint and replays `$all` through the selected projections, the live subscription
+	// is paused during the rebuild and continues afterward
+	RebuildProjections(
+		ctx context.Context,
+		options *RebuildProjectionsOptions,
+	) (*RebuildProjectionsResult, error)
 }
 
 type EventStoreDBSubscriptionToAllOptions struct {
@@ -50,6 +62,7 @@ func NewEsdbSubscriptionAllWorker(
 	cfg *config.EventStoreDbOptions,
 	esdbSerializer *EsdbSerializer,
 	subscriptionRepository contracts.SubscriptionCheckpointRepository,
+	parkedEventStore projection.IParkedEventStore,
 	projectionBuilderFunc ProjectionBuilderFuc,
 ) EsdbSubscriptionAllWorker {
 	builder := NewProjectionsBuilder()
@@ -57,7 +70,6 @@ func NewEsdbSubscriptionAllWorker(
 		projectionBuilderFunc(builder)
 	}
 	projectionConfigurations := builder.Build()
-	projectionPublisher := es.NewProjectionPublisher(projectionConfigurations.Projections)
 
 	return &esdbSubscriptionAllWorker{
 		db:                               db,
@@ -65,10 +77,35 @@ func NewEsdbSubscriptionAllWorker(
 		log:                              log,
 		esdbSerializer:                   esdbSerializer,
 		subscriptionCheckpointRepository: subscriptionRepository,
-		projectionPublisher:              projectionPublisher,
+		parkedEventStore:                 parkedEventStore,
+		projections:                      projectionConfigurations.Projections,
 	}
 }
 
+// newProjectionPublisher creates the projections publisher of a subscription, each projection has its own checkpoint and retry policy
+func newProjectionPublisher(
+	log logger.Logger,
+	cfg *config.EventStoreDbOptions,
+	subscriptionId string,
+	projections []projection.IProjection,
+	subscriptionCheckpointRepository contracts.SubscriptionCheckpointRepository,
+	parkedEventStore projection.IParkedEventStore,
+) projection.IProjectionPublisher {
+	options := &es.ProjectionPublisherOptions{
+		SubscriptionId:       subscriptionId,
+		CheckpointRepository: subscriptionCheckpointRepository,
+		ParkedEventStore:     parkedEventStore,
+		Logger:               log,
+	}
+	if cfg != nil {
+		options.RetryAttempts = cfg.Projections.RetryAttempts
+		options.RetryDelay = cfg.Projections.RetryDelay
+		options.MaxRetryDelay = cfg.Projections.MaxRetryDelay
+	}
+
+	return es.NewProjectionPublisherWithOptions(projections, options)
+}
+
 func (s *esdbSubscriptionAllWorker) SubscribeAll(
 	ctx context.Context,
 	subscriptionOption *EventStoreDBSubscriptionToAllOptions,
@@ -83,9 +120,40 @@ func (s *esdbSubscriptionAllWorker) SubscribeAll(
 
 	s.subscriptionOption = subscriptionOption
 	s.subscriptionId = subscriptionOption.SubscriptionId
+	s.projectionPublisher = newProjectionPublisher(
+		s.log,
+		s.cfg,
+		s.subscriptionId,
+		s.projections,
+		s.subscriptionCheckpointRepository,
+		s.parkedEventStore,
+	)
 
 	s.log.Info(fmt.Sprintf("starting subscription to all '%s'.", subscriptionOption.SubscriptionId))
 
+	for {
+		liveCtx, err := s.liveSwitch.start(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = s.subscribeLive(liveCtx, subscriptionOption)
+		s.liveSwitch.stop()
+
+		// the live subscription is paused for rebuilding the projections, it continues from the checkpoint after the rebuild
+		if ctx.Err() == nil && liveCtx.Err() != nil {
+			s.log.Info(fmt.Sprintf("subscription to all '%s' paused.", subscriptionOption.SubscriptionId))
+			continue
+		}
+
+		return err
+	}
+}
+
+func (s *esdbSubscriptionAllWorker) subscribeLive(
+	ctx context.Context,
+	subscriptionOption *EventStoreDBSubscriptionToAllOptions,
+) error {
 	checkpoint, err := s.subscriptionCheckpointRepository.Load(
 		subscriptionOption.SubscriptionId,
 		ctx,
@@ -117,6 +185,9 @@ func (s *esdbSubscriptionAllWorker) SubscribeAll(
 	for {
 		stream, err := s.db.SubscribeToAll(ctx, options)
 		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			time.Sleep(1 * time.Second)
 			continue
 		}
@@ -169,6 +240,157 @@ func (s *esdbSubscriptionAllWorker) SubscribeAll(
 	}
 }
 
+func (s *esdbSubscriptionAllWorker) RebuildProjections(
+	ctx context.Context,
+	options *RebuildProjectionsOptions,
+) (*RebuildProjectionsResult, error) {
+	subscriptionId, prefixes := s.rebuildSubscription(options)
+
+	rebuild, err := newProjectionsRebuild(
+		s.log,
+		s.subscriptionCheckpointRepository,
+		s.projections,
+		subscriptionId,
+		options,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resume, err := s.liveSwitch.pause(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	err = rebuild.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rebuild.complete(ctx, s.replay(ctx, rebuild, prefixes))
+}
+
+// rebuildSubscription gets the subscription id and the stream prefixes of the rebuild, from the running live subscription or the configuration
+func (s *esdbSubscriptionAllWorker) rebuildSubscription(options *RebuildProjectionsOptions) (string, []string) {
+	subscriptionId := options.SubscriptionId
+
+	var prefixes []string
+	if s.subscriptionOption != nil {
+		if subscriptionId == "" {
+			subscriptionId = s.subscriptionOption.SubscriptionId
+		}
+		if s.subscriptionOption.FilterOptions != nil &&
+			s.subscriptionOption.FilterOptions.Type == esdb.StreamFilterType {
+			prefixes = s.subscriptionOption.FilterOptions.Prefixes
+		}
+	} else if s.cfg != nil && s.cfg.Subscription != nil {
+		if subscriptionId == "" {
+			subscriptionId = s.cfg.Subscription.SubscriptionId
+		}
+		prefixes = s.cfg.Subscription.Prefix
+	}
+
+	if subscriptionId == "" {
+		subscriptionId = "defaultLogger"
+	}
+
+	return subscriptionId, prefixes
+}
+
+// replay reads `$all` page by page up to the live checkpoint
+// https://developers.eventstore.com/clients/grpc/reading-events.html#reading-from-the-all-stream
+func (s *esdbSubscriptionAllWorker) replay(
+	ctx context.Context,
+	rebuild *projectionsRebuild,
+	prefixes []string,
+) error {
+	var from esdb.AllPosition = esdb.Start{}
+	var last *esdb.Position
+
+	for {
+		readStream, err := s.db.ReadAll(
+			ctx,
+			esdb.ReadAllOptions{Direction: esdb.Forwards, From: from, ResolveLinkTos: true},
+			replayPageSize,
+		)
+		if err != nil {
+			return errors.WrapIf(err, "failed to read all stream")
+		}
+
+		count, done, err := s.replayPage(ctx, readStream, rebuild, prefixes, &last)
+		readStream.Close()
+		if err != nil {
+			return err
+		}
+
+		if done || count < replayPageSize || last == nil {
+			return nil
+		}
+
+		// the start position of reading `$all` is inclusive
+		from = *last
+	}
+}
+
+func (s *esdbSubscriptionAllWorker) replayPage(
+	ctx context.Context,
+	readStream *esdb.ReadStream,
+	rebuild *projectionsRebuild,
+	prefixes []string,
+	last **esdb.Position,
+) (uint64, bool, error) {
+	var count uint64
+	for {
+		resolvedEvent, err := readStream.Recv()
+		if errors.Is(err, io.EOF) {
+			return count, false, nil
+		}
+		if err != nil {
+			return count, false, errors.WrapIf(err, "failed to read all stream")
+		}
+		count++
+
+		position := resolvedEvent.OriginalEvent().Position
+		if *last != nil && position == **last {
+			continue
+		}
+		*last = &position
+
+		if !rebuild.isReplayed(position.Commit) {
+			return count, true, nil
+		}
+
+		if !s.shouldReplay(resolvedEvent, prefixes) {
+			rebuild.moveTo(position.Commit)
+			continue
+		}
+
+		streamEvent, err := s.esdbSerializer.ResolvedEventToStreamEvent(resolvedEvent)
+		if err != nil {
+			return count, false, errors.WrapIf(err, "failed to convert resolved event to stream event")
+		}
+
+		err = rebuild.replayEvent(ctx, streamEvent, position.Commit)
+		if err != nil {
+			return count, false, err
+		}
+	}
+}
+
+func (s *esdbSubscriptionAllWorker) shouldReplay(resolvedEvent *esdb.ResolvedEvent, prefixes []string) bool {
+	event := resolvedEvent.OriginalEvent()
+	if strings.HasPrefix(event.EventType, "$") || strings.HasPrefix(event.StreamID, "$") {
+		return false
+	}
+
+	if len(prefixes) > 0 && !hasStreamPrefix(event.StreamID, prefixes) {
+		return false
+	}
+
+	return !s.isCheckpointEvent(resolvedEvent) && !s.isEventWithEmptyData(resolvedEvent)
+}
+
 func (s *esdbSubscriptionAllWorker) handleEvent(
 	ctx context.Context,
 	resolvedEvent *esdb.ResolvedEvent,
@@ -182,8 +404,20 @@ func (s *esdbSubscriptionAllWorker) handleEvent(
 		return errors.WrapIf(err, "failed to convert resolved event to stream event")
 	}
 
+	// the event is not handled in a shared transaction, the mediatr handlers and the non-transactional projections (e.g.
+	// elasticsearch) would repeat their side effects on a retried transaction, and a failed projection would abort the
+	// writes of the other projections. each transactional projection writes its read model with its checkpoint in its own
+	// transaction
+	return s.projectEvent(ctx, resolvedEvent, streamEvent)
+}
+
+func (s *esdbSubscriptionAllWorker) projectEvent(
+	ctx context.Context,
+	resolvedEvent *esdb.ResolvedEvent,
+	streamEvent *models.StreamEvent,
+) error {
 	// publish to internal event bus - for handling event and project it manually tp corresponding read model
-	err = mediatr.Publish(ctx, streamEvent)
+	err := mediatr.Publish(ctx, streamEvent)
 	if err != nil {
 		return errors.WrapIf(
 			err,
@@ -11,7 +11,6 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type grpcClient struct {
@@ -25,12 +24,25 @@ type GrpcClient interface {
 	WaitForAvailableConnection() error
 }
 
-func NewGrpcClient(config *config.GrpcOptions) (GrpcClient, error) {
+// NewGrpcClient creates the client with the interceptors of the services, the interceptors are provided with fx value groups
+// which are not ordered, so they should not depend on each other order
+func NewGrpcClient(
+	config *config.GrpcOptions,
+	unaryInterceptors []grpc.UnaryClientInterceptor,
+	streamInterceptors []grpc.StreamClientInterceptor,
+) (GrpcClient, error) {
+	clientCredentials, err := NewClientCredentials(config.Tls)
+	if err != nil {
+		return nil, err
+	}
+
 	// Grpc Client to call Grpc Server
 	// https://sahansera.dev/building-grpc-client-go/
 	// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/df16f32df86b40077c9c90d06f33c4cdb6dd5afa/instrumentation/google.golang.org/grpc/otelgrpc/example_interceptor_test.go
 	conn, err := grpc.Dial(fmt.Sprintf("%s%s", config.Host, config.Port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(clientCredentials),
+		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
+		grpc.WithChainStreamInterceptor(streamInterceptors...),
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/main/instrumentation/google.golang.org/grpc/otelgrpc/example/client/main.go#L47C3-L47C52
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/main/instrumentation/google.golang.org/grpc/otelgrpc/doc.go
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
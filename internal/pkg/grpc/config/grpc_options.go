@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config/environment"
 	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
@@ -15,6 +17,22 @@ type GrpcOptions struct {
 	Host        string `mapstructure:"host"        env:"Host"`
 	Development bool   `mapstructure:"development" env:"Development"`
 	Name        string `mapstructure:"name"        env:"ShortTypeName"`
+	// Tls secures the server and the client connections, they use the insecure transport when it is not enabled
+	Tls TlsOptions `mapstructure:"tls"`
+}
+
+type TlsOptions struct {
+	Enabled bool `mapstructure:"enabled" env:"TlsEnabled"`
+	// CertFile and KeyFile are the certificate of the server, the client also presents them as its certificate for mTLS
+	CertFile string `mapstructure:"certFile" env:"TlsCertFile"`
+	KeyFile  string `mapstructure:"keyFile"  env:"TlsKeyFile"`
+	// CaFile verifies the peer certificates, the server requires and verifies the client certificates (mTLS) when it is set
+	// and the client uses it instead of the system root CAs
+	CaFile string `mapstructure:"caFile" env:"TlsCaFile"`
+	// ServerName overrides the host name which is verified in the server certificate by the client
+	ServerName string `mapstructure:"serverName" env:"TlsServerName"`
+	// ReloadInterval is the interval of checking the certificate files, the rotated certificates are used by the new connections
+	ReloadInterval time.Duration `mapstructure:"reloadInterval" default:"1m"`
 }
 
 func ProvideConfig(environment environment.Environment) (*GrpcOptions, error) {
@@ -0,0 +1,288 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/grpc/config"
+
+	"emperror.dev/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// http2Protocol is the ALPN protocol of grpc
+const http2Protocol = "h2"
+
+// certificateReloader keeps the certificate and the CA pool of the tls options, the files are checked again on the handshakes
+// after the reload interval, so the rotated certificates are used without restarting the service
+type certificateReloader struct {
+	options     config.TlsOptions
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	caPool      *x509.CertPool
+	modTimes    map[string]time.Time
+	lastCheck   time.Time
+}
+
+func newCertificateReloader(options config.TlsOptions) (*certificateReloader, error) {
+	r := &certificateReloader{options: options, modTimes: map[string]time.Time{}}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewServerCredentials creates the transport credentials of the server, the client certificates are required when the CA file
+// is set (mTLS)
+func NewServerCredentials(options config.TlsOptions) (credentials.TransportCredentials, error) {
+	if !options.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	if options.CertFile == "" || options.KeyFile == "" {
+		return nil, errors.New("grpc tls server needs the certificate and the key files")
+	}
+
+	reloader, err := newCertificateReloader(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the config is created for each handshake, so the reloaded certificate and CA pool are used by the new connections
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certificate, caPool := reloader.current()
+
+			// grpc adds the http/2 ALPN just to the base config, so it is set on the config of the handshake
+			serverConfig := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*certificate},
+				NextProtos:   []string{http2Protocol},
+			}
+			if caPool != nil {
+				serverConfig.ClientCAs = caPool
+				serverConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return serverConfig, nil
+		},
+	}), nil
+}
+
+// NewClientCredentials creates the transport credentials of the client, the certificate is presented to the servers which
+// require mTLS and the CA file replaces the system root CAs for verifying the server certificate
+func NewClientCredentials(options config.TlsOptions) (credentials.TransportCredentials, error) {
+	if !options.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	reloader, err := newCertificateReloader(options)
+	if err != nil {
+		return nil, err
+	}
+
+	clientConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: options.ServerName,
+	}
+
+	if options.CertFile != "" {
+		clientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certificate, _ := reloader.current()
+
+			return certificate, nil
+		}
+	}
+
+	if options.CaFile == "" {
+		return credentials.NewTLS(clientConfig), nil
+	}
+
+	// the root CAs of the tls config can't change after dialing, so the server certificate is verified with the reloaded CA
+	// pool in `VerifyConnection` instead of the default verification
+	clientConfig.InsecureSkipVerify = true
+
+	return &clientCredentials{
+		TransportCredentials: credentials.NewTLS(clientConfig),
+		config:               clientConfig,
+		reloader:             reloader,
+	}, nil
+}
+
+// clientCredentials verifies the server certificate with the reloaded CA pool, for the configured server name or the host of
+// the dialed authority
+type clientCredentials struct {
+	credentials.TransportCredentials
+	config   *tls.Config
+	reloader *certificateReloader
+}
+
+func (c *clientCredentials) ClientHandshake(
+	ctx context.Context,
+	authority string,
+	rawConn net.Conn,
+) (net.Conn, credentials.AuthInfo, error) {
+	// `tls.ConnectionState.ServerName` is empty for the IP addresses, so the verified host is kept for the handshake
+	serverName := c.config.ServerName
+	if serverName == "" {
+		serverName = authorityHost(authority)
+	}
+
+	handshakeConfig := c.config.Clone()
+	handshakeConfig.ServerName = serverName
+	handshakeConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		_, caPool := c.reloader.current()
+
+		return verifyServerCertificate(state, caPool, serverName)
+	}
+
+	return credentials.NewTLS(handshakeConfig).ClientHandshake(ctx, authority, rawConn)
+}
+
+func (c *clientCredentials) Clone() credentials.TransportCredentials {
+	return &clientCredentials{
+		TransportCredentials: c.TransportCredentials.Clone(),
+		config:               c.config.Clone(),
+		reloader:             c.reloader,
+	}
+}
+
+func authorityHost(authority string) string {
+	host, _, err := net.SplitHostPort(authority)
+	if err != nil {
+		// the authority doesn't have a port
+		return authority
+	}
+
+	return host
+}
+
+// verifyServerCertificate verifies the certificate chain with the CA pool and the host with the DNS names of the certificate,
+// or with its IP addresses when the host is an IP address
+func verifyServerCertificate(state tls.ConnectionState, caPool *x509.CertPool, serverName string) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("grpc server didn't present a certificate")
+	}
+
+	if serverName == "" {
+		return errors.New("grpc server name is empty for verifying the server certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range state.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         caPool,
+		Intermediates: intermediates,
+	})
+
+	return errors.WrapIf(err, "error in verifying the grpc server certificate")
+}
+
+// current returns the loaded certificate and CA pool, the files are loaded again when they are changed since the last check
+func (r *certificateReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	shouldCheck := time.Since(r.lastCheck) >= r.options.ReloadInterval
+	certificate, caPool := r.certificate, r.caPool
+	r.mu.RUnlock()
+
+	if !shouldCheck {
+		return certificate, caPool
+	}
+
+	if r.changed() {
+		// a failed reload keeps the previous certificates, e.g. when the key file is not written yet
+		_ = r.load()
+	} else {
+		r.mu.Lock()
+		r.lastCheck = time.Now()
+		r.mu.Unlock()
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.certificate, r.caPool
+}
+
+func (r *certificateReloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *certificateReloader) load() error {
+	var certificate *tls.Certificate
+	var caPool *x509.CertPool
+
+	if r.options.CertFile != "" {
+		loaded, err := tls.LoadX509KeyPair(r.options.CertFile, r.options.KeyFile)
+		if err != nil {
+			return errors.WrapIf(err, "error in loading the grpc tls certificate")
+		}
+		certificate = &loaded
+	}
+
+	if r.options.CaFile != "" {
+		ca, err := os.ReadFile(r.options.CaFile)
+		if err != nil {
+			return errors.WrapIf(err, "error in reading the grpc tls CA file")
+		}
+
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(ca) {
+			return errors.Errorf("no certificate is found in the grpc tls CA file '%s'", r.options.CaFile)
+		}
+	}
+
+	modTimes := map[string]time.Time{}
+	for _, file := range r.files() {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.certificate = certificate
+	r.caPool = caPool
+	r.modTimes = modTimes
+	r.lastCheck = time.Now()
+
+	return nil
+}
+
+func (r *certificateReloader) files() []string {
+	var files []string
+	for _, file := range []string{r.options.CertFile, r.options.KeyFile, r.options.CaFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
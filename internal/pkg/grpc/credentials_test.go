@@ -0,0 +1,320 @@
+//go:build unit
+// +build unit
+
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/grpc/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	googleGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func Test_Grpc_Server_And_Client_Should_Communicate_With_Mtls_And_Interceptors(t *testing.T) {
+	certs := newTestCertificates(t)
+
+	var serverCalls, clientCalls int
+	address := startTestGrpcServer(t, certs.serverOptions(), func(
+		ctx context.Context,
+		req interface{},
+		info *googleGrpc.UnaryServerInfo,
+		handler googleGrpc.UnaryHandler,
+	) (interface{}, error) {
+		serverCalls++
+		return handler(ctx, req)
+	})
+
+	client, err := NewGrpcClient(
+		certs.clientOptions(address, true),
+		[]googleGrpc.UnaryClientInterceptor{func(
+			ctx context.Context,
+			method string,
+			req, reply interface{},
+			cc *googleGrpc.ClientConn,
+			invoker googleGrpc.UnaryInvoker,
+			opts ...googleGrpc.CallOption,
+		) error {
+			clientCalls++
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}},
+		nil,
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = grpc_health_v1.NewHealthClient(client.GetGrpcConnection()).
+		Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, serverCalls)
+	assert.Equal(t, 1, clientCalls)
+}
+
+func Test_Grpc_Server_Should_Reject_Client_Without_Certificate_When_Mtls_Is_Enabled(t *testing.T) {
+	certs := newTestCertificates(t)
+	address := startTestGrpcServer(t, certs.serverOptions())
+
+	client, err := NewGrpcClient(certs.clientOptions(address, false), nil, nil)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = grpc_health_v1.NewHealthClient(client.GetGrpcConnection()).
+		Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.Error(t, err)
+}
+
+func Test_Grpc_Client_Should_Verify_Ip_Address_Of_Authority_Without_Server_Name(t *testing.T) {
+	certs := newTestCertificates(t)
+	address := startTestGrpcServer(t, certs.serverOptions())
+
+	// the server certificate is just valid for localhost
+	options := certs.clientOptions(address, true)
+	options.Tls.ServerName = ""
+	client, err := NewGrpcClient(options, nil, nil)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = grpc_health_v1.NewHealthClient(client.GetGrpcConnection()).
+		Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.Error(t, err)
+
+	// the server certificate with the IP address of the authority
+	certs.writeCertificate(t, "server", "127.0.0.1")
+	ipAddress := startTestGrpcServer(t, certs.serverOptions())
+
+	options = certs.clientOptions(ipAddress, true)
+	options.Tls.ServerName = ""
+	ipClient, err := NewGrpcClient(options, nil, nil)
+	require.NoError(t, err)
+	defer ipClient.Close()
+
+	_, err = grpc_health_v1.NewHealthClient(ipClient.GetGrpcConnection()).
+		Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+}
+
+func Test_Grpc_Server_Credentials_Should_Negotiate_Http2_Alpn(t *testing.T) {
+	certs := newTestCertificates(t)
+	address := startTestGrpcServer(t, certs.serverOptions())
+
+	clientCertificate, err := tls.LoadX509KeyPair(
+		filepath.Join(certs.dir, "client.pem"),
+		filepath.Join(certs.dir, "client-key.pem"),
+	)
+	require.NoError(t, err)
+
+	caPool := x509.NewCertPool()
+	caPool.AddCert(certs.ca)
+
+	conn, err := tls.Dial("tcp", address, &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		ServerName:   "localhost",
+		RootCAs:      caPool,
+		Certificates: []tls.Certificate{clientCertificate},
+		NextProtos:   []string{"h2"},
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	assert.Equal(t, "h2", conn.ConnectionState().NegotiatedProtocol)
+}
+
+func Test_Certificate_Reloader_Should_Reload_Rotated_Certificate(t *testing.T) {
+	certs := newTestCertificates(t)
+	options := certs.serverOptions().Tls
+	options.ReloadInterval = time.Millisecond
+
+	reloader, err := newCertificateReloader(options)
+	require.NoError(t, err)
+
+	certificate, _ := reloader.current()
+	oldSerial := leafSerial(t, certificate)
+
+	// rotating the server certificate
+	certs.writeCertificate(t, "server", "localhost")
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(options.CertFile, future, future))
+	time.Sleep(5 * time.Millisecond)
+
+	certificate, _ = reloader.current()
+	assert.NotEqual(t, oldSerial, leafSerial(t, certificate))
+}
+
+func Test_Credentials_Should_Be_Insecure_When_Tls_Is_Disabled(t *testing.T) {
+	serverCredentials, err := NewServerCredentials(config.TlsOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "insecure", serverCredentials.Info().SecurityProtocol)
+
+	clientCredentials, err := NewClientCredentials(config.TlsOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "insecure", clientCredentials.Info().SecurityProtocol)
+}
+
+func startTestGrpcServer(
+	t *testing.T,
+	options *config.GrpcOptions,
+	unaryInterceptors ...googleGrpc.UnaryServerInterceptor,
+) string {
+	t.Helper()
+
+	server, err := NewGrpcServer(options, empty.EmptyLogger, unaryInterceptors, nil)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		_ = server.GetCurrentGrpcServer().Serve(listener)
+	}()
+	t.Cleanup(server.GetCurrentGrpcServer().Stop)
+
+	return listener.Addr().String()
+}
+
+func leafSerial(t *testing.T, certificate *tls.Certificate) *big.Int {
+	t.Helper()
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.NoError(t, err)
+
+	return leaf.SerialNumber
+}
+
+type testCertificates struct {
+	dir    string
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caFile string
+}
+
+func newTestCertificates(t *testing.T) *testCertificates {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          newSerial(t),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	certs := &testCertificates{dir: t.TempDir(), ca: ca, caKey: caKey}
+	certs.caFile = filepath.Join(certs.dir, "ca.pem")
+	writePem(t, certs.caFile, "CERTIFICATE", der)
+
+	certs.writeCertificate(t, "server", "localhost")
+	certs.writeCertificate(t, "client", "client")
+
+	return certs
+}
+
+func (c *testCertificates) writeCertificate(t *testing.T, name string, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: newSerial(t),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if ip := net.ParseIP(commonName); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{commonName}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.ca, &key.PublicKey, c.caKey)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	writePem(t, filepath.Join(c.dir, name+".pem"), "CERTIFICATE", der)
+	writePem(t, filepath.Join(c.dir, name+"-key.pem"), "EC PRIVATE KEY", keyDer)
+}
+
+func (c *testCertificates) serverOptions() *config.GrpcOptions {
+	return &config.GrpcOptions{
+		Name: "test",
+		Tls: config.TlsOptions{
+			Enabled:        true,
+			CertFile:       filepath.Join(c.dir, "server.pem"),
+			KeyFile:        filepath.Join(c.dir, "server-key.pem"),
+			CaFile:         c.caFile,
+			ReloadInterval: time.Minute,
+		},
+	}
+}
+
+func (c *testCertificates) clientOptions(address string, withCertificate bool) *config.GrpcOptions {
+	options := &config.GrpcOptions{
+		Host: address,
+		Tls: config.TlsOptions{
+			Enabled:        true,
+			CaFile:         c.caFile,
+			ServerName:     "localhost",
+			ReloadInterval: time.Minute,
+		},
+	}
+
+	if withCertificate {
+		options.Tls.CertFile = filepath.Join(c.dir, "client.pem")
+		options.Tls.KeyFile = filepath.Join(c.dir, "client-key.pem")
+	}
+
+	return options
+}
+
+func newSerial(t *testing.T) *big.Int {
+	t.Helper()
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	return serial
+}
+
+func writePem(t *testing.T, file string, blockType string, bytes []byte) {
+	t.Helper()
+
+	err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0o600)
+	require.NoError(t, err)
+}
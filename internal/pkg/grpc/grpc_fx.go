@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/grpc/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
@@ -27,9 +28,21 @@ var (
 		// https://uber-go.github.io/fx/annotate.html
 		fx.Annotate(
 			NewGrpcServer,
-			fx.ParamTags(``, ``),
+			fx.ParamTags(
+				``,
+				``,
+				fmt.Sprintf(`group:"%s"`, UnaryServerInterceptorsGroupName),
+				fmt.Sprintf(`group:"%s"`, StreamServerInterceptorsGroupName),
+			),
+		),
+		fx.Annotate(
+			NewGrpcClient,
+			fx.ParamTags(
+				``,
+				fmt.Sprintf(`group:"%s"`, UnaryClientInterceptorsGroupName),
+				fmt.Sprintf(`group:"%s"`, StreamClientInterceptorsGroupName),
+			),
 		),
-		NewGrpcClient,
 	))
 
 	// - execute after registering all of our provided
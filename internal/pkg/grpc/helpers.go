@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"fmt"
+
+	"go.uber.org/fx"
+)
+
+const (
+	UnaryServerInterceptorsGroupName  = "grpc-unary-server-interceptors"
+	StreamServerInterceptorsGroupName = "grpc-stream-server-interceptors"
+	UnaryClientInterceptorsGroupName  = "grpc-unary-client-interceptors"
+	StreamClientInterceptorsGroupName = "grpc-stream-client-interceptors"
+)
+
+// AsUnaryServerInterceptor annotates the given constructor to state that it provides a `grpc.UnaryServerInterceptor` to the
+// "grpc-unary-server-interceptors" group
+func AsUnaryServerInterceptor(interceptor interface{}) interface{} {
+	return asGroup(interceptor, UnaryServerInterceptorsGroupName)
+}
+
+// AsStreamServerInterceptor annotates the given constructor to state that it provides a `grpc.StreamServerInterceptor` to the
+// "grpc-stream-server-interceptors" group
+func AsStreamServerInterceptor(interceptor interface{}) interface{} {
+	return asGroup(interceptor, StreamServerInterceptorsGroupName)
+}
+
+// AsUnaryClientInterceptor annotates the given constructor to state that it provides a `grpc.UnaryClientInterceptor` to the
+// "grpc-unary-client-interceptors" group
+func AsUnaryClientInterceptor(interceptor interface{}) interface{} {
+	return asGroup(interceptor, UnaryClientInterceptorsGroupName)
+}
+
+// AsStreamClientInterceptor annotates the given constructor to state that it provides a `grpc.StreamClientInterceptor` to the
+// "grpc-stream-client-interceptors" group
+func AsStreamClientInterceptor(interceptor interface{}) interface{} {
+	return asGroup(interceptor, StreamClientInterceptorsGroupName)
+}
+
+func asGroup(constructor interface{}, groupName string) interface{} {
+	return fx.Annotate(
+		constructor,
+		fx.ResultTags(fmt.Sprintf(`group:"%s"`, groupName)),
+	)
+}
@@ -44,20 +44,30 @@ type grpcServer struct {
 	serviceBuilder *GrpcServiceBuilder
 }
 
+// NewGrpcServer creates the server with the built-in interceptors, the interceptors of the services run after them. the
+// interceptors are provided with fx value groups which are not ordered, so they should not depend on each other order
 func NewGrpcServer(
 	config *config.GrpcOptions,
 	logger logger.Logger,
-) GrpcServer {
-	unaryServerInterceptors := []googleGrpc.UnaryServerInterceptor{
+	unaryInterceptors []googleGrpc.UnaryServerInterceptor,
+	streamInterceptors []googleGrpc.StreamServerInterceptor,
+) (GrpcServer, error) {
+	unaryServerInterceptors := append([]googleGrpc.UnaryServerInterceptor{
 		interceptors.UnaryServerInterceptor(),
 		grpcCtxTags.UnaryServerInterceptor(),
 		grpcRecovery.UnaryServerInterceptor(),
-	}
-	streamServerInterceptors := []googleGrpc.StreamServerInterceptor{
+	}, unaryInterceptors...)
+	streamServerInterceptors := append([]googleGrpc.StreamServerInterceptor{
 		interceptors.StreamServerInterceptor(),
+	}, streamInterceptors...)
+
+	serverCredentials, err := NewServerCredentials(config.Tls)
+	if err != nil {
+		return nil, err
 	}
 
 	s := googleGrpc.NewServer(
+		googleGrpc.Creds(serverCredentials),
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/issues/2840
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/pull/3002
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/main/instrumentation/google.golang.org/grpc/otelgrpc/doc.go
@@ -93,7 +103,7 @@ func NewGrpcServer(
 		log:            logger,
 		serviceName:    config.Name,
 		serviceBuilder: NewGrpcServiceBuilder(s),
-	}
+	}, nil
 }
 
 func (s *grpcServer) RunGrpcServer(
@@ -467,6 +467,11 @@ func processValues[TDes any, TSrc any](
 	case reflect.Ptr:
 		mapPointers[TDes, TSrc](src, dest)
 	default:
+		// named types with the same underlying kind, like `type OrderStatus string` to `string`
+		if src.Type().ConvertibleTo(dest.Type()) {
+			dest.Set(src.Convert(dest.Type()))
+			return nil
+		}
 		dest.Set(src)
 	}
 
@@ -3,6 +3,7 @@ package mongodb
 import (
 	"context"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 
 	"emperror.dev/errors"
@@ -13,11 +14,90 @@ import (
 
 // https://stackoverflow.com/a/23650312/581476
 
+// Paginate returns the page of the documents which match the filter sorted by the `_id`, the list query can't have
+// filters, `orderBy` or cursors because they need the allow-list of a schema, they are applied by `FilterPaginate`
 func Paginate[T any](
 	ctx context.Context,
 	listQuery *utils.ListQuery,
 	collection *mongo.Collection,
 	filter interface{},
+) (*utils.ListResult[T], error) {
+	query, err := listquery.ParseWithoutSchema(listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate[T](ctx, listQuery, query, collection, filter)
+}
+
+// FilterPaginate returns the page of the documents which match the filter and the filters of the list query, sorted by
+// the `orderBy`, the filters and the sorts are validated against the allow-list of the schema, the page starts after the
+// `after` cursor or ends before the `before` cursor when the list query has a cursor
+func FilterPaginate[T any](
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+	schema *listquery.Schema,
+	collection *mongo.Collection,
+	filter bson.D,
+) (*utils.ListResult[T], error) {
+	query, err := schema.Parse(listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	specificationFilter, err := SpecificationToFilter(query.Specification)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate[T](ctx, listQuery, query, collection, andFilters(filter, specificationFilter))
+}
+
+// SortsToSort converts the sorts of the list query to the sort document of the find options, the document keeps the
+// order of the sorts
+func SortsToSort(sorts []listquery.Sort) bson.D {
+	if len(sorts) == 0 {
+		return nil
+	}
+
+	sort := make(bson.D, 0, len(sorts))
+	for _, item := range sorts {
+		direction := 1
+		if item.Descending {
+			direction = -1
+		}
+
+		sort = append(sort, bson.E{Key: documentKey(item.Field), Value: direction})
+	}
+
+	return sort
+}
+
+// andFilters combines the filters with `$and`, the empty filters are skipped
+func andFilters(filters ...bson.D) bson.D {
+	var items []bson.D
+	for _, filter := range filters {
+		if len(filter) > 0 {
+			items = append(items, filter)
+		}
+	}
+
+	switch len(items) {
+	case 0:
+		return bson.D{}
+	case 1:
+		return items[0]
+	default:
+		return bson.D{{Key: "$and", Value: items}}
+	}
+}
+
+func paginate[T any](
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+	query *listquery.Query,
+	collection *mongo.Collection,
+	filter interface{},
 ) (*utils.ListResult[T], error) {
 	if filter == nil {
 		filter = bson.D{}
@@ -28,15 +108,31 @@ func Paginate[T any](
 		return nil, errors.WrapIf(err, "CountDocuments")
 	}
 
-	limit := int64(listQuery.GetLimit())
-	skip := int64(listQuery.GetOffset())
+	findFilter := filter
+	if query.Keyset != nil {
+		keysetFilter, err := SpecificationToFilter(query.Keyset.Specification)
+		if err != nil {
+			return nil, err
+		}
+		findFilter = bson.D{{Key: "$and", Value: bson.A{filter, keysetFilter}}}
+	}
+
+	limit := int64(query.Limit(listQuery))
+	skip := int64(query.Offset(listQuery))
+
+	// the offset pages need a stable order, so the documents are sorted by the `_id` when the list query has no sort
+	sort := SortsToSort(query.Sorts)
+	if len(sort) == 0 {
+		sort = bson.D{{Key: "_id", Value: 1}}
+	}
 
 	cursor, err := collection.Find(
 		ctx,
-		filter,
+		findFilter,
 		&options.FindOptions{
 			Limit: &limit,
 			Skip:  &skip,
+			Sort:  sort,
 		})
 	if err != nil {
 		return nil, err
@@ -52,10 +148,18 @@ func Paginate[T any](
 		return nil, err
 	}
 
-	return utils.NewListResult[T](
+	items, cursors, err := listquery.Page(query, listQuery, items)
+	if err != nil {
+		return nil, err
+	}
+
+	listResult := utils.NewListResult[T](
 		items,
 		listQuery.GetSize(),
 		listQuery.GetPage(),
 		count,
-	), nil
+	)
+	listResult.PreviousCursor, listResult.NextCursor = cursors.Previous, cursors.Next
+
+	return listResult, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/health/contracts"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 
@@ -24,6 +25,10 @@ var (
 	mongoProviders = fx.Provide( //nolint:gochecknoglobals
 		provideConfig,
 		NewMongoDB,
+		fx.Annotate(
+			NewMongoUnitOfWork,
+			fx.As(new(unitofwork.UnitOfWork)),
+		),
 		fx.Annotate(
 			NewMongoHealthChecker,
 			fx.As(new(contracts.Health)),
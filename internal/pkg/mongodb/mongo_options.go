@@ -16,6 +16,8 @@ type MongoDbOptions struct {
 	Database      string `mapstructure:"database"`
 	UseAuth       bool   `mapstructure:"useAuth"`
 	EnableTracing bool   `mapstructure:"enableTracing" default:"true"`
+	// UseTransaction enables multi-document transactions, they are only supported on a replica set or a sharded cluster
+	UseTransaction bool `mapstructure:"useTransaction"`
 }
 
 func provideConfig(
@@ -0,0 +1,150 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type mongoUnitOfWork struct {
+	client         *mongo.Client
+	useTransaction bool
+	logger         logger.Logger
+}
+
+// NewMongoUnitOfWork creates a unit of work on the mongo sessions, the session is stored in the context with
+// `mongo.NewSessionContext` and the collection operations with the context run in it. the writes are atomic when
+// `UseTransaction` is enabled, because the multi-document transactions need a replica set or a sharded cluster
+func NewMongoUnitOfWork(client *mongo.Client, cfg *MongoDbOptions, l logger.Logger) unitofwork.UnitOfWork {
+	if !cfg.UseTransaction {
+		l.Warn(
+			"mongo transactions are disabled with `useTransaction: false`, the writes of the mongo unit of work " +
+				"and the outbox messages are not atomic",
+		)
+	}
+
+	return &mongoUnitOfWork{
+		client:         client,
+		useTransaction: cfg.UseTransaction,
+		logger:         l,
+	}
+}
+
+func (u *mongoUnitOfWork) Do(ctx context.Context, action unitofwork.ActionFunc) error {
+	// mongo doesn't have savepoints, a nested unit of work joins the session of the outer unit of work and a failed nested
+	// unit of work rolls back the outer one
+	if mongo.SessionFromContext(ctx) != nil {
+		return u.doNested(ctx, action)
+	}
+
+	// https://www.mongodb.com/docs/drivers/go/current/fundamentals/transactions/
+	session, err := u.client.StartSession()
+	if err != nil {
+		return errors.WrapIf(err, "error in starting the mongo session")
+	}
+	defer session.EndSession(ctx)
+
+	if u.useTransaction {
+		if err := session.StartTransaction(); err != nil {
+			return errors.WrapIf(err, "error in starting the mongo transaction")
+		}
+
+		u.logger.Info("beginning mongo transaction")
+	}
+
+	scopeCtx, scope := unitofwork.Begin(mongo.NewSessionContext(ctx, session))
+
+	defer func() {
+		if r := recover(); r != nil {
+			u.logger.Errorf("panic in the transaction, aborting transaction with panic: %+v", r)
+			u.abort(ctx, session)
+
+			panic(r)
+		}
+	}()
+
+	if err := action(scopeCtx); err != nil {
+		u.logger.Error("aborting mongo transaction")
+		u.abort(ctx, session)
+
+		return err
+	}
+
+	if scope.IsRollbackOnly() {
+		u.logger.Error("aborting mongo transaction, a nested unit of work is failed")
+		u.abort(ctx, session)
+
+		return unitofwork.ErrRollbackOnly
+	}
+
+	if u.useTransaction {
+		u.logger.Info("committing mongo transaction")
+
+		if err := session.CommitTransaction(ctx); err != nil {
+			return errors.WrapIf(err, "error in committing the mongo transaction")
+		}
+	}
+
+	if err := scope.Complete(ctx); err != nil {
+		// the transaction is committed, so the errors of the hooks just log
+		u.logger.Errorf("error in running the after-commit hooks: %v", err)
+	}
+
+	return nil
+}
+
+func (u *mongoUnitOfWork) doNested(ctx context.Context, action unitofwork.ActionFunc) error {
+	scopeCtx, scope := unitofwork.Begin(ctx)
+
+	defer func() {
+		if r := recover(); r != nil {
+			scope.SetRollbackOnly()
+
+			panic(r)
+		}
+	}()
+
+	if err := action(scopeCtx); err != nil {
+		scope.Discard()
+		scope.SetRollbackOnly()
+
+		return err
+	}
+
+	if err := scope.Complete(ctx); err != nil {
+		u.logger.Errorf("error in running the after-commit hooks: %v", err)
+	}
+
+	return nil
+}
+
+func (u *mongoUnitOfWork) abort(ctx context.Context, session mongo.Session) {
+	if !u.useTransaction {
+		return
+	}
+
+	if err := session.AbortTransaction(ctx); err != nil {
+		u.logger.Errorf("error in aborting the mongo transaction: %v", err)
+	}
+}
+
+// IsTransactionRunning reports whether the ctx is in a started mongo transaction, a session without a transaction
+// doesn't make the writes atomic
+func IsTransactionRunning(ctx context.Context) bool {
+	session := mongo.SessionFromContext(ctx)
+	if session == nil {
+		return false
+	}
+
+	// the transaction state is just exposed on the client session of the driver
+	xSession, ok := session.(mongo.XSession) //nolint:staticcheck // the session interface doesn't have the transaction state
+	if !ok {
+		return false
+	}
+
+	return xSession.ClientSession().TransactionRunning()
+}
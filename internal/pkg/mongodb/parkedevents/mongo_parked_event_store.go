@@ -0,0 +1,122 @@
+package parkedevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const collectionName = "parked_events"
+
+type ParkedEvent struct {
+	Id             string    `bson:"_id"`
+	ProjectionName string    `bson:"projectionName"`
+	EventId        string    `bson:"eventId"`
+	EventType      string    `bson:"eventType"`
+	AggregateId    string    `bson:"aggregateId"`
+	Version        int64     `bson:"version"`
+	Position       int64     `bson:"position"`
+	Data           string    `bson:"data"`
+	Error          string    `bson:"error"`
+	Attempts       int       `bson:"attempts"`
+	ParkedAt       time.Time `bson:"parkedAt"`
+}
+
+type mongoParkedEventStore struct {
+	db           *mongo.Client
+	databaseName string
+}
+
+func NewMongoParkedEventStore(
+	db *mongo.Client,
+	cfg *mongodb.MongoDbOptions,
+) projection.IParkedEventStore {
+	return &mongoParkedEventStore{
+		db:           db,
+		databaseName: cfg.Database,
+	}
+}
+
+func (m *mongoParkedEventStore) Park(ctx context.Context, parkedEvent *projection.ParkedEvent) error {
+	collection := m.db.Database(m.databaseName).Collection(collectionName)
+
+	_, err := collection.InsertOne(ctx, &ParkedEvent{
+		Id:             parkedEvent.Id.String(),
+		ProjectionName: parkedEvent.ProjectionName,
+		EventId:        parkedEvent.EventId.String(),
+		EventType:      parkedEvent.EventType,
+		AggregateId:    parkedEvent.AggregateId.String(),
+		Version:        parkedEvent.Version,
+		Position:       parkedEvent.Position,
+		Data:           string(parkedEvent.Data),
+		Error:          parkedEvent.Error,
+		Attempts:       parkedEvent.Attempts,
+		ParkedAt:       parkedEvent.ParkedAt,
+	})
+	if err != nil {
+		return errors.WrapIf(err, "error in storing parked event")
+	}
+
+	return nil
+}
+
+func (m *mongoParkedEventStore) GetParkedEvents(
+	ctx context.Context,
+	projectionName string,
+) ([]*projection.ParkedEvent, error) {
+	collection := m.db.Database(m.databaseName).Collection(collectionName)
+
+	filter := bson.M{}
+	if projectionName != "" {
+		filter["projectionName"] = projectionName
+	}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"position": 1}))
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in loading parked events")
+	}
+	defer cursor.Close(ctx)
+
+	var documents []*ParkedEvent
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, errors.WrapIf(err, "error in decoding parked events")
+	}
+
+	parkedEvents := make([]*projection.ParkedEvent, 0, len(documents))
+	for _, document := range documents {
+		parkedEvents = append(parkedEvents, &projection.ParkedEvent{
+			Id:             uuid.FromStringOrNil(document.Id),
+			ProjectionName: document.ProjectionName,
+			EventId:        uuid.FromStringOrNil(document.EventId),
+			EventType:      document.EventType,
+			AggregateId:    uuid.FromStringOrNil(document.AggregateId),
+			Version:        document.Version,
+			Position:       document.Position,
+			Data:           []byte(document.Data),
+			Error:          document.Error,
+			Attempts:       document.Attempts,
+			ParkedAt:       document.ParkedAt,
+		})
+	}
+
+	return parkedEvents, nil
+}
+
+func (m *mongoParkedEventStore) Remove(ctx context.Context, id uuid.UUID) error {
+	collection := m.db.Database(m.databaseName).Collection(collectionName)
+
+	_, err := collection.DeleteOne(ctx, bson.M{"_id": id.String()})
+	if err != nil {
+		return errors.WrapIf(err, "error in removing parked event")
+	}
+
+	return nil
+}
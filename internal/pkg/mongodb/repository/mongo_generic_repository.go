@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
@@ -191,6 +192,27 @@ func (m *mongoGenericRepository[TDataModel, TEntity]) GetAll(
 	}
 }
 
+func (m *mongoGenericRepository[TDataModel, TEntity]) FindPage(
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+	schema *listquery.Schema,
+) (*utils.ListResult[TEntity], error) {
+	dataModelType := typeMapper.GetGenericTypeByT[TDataModel]()
+	modelType := typeMapper.GetGenericTypeByT[TEntity]()
+	collection := m.db.Database(m.databaseName).Collection(m.collectionName)
+
+	if modelType == dataModelType {
+		return mongodb.FilterPaginate[TEntity](ctx, listQuery, schema, collection, nil)
+	}
+
+	result, err := mongodb.FilterPaginate[TDataModel](ctx, listQuery, schema, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.ListResultToListResultDto[TEntity](result)
+}
+
 func (m *mongoGenericRepository[TDataModel, TEntity]) Search(
 	ctx context.Context,
 	searchTerm string,
@@ -259,54 +281,28 @@ func (m *mongoGenericRepository[TDataModel, TEntity]) GetByFilter(
 	ctx context.Context,
 	filters map[string]interface{},
 ) ([]TEntity, error) {
-	dataModelType := typeMapper.GetGenericTypeByT[TDataModel]()
-	modelType := typeMapper.GetGenericTypeByT[TEntity]()
-	collection := m.db.Database(m.databaseName).Collection(m.collectionName)
-
 	// we could use also bson.D{} for filtering, it is also a map
-	cursorResult, err := collection.Find(ctx, filters)
+	return m.find(ctx, filters)
+}
+
+func (m *mongoGenericRepository[TDataModel, TEntity]) GetByFuncFilter(
+	ctx context.Context,
+	filterFunc func(TEntity) bool,
+) ([]TEntity, error) {
+	// the filter function can't be translated to a mongo filter, so the documents are filtered after loading
+	entities, err := m.find(ctx, bson.D{})
 	if err != nil {
 		return nil, err
 	}
 
-	defer cursorResult.Close(ctx) // nolint: errcheck
-
-	if modelType == dataModelType {
-		var models []TEntity
-
-		for cursorResult.Next(ctx) {
-			var e TEntity
-			if err := cursorResult.Decode(&e); err != nil {
-				return nil, errors.WrapIf(err, "Find")
-			}
-			models = append(models, e)
-		}
-
-		return models, nil
-	} else {
-		var dataModels []TDataModel
-
-		for cursorResult.Next(ctx) {
-			var d TDataModel
-			if err := cursorResult.Decode(&d); err != nil {
-				return nil, errors.WrapIf(err, "Find")
-			}
-			dataModels = append(dataModels, d)
-		}
-
-		models, err := mapper.Map[[]TEntity](dataModels)
-		if err != nil {
-			return nil, err
+	var models []TEntity
+	for _, entity := range entities {
+		if filterFunc(entity) {
+			models = append(models, entity)
 		}
-		return models, nil
 	}
-}
 
-func (m *mongoGenericRepository[TDataModel, TEntity]) GetByFuncFilter(
-	ctx context.Context,
-	filterFunc func(TEntity) bool,
-) ([]TEntity, error) {
-	return nil, nil
+	return models, nil
 }
 
 func (m *mongoGenericRepository[TDataModel, TEntity]) FirstOrDefault(
@@ -492,6 +488,56 @@ func (m *mongoGenericRepository[TDataModel, TEntity]) Find(
 	ctx context.Context,
 	specification specification.Specification,
 ) ([]TEntity, error) {
-	// TODO implement me
-	panic("implement me")
+	filter, err := mongodb.SpecificationToFilter(specification)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.find(ctx, filter)
+}
+
+func (m *mongoGenericRepository[TDataModel, TEntity]) find(
+	ctx context.Context,
+	filter interface{},
+) ([]TEntity, error) {
+	dataModelType := typeMapper.GetGenericTypeByT[TDataModel]()
+	modelType := typeMapper.GetGenericTypeByT[TEntity]()
+	collection := m.db.Database(m.databaseName).Collection(m.collectionName)
+
+	cursorResult, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursorResult.Close(ctx) // nolint: errcheck
+
+	if modelType == dataModelType {
+		var models []TEntity
+
+		for cursorResult.Next(ctx) {
+			var e TEntity
+			if err := cursorResult.Decode(&e); err != nil {
+				return nil, errors.WrapIf(err, "Find")
+			}
+			models = append(models, e)
+		}
+
+		return models, nil
+	} else {
+		var dataModels []TDataModel
+
+		for cursorResult.Next(ctx) {
+			var d TDataModel
+			if err := cursorResult.Decode(&d); err != nil {
+				return nil, errors.WrapIf(err, "Find")
+			}
+			dataModels = append(dataModels, d)
+		}
+
+		models, err := mapper.Map[[]TEntity](dataModels)
+		if err != nil {
+			return nil, err
+		}
+		return models, nil
+	}
 }
@@ -0,0 +1,144 @@
+package sagastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/saga"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const collectionName = "sagas"
+
+type SagaDocument struct {
+	Id            string    `bson:"_id"`
+	CorrelationId string    `bson:"correlationId"`
+	SagaName      string    `bson:"sagaName"`
+	State         string    `bson:"state"`
+	Data          string    `bson:"data"`
+	Compensations []string  `bson:"compensations"`
+	Completed     bool      `bson:"completed"`
+	Version       int64     `bson:"version"`
+	CreatedAt     time.Time `bson:"createdAt"`
+	UpdatedAt     time.Time `bson:"updatedAt"`
+}
+
+type mongoSagaStore struct {
+	db             *mongo.Client
+	databaseName   string
+	useTransaction bool
+}
+
+func NewMongoSagaStore(db *mongo.Client, cfg *mongodb.MongoDbOptions) saga.SagaStore {
+	return &mongoSagaStore{
+		db:             db,
+		databaseName:   cfg.Database,
+		useTransaction: cfg.UseTransaction,
+	}
+}
+
+func (m *mongoSagaStore) Load(
+	ctx context.Context,
+	sagaName string,
+	correlationId string,
+) (*saga.SagaInstance, error) {
+	collection := m.db.Database(m.databaseName).Collection(collectionName)
+
+	var document SagaDocument
+
+	err := collection.FindOne(ctx, bson.M{"_id": documentId(sagaName, correlationId)}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in loading saga instance")
+	}
+
+	return &saga.SagaInstance{
+		CorrelationId: document.CorrelationId,
+		SagaName:      document.SagaName,
+		State:         document.State,
+		Data:          []byte(document.Data),
+		Compensations: document.Compensations,
+		Completed:     document.Completed,
+		Version:       document.Version,
+		CreatedAt:     document.CreatedAt,
+		UpdatedAt:     document.UpdatedAt,
+	}, nil
+}
+
+func (m *mongoSagaStore) Save(ctx context.Context, instance *saga.SagaInstance, expectedVersion int64) error {
+	collection := m.db.Database(m.databaseName).Collection(collectionName)
+
+	document := &SagaDocument{
+		Id:            documentId(instance.SagaName, instance.CorrelationId),
+		CorrelationId: instance.CorrelationId,
+		SagaName:      instance.SagaName,
+		State:         instance.State,
+		Data:          string(instance.Data),
+		Compensations: instance.Compensations,
+		Completed:     instance.Completed,
+		Version:       instance.Version,
+		CreatedAt:     instance.CreatedAt,
+		UpdatedAt:     instance.UpdatedAt,
+	}
+
+	if expectedVersion == 0 {
+		_, err := collection.InsertOne(ctx, document)
+		if mongo.IsDuplicateKeyError(err) {
+			return saga.ErrSagaConcurrencyConflict
+		}
+		if err != nil {
+			return errors.WrapIf(err, "error in inserting saga instance")
+		}
+
+		return nil
+	}
+
+	result, err := collection.ReplaceOne(ctx, bson.M{"_id": document.Id, "version": expectedVersion}, document)
+	if err != nil {
+		return errors.WrapIf(err, "error in updating saga instance")
+	}
+
+	if result.MatchedCount == 0 {
+		return saga.ErrSagaConcurrencyConflict
+	}
+
+	return nil
+}
+
+// ExecuteInTransaction runs the action in a mongo session, if transactions are disabled, writes are just sequenced in the session
+func (m *mongoSagaStore) ExecuteInTransaction(
+	ctx context.Context,
+	action func(ctx context.Context) error,
+) error {
+	// joining to the existing session
+	if mongo.SessionFromContext(ctx) != nil {
+		return action(ctx)
+	}
+
+	// https://www.mongodb.com/docs/drivers/go/current/fundamentals/transactions/
+	return m.db.UseSession(ctx, func(sessionContext mongo.SessionContext) error {
+		if !m.useTransaction {
+			return action(sessionContext)
+		}
+
+		_, err := sessionContext.WithTransaction(
+			sessionContext,
+			func(sessionContext mongo.SessionContext) (interface{}, error) {
+				return nil, action(sessionContext)
+			},
+		)
+
+		return err
+	})
+}
+
+func documentId(sagaName string, correlationId string) string {
+	return fmt.Sprintf("%s/%s", sagaName, correlationId)
+}
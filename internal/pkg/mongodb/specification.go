@@ -0,0 +1,191 @@
+package mongodb
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SpecificationToFilter compiles the specification to a mongo filter, the filter is empty for the specifications which
+// match all the documents
+func SpecificationToFilter(spec specification.Specification) (bson.D, error) {
+	switch s := spec.(type) {
+	case nil:
+		return bson.D{}, nil
+	case specification.AndSpecification:
+		filters, err := specificationsToFilters(s.Specifications)
+		if err != nil {
+			return nil, err
+		}
+
+		switch len(filters) {
+		case 0:
+			return bson.D{}, nil
+		case 1:
+			return filters[0], nil
+		default:
+			return bson.D{{Key: "$and", Value: filters}}, nil
+		}
+	case specification.OrSpecification:
+		if len(s.Specifications) == 0 {
+			return nil, errors.New("or specification needs at least one specification")
+		}
+
+		filters, err := specificationsToFilters(s.Specifications)
+		if err != nil {
+			return nil, err
+		}
+		if len(filters) < len(s.Specifications) {
+			// one of the specifications matches all the documents
+			return bson.D{}, nil
+		}
+
+		return bson.D{{Key: "$or", Value: filters}}, nil
+	case specification.NotSpecification:
+		filter, err := SpecificationToFilter(s.Specification)
+		if err != nil {
+			return nil, err
+		}
+
+		// `$nor` negates the whole filter, `$not` just works on the operator expressions of a field
+		return bson.D{{Key: "$nor", Value: bson.A{filter}}}, nil
+	case specification.FieldSpecification:
+		normalized := specification.Normalize(s)
+		if fieldSpecification, ok := normalized.(specification.FieldSpecification); ok {
+			return fieldSpecificationToFilter(fieldSpecification)
+		}
+
+		return SpecificationToFilter(normalized)
+	default:
+		return nil, errors.Errorf("specification '%T' is not supported by mongo", spec)
+	}
+}
+
+func specificationsToFilters(specifications []specification.Specification) ([]bson.D, error) {
+	filters := make([]bson.D, 0, len(specifications))
+
+	for _, spec := range specifications {
+		filter, err := SpecificationToFilter(spec)
+		if err != nil {
+			return nil, err
+		}
+		if len(filter) > 0 {
+			filters = append(filters, filter)
+		}
+	}
+
+	return filters, nil
+}
+
+func fieldSpecificationToFilter(spec specification.FieldSpecification) (bson.D, error) {
+	if spec.Field.Document == "" {
+		return nil, errors.New("field specification needs a field")
+	}
+
+	key := documentKey(spec.Field)
+
+	var condition interface{}
+
+	switch spec.Operator {
+	case specification.OperatorEqual:
+		condition = bson.D{{Key: "$eq", Value: spec.Value}}
+	case specification.OperatorNotEqual:
+		// `$ne` matches the null and missing fields, unlike the sql `<>`
+		condition = bson.D{{Key: "$nin", Value: bson.A{spec.Value, nil}}}
+	case specification.OperatorGreaterThan:
+		condition = bson.D{{Key: "$gt", Value: spec.Value}}
+	case specification.OperatorGreaterOrEqual:
+		condition = bson.D{{Key: "$gte", Value: spec.Value}}
+	case specification.OperatorLessThan:
+		condition = bson.D{{Key: "$lt", Value: spec.Value}}
+	case specification.OperatorLessOrEqual:
+		condition = bson.D{{Key: "$lte", Value: spec.Value}}
+	case specification.OperatorIn, specification.OperatorNotIn:
+		values, ok := spec.Value.([]any)
+		if !ok {
+			return nil, errors.Errorf("value of the '%s' operator of field '%s' should be a slice", spec.Operator, spec.Field)
+		}
+
+		if spec.Operator == specification.OperatorNotIn {
+			// `$nin` matches the null and missing fields, unlike the sql `NOT IN`
+			condition = bson.D{{Key: "$nin", Value: append(append(bson.A{}, values...), nil)}}
+		} else {
+			condition = bson.D{{Key: "$in", Value: bson.A(values)}}
+		}
+	case specification.OperatorLike, specification.OperatorILike:
+		pattern, ok := spec.Value.(string)
+		if !ok {
+			return nil, errors.Errorf("value of the '%s' operator of field '%s' should be a string", spec.Operator, spec.Field)
+		}
+
+		regex := primitive.Regex{Pattern: likeToRegex(pattern)}
+		if spec.Operator == specification.OperatorILike {
+			regex.Options = "i"
+		}
+		condition = bson.D{{Key: "$regex", Value: regex}}
+	case specification.OperatorBetween:
+		valueRange, ok := spec.Value.(specification.Range)
+		if !ok {
+			return nil, errors.Errorf("value of the between operator of field '%s' should be a range", spec.Field)
+		}
+
+		condition = bson.D{{Key: "$gte", Value: valueRange.From}, {Key: "$lte", Value: valueRange.To}}
+	case specification.OperatorIsNull:
+		// matches the documents with the null value and the documents without the field, like the sql `IS NULL`
+		condition = nil
+	case specification.OperatorIsNotNull:
+		condition = bson.D{{Key: "$ne", Value: nil}}
+	case specification.OperatorContains:
+		condition = bson.D{{Key: "$elemMatch", Value: bson.D{{Key: "$eq", Value: spec.Value}}}}
+	default:
+		return nil, errors.Errorf(
+			"operator '%s' of field '%s' is not supported by mongo",
+			spec.Operator,
+			spec.Field,
+		)
+	}
+
+	return bson.D{{Key: key, Value: condition}}, nil
+}
+
+// documentKey returns the key of the field, the nested fields of the sub documents are queried with the dot notation
+func documentKey(field specification.FieldName) string {
+	return strings.Join(append([]string{field.Document}, field.Path...), ".")
+}
+
+// likeToRegex converts the sql like pattern to an anchored regex, `%` matches any characters and `_` matches one character,
+// the characters after the `\` escape character are matched literally like postgres
+func likeToRegex(pattern string) string {
+	var builder strings.Builder
+	builder.WriteString("^")
+
+	escaped := false
+	for _, char := range pattern {
+		if escaped {
+			builder.WriteString(regexp.QuoteMeta(string(char)))
+			escaped = false
+
+			continue
+		}
+
+		switch char {
+		case '\\':
+			escaped = true
+		case '%':
+			builder.WriteString(".*")
+		case '_':
+			builder.WriteString(".")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+
+	builder.WriteString("$")
+
+	return builder.String()
+}
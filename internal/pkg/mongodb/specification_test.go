@@ -0,0 +1,133 @@
+//go:build unit
+// +build unit
+
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_Specification_To_Filter_Should_Compile_Expression_Tree_To_Bson(t *testing.T) {
+	filter, err := SpecificationToFilter(specification.And(
+		specification.Equal("name", "product1"),
+		specification.Or(
+			specification.GreaterThan("weight", 10),
+			specification.Not(specification.LessOrEqual("weight", 5)),
+		),
+		specification.IsNull("description"),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.D{{Key: "$and", Value: []bson.D{
+		{{Key: "name", Value: bson.D{{Key: "$eq", Value: "product1"}}}},
+		{{Key: "$or", Value: []bson.D{
+			{{Key: "weight", Value: bson.D{{Key: "$gt", Value: 10}}}},
+			{{Key: "$nor", Value: bson.A{bson.D{{Key: "weight", Value: bson.D{{Key: "$lte", Value: 5}}}}}}},
+		}}},
+		{{Key: "description", Value: nil}},
+	}}}, filter)
+}
+
+func Test_Specification_To_Filter_Should_Compile_Operators_And_Document_Paths(t *testing.T) {
+	filter, err := SpecificationToFilter(specification.And(
+		specification.In("name", "product1", "product2"),
+		specification.NotIn("weight", 1, 2),
+		specification.ILike("name", "prod_ct%.v1"),
+		specification.Between("weight", 10, 20),
+		specification.IsNotNull("description"),
+		specification.Contains("tags", "new"),
+		specification.FieldSpecification{
+			Field:    specification.NewFieldName("attributes", "size", "width"),
+			Operator: specification.OperatorGreaterThan,
+			Value:    10,
+		},
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.D{{Key: "$and", Value: []bson.D{
+		{{Key: "name", Value: bson.D{{Key: "$in", Value: bson.A{"product1", "product2"}}}}},
+		{{Key: "weight", Value: bson.D{{Key: "$nin", Value: bson.A{1, 2, nil}}}}},
+		{{Key: "name", Value: bson.D{{Key: "$regex", Value: primitive.Regex{Pattern: `^prod.ct.*\.v1$`, Options: "i"}}}}},
+		{{Key: "weight", Value: bson.D{{Key: "$gte", Value: 10}, {Key: "$lte", Value: 20}}}},
+		{{Key: "description", Value: bson.D{{Key: "$ne", Value: nil}}}},
+		{{Key: "tags", Value: bson.D{{Key: "$elemMatch", Value: bson.D{{Key: "$eq", Value: "new"}}}}}},
+		{{Key: "attributes.size.width", Value: bson.D{{Key: "$gt", Value: 10}}}},
+	}}}, filter)
+}
+
+func Test_Specification_To_Filter_Should_Not_Wrap_Single_And_Specification(t *testing.T) {
+	filter, err := SpecificationToFilter(specification.And(specification.GreaterOrEqual("weight", 10)))
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.D{{Key: "weight", Value: bson.D{{Key: "$gte", Value: 10}}}}, filter)
+}
+
+func Test_Specification_To_Filter_Should_Match_All_For_Empty_And(t *testing.T) {
+	filter, err := SpecificationToFilter(specification.And())
+	require.NoError(t, err)
+	assert.Empty(t, filter)
+
+	filter, err = SpecificationToFilter(nil)
+	require.NoError(t, err)
+	assert.Empty(t, filter)
+}
+
+func Test_Specification_To_Filter_Should_Not_Match_Null_Fields_For_Negative_Comparisons(t *testing.T) {
+	filter, err := SpecificationToFilter(specification.NotEqual("description", "new"))
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.D{{Key: "description", Value: bson.D{{Key: "$nin", Value: bson.A{"new", nil}}}}}, filter)
+}
+
+func Test_Specification_To_Filter_Should_Normalize_Empty_In_Operators(t *testing.T) {
+	filter, err := SpecificationToFilter(specification.NotIn[int]("weight"))
+	require.NoError(t, err)
+	assert.Empty(t, filter)
+
+	filter, err = SpecificationToFilter(specification.In[int]("weight"))
+	require.NoError(t, err)
+	assert.Equal(t, bson.D{{Key: "$nor", Value: bson.A{bson.D{}}}}, filter)
+
+	filter, err = SpecificationToFilter(specification.Or(specification.NotIn[int]("weight"), specification.Equal("name", "product1")))
+	require.NoError(t, err)
+	assert.Empty(t, filter)
+}
+
+func Test_Specification_To_Filter_Should_Return_Error_For_Empty_Or(t *testing.T) {
+	_, err := SpecificationToFilter(specification.Or())
+	assert.Error(t, err)
+}
+
+func Test_Specification_To_Filter_Should_Return_Error_For_Unsupported_Operator(t *testing.T) {
+	_, err := SpecificationToFilter(specification.FieldSpecification{Field: specification.NewFieldName("name"), Operator: "unknown"})
+	assert.ErrorContains(t, err, "operator 'unknown' of field 'name' is not supported by mongo")
+}
+
+func Test_Specification_To_Filter_Should_Match_Escaped_Like_Characters_Literally(t *testing.T) {
+	filter, err := SpecificationToFilter(specification.ILike("name", "%"+specification.EscapeLike(`50%_off\`)+"%"))
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		bson.D{{Key: "name", Value: bson.D{{Key: "$regex", Value: primitive.Regex{Pattern: `^.*50%_off\\.*$`, Options: "i"}}}}},
+		filter,
+	)
+}
+
+func Test_Sorts_To_Sort_Should_Convert_Sorts_To_Bson(t *testing.T) {
+	sort := SortsToSort([]listquery.Sort{
+		{Field: specification.NewFieldName("weight"), Descending: true},
+		{Field: specification.NewFieldName("attributes", "size")},
+	})
+
+	assert.Equal(t, bson.D{{Key: "weight", Value: -1}, {Key: "attributes.size", Value: 1}}, sort)
+	assert.Nil(t, SortsToSort(nil))
+}
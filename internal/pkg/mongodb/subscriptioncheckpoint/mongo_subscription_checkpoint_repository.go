@@ -0,0 +1,115 @@
+package subscriptioncheckpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const collectionName = "subscription_checkpoints"
+
+type SubscriptionCheckpoint struct {
+	SubscriptionId string    `bson:"_id"`
+	Position       uint64    `bson:"position"`
+	CheckpointAt   time.Time `bson:"checkpointAt"`
+}
+
+type mongoSubscriptionCheckpointRepository struct {
+	db             *mongo.Client
+	databaseName   string
+	useTransaction bool
+}
+
+func NewMongoSubscriptionCheckpointRepository(
+	db *mongo.Client,
+	cfg *mongodb.MongoDbOptions,
+	l logger.Logger,
+) contracts.TransactionalSubscriptionCheckpointRepository {
+	if !cfg.UseTransaction {
+		l.Warn(
+			"mongo transactions are disabled with `useTransaction: false`, the subscription checkpoints are not atomic " +
+				"with the read models and the events after the last checkpoint are projected again after a crash",
+		)
+	}
+
+	return &mongoSubscriptionCheckpointRepository{
+		db:             db,
+		databaseName:   cfg.Database,
+		useTransaction: cfg.UseTransaction,
+	}
+}
+
+func (m *mongoSubscriptionCheckpointRepository) Load(
+	subscriptionId string,
+	ctx context.Context,
+) (uint64, error) {
+	collection := m.db.Database(m.databaseName).Collection(collectionName)
+
+	var checkpoint SubscriptionCheckpoint
+
+	err := collection.FindOne(ctx, bson.M{"_id": subscriptionId}).Decode(&checkpoint)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.WrapIf(err, "error in loading subscription checkpoint")
+	}
+
+	return checkpoint.Position, nil
+}
+
+func (m *mongoSubscriptionCheckpointRepository) Store(
+	subscriptionId string,
+	position uint64,
+	ctx context.Context,
+) error {
+	collection := m.db.Database(m.databaseName).Collection(collectionName)
+
+	_, err := collection.UpdateOne(
+		ctx,
+		bson.M{"_id": subscriptionId},
+		bson.M{"$set": bson.M{"position": position, "checkpointAt": time.Now()}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return errors.WrapIf(err, "error in storing subscription checkpoint")
+	}
+
+	return nil
+}
+
+// ExecuteInTransaction runs the action in a mongo session, the session context should be used by the projections for writing the read models.
+// if transactions are disabled, writes are just sequenced in the session, because standalone mongo servers don't support transactions
+func (m *mongoSubscriptionCheckpointRepository) ExecuteInTransaction(
+	ctx context.Context,
+	action func(ctx context.Context) error,
+) error {
+	// joining to the existing session
+	if mongo.SessionFromContext(ctx) != nil {
+		return action(ctx)
+	}
+
+	// https://www.mongodb.com/docs/drivers/go/current/fundamentals/transactions/
+	return m.db.UseSession(ctx, func(sessionContext mongo.SessionContext) error {
+		if !m.useTransaction {
+			return action(sessionContext)
+		}
+
+		_, err := sessionContext.WithTransaction(
+			sessionContext,
+			func(sessionContext mongo.SessionContext) (interface{}, error) {
+				return nil, action(sessionContext)
+			},
+		)
+
+		return err
+	})
+}
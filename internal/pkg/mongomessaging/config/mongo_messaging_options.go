@@ -0,0 +1,57 @@
+package config
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config/environment"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"github.com/iancoleman/strcase"
+)
+
+type MongoMessagingOptions struct {
+	// CollectionName is the collection of the outbox, inbox and internal messages
+	CollectionName string `mapstructure:"collectionName" default:"store_messages"`
+	// EnableDispatcher starts the background outbox dispatcher
+	EnableDispatcher bool `mapstructure:"enableDispatcher" default:"true"`
+	// ProcessingInterval is the polling interval of the outbox dispatcher
+	ProcessingInterval time.Duration `mapstructure:"processingInterval" default:"2s"`
+	// LockTimeout is how long a message claimed by a dispatcher is hidden from the other dispatchers, a message of a
+	// crashed dispatcher is claimed again after this timeout
+	LockTimeout time.Duration `mapstructure:"lockTimeout" default:"1m"`
+	// MaxRetryCount is the number of dispatch attempts before a message is moved to the terminal dead-lettered state
+	MaxRetryCount int `mapstructure:"maxRetryCount" default:"5"`
+	// RetryBaseDelay is the first retry delay, next delays grow exponentially up to RetryMaxDelay
+	RetryBaseDelay time.Duration `mapstructure:"retryBaseDelay" default:"1s"`
+	RetryMaxDelay  time.Duration `mapstructure:"retryMaxDelay"  default:"5m"`
+	// RetentionPeriod is how long processed messages are kept, inbox messages are used for detecting duplicate
+	// messages in this period
+	RetentionPeriod time.Duration `mapstructure:"retentionPeriod" default:"168h"`
+	// CleanupInterval is the interval of removing the processed messages older than RetentionPeriod
+	CleanupInterval time.Duration `mapstructure:"cleanupInterval" default:"1h"`
+}
+
+// RetryDelay returns the exponential backoff delay for the given retry count
+func (o *MongoMessagingOptions) RetryDelay(retryCount int) time.Duration {
+	delay := o.RetryBaseDelay
+	for i := 1; i < retryCount; i++ {
+		delay *= 2
+		if delay >= o.RetryMaxDelay {
+			return o.RetryMaxDelay
+		}
+	}
+
+	if delay > o.RetryMaxDelay {
+		return o.RetryMaxDelay
+	}
+
+	return delay
+}
+
+func ProvideConfig(environment environment.Environment) (*MongoMessagingOptions, error) {
+	optionName := strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[MongoMessagingOptions]())
+	cfg, err := config.BindConfigKey[*MongoMessagingOptions](optionName, environment)
+
+	return cfg, err
+}
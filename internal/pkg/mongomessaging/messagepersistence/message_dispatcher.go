@@ -0,0 +1,92 @@
+package messagepersistence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongomessaging/config"
+)
+
+// MessageDispatcher polls the message store and publishes the pending outbox messages and sends the pending internal
+// commands in the background, it also removes the processed messages after the retention period
+type MessageDispatcher interface {
+	Start(ctx context.Context)
+	Stop(ctx context.Context) error
+}
+
+type messageDispatcher struct {
+	messagePersistenceService persistmessage.MessagePersistenceService
+	options                   *config.MongoMessagingOptions
+	logger                    logger.Logger
+	cancel                    context.CancelFunc
+	wg                        sync.WaitGroup
+}
+
+func NewMessageDispatcher(
+	messagePersistenceService persistmessage.MessagePersistenceService,
+	options *config.MongoMessagingOptions,
+	logger logger.Logger,
+) MessageDispatcher {
+	return &messageDispatcher{
+		messagePersistenceService: messagePersistenceService,
+		options:                   options,
+		logger:                    logger,
+	}
+}
+
+func (d *messageDispatcher) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		ticker := time.NewTicker(d.options.ProcessingInterval)
+		defer ticker.Stop()
+
+		cleanupTicker := time.NewTicker(d.options.CleanupInterval)
+		defer cleanupTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := d.messagePersistenceService.ProcessAll(ctx); err != nil && ctx.Err() == nil {
+					d.logger.Errorf("(ProcessAll) error in dispatching stored messages: {%v}", err)
+				}
+			case <-cleanupTicker.C:
+				if err := d.messagePersistenceService.CleanupMessages(ctx); err != nil && ctx.Err() == nil {
+					d.logger.Errorf("(CleanupMessages) error in cleaning up processed messages: {%v}", err)
+				}
+			}
+		}
+	}()
+
+	d.logger.Info("message dispatcher is started.")
+}
+
+// Stop cancels the dispatcher and waits for the in-flight batch, until the stop context is done
+func (d *messageDispatcher) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		d.logger.Info("message dispatcher is stopped.")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
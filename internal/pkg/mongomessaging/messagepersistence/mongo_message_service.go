@@ -0,0 +1,714 @@
+package messagepersistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongomessaging/config"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"emperror.dev/errors"
+	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoMessagePersistenceService struct {
+	collection        *mongo.Collection
+	unitOfWork        unitofwork.UnitOfWork
+	messageSerializer serializer.MessageSerializer
+	bus               bus.Bus
+	options           *config.MongoMessagingOptions
+	logger            logger.Logger
+}
+
+// NewMongoMessageService creates the message persistence service on a mongo collection, the messages are written with
+// the session of the ctx, so they are committed atomically with the other writes of the mongo unit of work
+func NewMongoMessageService(
+	client *mongo.Client,
+	mongoOptions *mongodb.MongoDbOptions,
+	messageSerializer serializer.MessageSerializer,
+	bus bus.Bus,
+	options *config.MongoMessagingOptions,
+	l logger.Logger,
+) persistmessage.MessagePersistenceService {
+	return &mongoMessagePersistenceService{
+		collection:        client.Database(mongoOptions.Database).Collection(options.CollectionName),
+		unitOfWork:        mongodb.NewMongoUnitOfWork(client, mongoOptions, l),
+		messageSerializer: messageSerializer,
+		bus:               bus,
+		options:           options,
+		logger:            l,
+	}
+}
+
+// Process publishes a stored outbox message or dispatches a stored internal command by its id, the message is claimed
+// like the messages of `ProcessAll`, so it is not processed concurrently with a dispatcher
+func (m *mongoMessagePersistenceService) Process(messageID string, ctx context.Context) error {
+	id, err := uuid.FromString(messageID)
+	if err != nil {
+		return customErrors.NewBadRequestErrorWrap(err, fmt.Sprintf("invalid message id `%s`", messageID))
+	}
+
+	storeMessage, err := m.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if storeMessage.MessageStatus != persistmessage.Stored {
+		return nil
+	}
+
+	if !isDispatchable(storeMessage.DeliveryType) {
+		return errors.Errorf(
+			"message with id `%s` and delivery type `%v` can't be processed by the message dispatcher",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+		)
+	}
+
+	// a scheduled message is processed before its time, but not while it is claimed by a dispatcher
+	storeMessage, err = m.claim(ctx, bson.M{
+		"_id": id.String(),
+		"$or": []bson.M{
+			{"claimToken": bson.M{"$in": []interface{}{nil, ""}}},
+			{"nextRetryAt": nil},
+			{"nextRetryAt": bson.M{"$lte": time.Now()}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if storeMessage == nil {
+		m.logger.Infof("message with id: %v is claimed by another dispatcher or is not due yet", id)
+
+		return nil
+	}
+
+	return m.processStoreMessage(ctx, storeMessage)
+}
+
+// ProcessAll claims the pending outbox messages and internal commands one by one, publishes the outbox messages on the
+// bus and sends the internal commands to their handlers. a claimed message is hidden from the other dispatchers for the
+// lock timeout, so multiple dispatcher instances can run concurrently without processing a message twice.
+func (m *mongoMessagePersistenceService) ProcessAll(ctx context.Context) error {
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		storeMessage, err := m.claim(ctx, bson.M{
+			"deliveryType": bson.M{"$in": dispatchableDeliveryTypes},
+			"$or":          []bson.M{{"nextRetryAt": nil}, {"nextRetryAt": bson.M{"$lte": time.Now()}}},
+		})
+		if err != nil {
+			return err
+		}
+
+		if storeMessage == nil {
+			return nil
+		}
+
+		if err := m.processStoreMessage(ctx, storeMessage); err != nil {
+			return err
+		}
+	}
+}
+
+// claim postpones the next processing of the oldest pending message of the filter by the lock timeout and stamps it with
+// a new claim token atomically, and returns it
+func (m *mongoMessagePersistenceService) claim(
+	ctx context.Context,
+	filter bson.M,
+) (*persistmessage.StoreMessage, error) {
+	now := time.Now()
+
+	filter["messageStatus"] = persistmessage.Stored
+	filter["retryCount"] = bson.M{"$lt": m.options.MaxRetryCount}
+	update := bson.M{"$set": bson.M{
+		"nextRetryAt": now.Add(m.options.LockTimeout),
+		"claimToken":  uuid.NewV4().String(),
+	}}
+
+	// https://www.mongodb.com/docs/manual/reference/method/db.collection.findOneAndUpdate/
+	findOptions := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "createdAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var document storeMessageDocument
+
+	err := m.collection.FindOneAndUpdate(ctx, filter, update, findOptions).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in claiming stored message")
+	}
+
+	return document.toStoreMessage()
+}
+
+// processStoreMessage dispatches the claimed message and persists the result, a dispatch failure doesn't return an error
+// and the message will be retried with exponential backoff until the max retry count, then it is dead-lettered
+func (m *mongoMessagePersistenceService) processStoreMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	var dispatchErr error
+
+	switch storeMessage.DeliveryType {
+	case persistmessage.Outbox:
+		dispatchErr = m.publishClaimedMessage(ctx, storeMessage)
+	case persistmessage.Internal:
+		dispatchErr = m.processInternalCommand(ctx, storeMessage)
+		if dispatchErr == nil {
+			return nil
+		}
+	default:
+		return errors.Errorf(
+			"message with id `%s` and delivery type `%v` can't be processed by the message dispatcher",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+		)
+	}
+
+	claimToken := storeMessage.ClaimToken
+
+	switch {
+	case dispatchErr == nil:
+		storeMessage.MarkAsProcessed(time.Now())
+	case storeMessage.RetryCount+1 >= m.options.MaxRetryCount:
+		storeMessage.IncreaseRetry()
+		storeMessage.MarkAsDeadLettered()
+
+		m.logger.Errorf(
+			"message with id: %v and delivery type: %v is dead-lettered after %d retries, err: %v",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+			storeMessage.RetryCount,
+			dispatchErr,
+		)
+	default:
+		storeMessage.ScheduleRetry(time.Now().Add(m.options.RetryDelay(storeMessage.RetryCount + 1)))
+
+		m.logger.Errorf(
+			"error in processing message with id: %v, delivery type: %v, retry count: %d, err: %v",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+			storeMessage.RetryCount,
+			dispatchErr,
+		)
+	}
+
+	_, err := m.saveClaimed(ctx, storeMessage, claimToken)
+
+	return err
+}
+
+// publishClaimedMessage publishes the outbox message before its claim expires, after the lease the message can be claimed
+// by another dispatcher, so a publish which is slower than the lease is canceled and retried
+func (m *mongoMessagePersistenceService) publishClaimedMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	if storeMessage.NextRetryAt == nil {
+		return m.publishStoreMessage(ctx, storeMessage)
+	}
+
+	leaseCtx, cancel := context.WithDeadline(ctx, *storeMessage.NextRetryAt)
+	defer cancel()
+
+	return m.publishStoreMessage(leaseCtx, storeMessage)
+}
+
+// saveClaimed saves the result of the processing while the message is stored with the claim token of the dispatcher, when
+// the lease expired and another dispatcher claimed the message, the result of the new claim wins
+func (m *mongoMessagePersistenceService) saveClaimed(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+	claimToken string,
+) (bool, error) {
+	result, err := m.collection.ReplaceOne(
+		ctx,
+		bson.M{
+			"_id":           storeMessage.ID.String(),
+			"messageStatus": persistmessage.Stored,
+			"claimToken":    claimToken,
+		},
+		toDocument(storeMessage),
+	)
+	if err != nil {
+		return false, customErrors.NewInternalServerErrorWrap(
+			err,
+			"error in updating the storeMessage",
+		)
+	}
+
+	if result.MatchedCount == 0 {
+		m.logger.Warnf(
+			"message with id: %v is already processed or claimed by another dispatcher",
+			storeMessage.ID,
+		)
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (m *mongoMessagePersistenceService) publishStoreMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	messageEnvelope, err := m.messageSerializer.DeserializeEnvelop(
+		[]byte(storeMessage.Data),
+		storeMessage.DataType,
+		m.messageSerializer.ContentType(),
+	)
+	if err != nil {
+		return err
+	}
+
+	meta := metadata.MapToMetadata(messageEnvelope.Headers)
+	topicName := meta.GetString(persistmessage.TopicOrExchangeNameHeader)
+	delete(meta, persistmessage.TopicOrExchangeNameHeader)
+
+	if topicName != "" {
+		return m.bus.PublishMessageWithTopicName(ctx, messageEnvelope.Message, meta, topicName)
+	}
+
+	return m.bus.PublishMessage(ctx, messageEnvelope.Message, meta)
+}
+
+// processInternalCommand sends the internal command and marks it as processed in one unit of work, so the writes of the
+// command handler are committed together with the processed state when the mongo transactions are enabled. the claim of
+// the message may be expired and claimed by another dispatcher, so the status and the claim token are checked again in
+// the transaction with a write on the message, which conflicts with the concurrent claims like a row lock
+func (m *mongoMessagePersistenceService) processInternalCommand(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	claimed := true
+
+	err := m.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		result, err := m.collection.UpdateOne(
+			ctx,
+			bson.M{
+				"_id":           storeMessage.ID.String(),
+				"messageStatus": persistmessage.Stored,
+				"claimToken":    storeMessage.ClaimToken,
+			},
+			bson.M{"$set": bson.M{"claimToken": storeMessage.ClaimToken}},
+		)
+		if err != nil {
+			return errors.WrapIff(err, "error in locking the internal command with id `%s`", storeMessage.ID)
+		}
+
+		if result.MatchedCount == 0 {
+			claimed = false
+
+			return nil
+		}
+
+		if err := m.sendInternalCommand(ctx, storeMessage); err != nil {
+			return err
+		}
+
+		processed := *storeMessage
+		processed.MarkAsProcessed(time.Now())
+
+		claimed, err = m.saveClaimed(ctx, &processed, storeMessage.ClaimToken)
+		if err != nil {
+			return err
+		}
+
+		*storeMessage = processed
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !claimed {
+		m.logger.Infof(
+			"internal command with id: %v is already processed or claimed by another dispatcher",
+			storeMessage.ID,
+		)
+	}
+
+	return nil
+}
+
+var dispatchableDeliveryTypes = []persistmessage.MessageDeliveryType{persistmessage.Outbox, persistmessage.Internal}
+
+func isDispatchable(deliveryType persistmessage.MessageDeliveryType) bool {
+	return lo.Contains(dispatchableDeliveryTypes, deliveryType)
+}
+
+func (m *mongoMessagePersistenceService) sendInternalCommand(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	internalCommand, err := cqrs.NewInternalCommandInstance(storeMessage.DataType)
+	if err != nil {
+		return err
+	}
+
+	err = m.messageSerializer.Serializer().Unmarshal([]byte(storeMessage.Data), internalCommand)
+	if err != nil {
+		return errors.WrapIff(err, "error in deserializing internal command `%s`", storeMessage.DataType)
+	}
+
+	return cqrs.SendInternalCommand(ctx, internalCommand)
+}
+
+func (m *mongoMessagePersistenceService) AddPublishMessage(
+	messageEnvelope types.MessageEnvelope,
+	ctx context.Context,
+) error {
+	return m.AddMessageCore(ctx, messageEnvelope, persistmessage.Outbox)
+}
+
+func (m *mongoMessagePersistenceService) AddReceivedMessage(
+	messageEnvelope types.MessageEnvelope,
+	ctx context.Context,
+) error {
+	return m.AddMessageCore(ctx, messageEnvelope, persistmessage.Inbox)
+}
+
+func (m *mongoMessagePersistenceService) AddInternalMessage(
+	internalCommand cqrs.InternalCommand,
+	ctx context.Context,
+) error {
+	storeMessage, err := m.newInternalStoreMessage(internalCommand)
+	if err != nil {
+		return err
+	}
+
+	return m.addInternalStoreMessage(ctx, storeMessage)
+}
+
+func (m *mongoMessagePersistenceService) ScheduleInternalMessage(
+	internalCommand cqrs.InternalCommand,
+	processAt time.Time,
+	ctx context.Context,
+) error {
+	storeMessage, err := m.newInternalStoreMessage(internalCommand)
+	if err != nil {
+		return err
+	}
+
+	storeMessage.ScheduleAt(processAt)
+
+	return m.addInternalStoreMessage(ctx, storeMessage)
+}
+
+func (m *mongoMessagePersistenceService) newInternalStoreMessage(
+	internalCommand cqrs.InternalCommand,
+) (*persistmessage.StoreMessage, error) {
+	if internalCommand == nil {
+		return nil, errors.New("internalCommand is nil")
+	}
+
+	data, err := m.messageSerializer.Serializer().Marshal(internalCommand)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in serializing internal command `%T`", internalCommand)
+	}
+
+	// the dispatcher creates the command by its full type name from the internal commands registry
+	return persistmessage.NewStoreMessage(
+		uuid.NewV4(),
+		typeMapper.GetFullTypeName(internalCommand),
+		string(data),
+		persistmessage.Internal,
+	), nil
+}
+
+func (m *mongoMessagePersistenceService) addInternalStoreMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	err := m.Add(ctx, storeMessage)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Infof(
+		"Internal command %s with id: %v saved in persistence message store",
+		storeMessage.DataType,
+		storeMessage.ID,
+	)
+
+	return nil
+}
+
+func (m *mongoMessagePersistenceService) AddMessageCore(
+	ctx context.Context,
+	messageEnvelope types.MessageEnvelope,
+	deliveryType persistmessage.MessageDeliveryType,
+) error {
+	if messageEnvelope.Message == nil {
+		return errors.New("messageEnvelope.Message is nil")
+	}
+
+	id, err := uuid.FromString(messageEnvelope.Message.GeMessageId())
+	if err != nil {
+		id = uuid.NewV4()
+	}
+
+	data, err := m.messageSerializer.SerializeEnvelop(messageEnvelope)
+	if err != nil {
+		return err
+	}
+
+	// we use the full type name of the actual message, because typemapper resolves the message by this name for
+	// deserializing in the dispatcher
+	storeMessage := persistmessage.NewStoreMessage(
+		id,
+		typeMapper.GetFullTypeName(messageEnvelope.Message),
+		string(data.Data),
+		deliveryType,
+	)
+
+	if deliveryType == persistmessage.Inbox {
+		err = m.addInboxMessage(ctx, storeMessage)
+	} else {
+		err = m.Add(ctx, storeMessage)
+	}
+	if err != nil {
+		return err
+	}
+
+	m.logger.Infof(
+		"Message with id: %v and delivery type: %v saved in persistence message store",
+		id,
+		deliveryType,
+	)
+
+	return nil
+}
+
+func (m *mongoMessagePersistenceService) Add(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	if !mongodb.IsTransactionRunning(ctx) {
+		m.logger.Warnf(
+			"storeMessage with id `%s` is added without a mongo transaction, it is not atomic with the other writes",
+			storeMessage.ID,
+		)
+	}
+
+	// https://www.mongodb.com/docs/manual/reference/operator/update/setOnInsert/
+	// a duplicate key error aborts the current transaction, so we upsert with `$setOnInsert` and the caller can still
+	// load the existing message in the transaction
+	result, err := m.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": storeMessage.ID.String()},
+		bson.M{"$setOnInsert": toDocument(storeMessage)},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return customErrors.NewInternalServerErrorWrap(
+			err,
+			"error in inserting the storeMessage",
+		)
+	}
+
+	if result.UpsertedCount == 0 {
+		return customErrors.NewConflictError(
+			fmt.Sprintf("storeMessage with id `%s` already exists", storeMessage.ID.String()),
+		)
+	}
+
+	return nil
+}
+
+// addInboxMessage inserts the received message with its unique id before its handler runs, a duplicate message is a
+// conflict error. the existing message is checked before the insert, because the duplicate key error of a concurrent
+// delivery aborts the current transaction, and its redelivery is skipped without the error
+func (m *mongoMessagePersistenceService) addInboxMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	count, err := m.collection.CountDocuments(
+		ctx,
+		bson.M{"_id": storeMessage.ID.String()},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return customErrors.NewInternalServerErrorWrap(
+			err,
+			"error in inserting the storeMessage",
+		)
+	}
+
+	if count == 0 {
+		_, err = m.collection.InsertOne(ctx, toDocument(storeMessage))
+	}
+
+	if count > 0 || mongo.IsDuplicateKeyError(err) {
+		return customErrors.NewConflictError(
+			fmt.Sprintf("storeMessage with id `%s` already exists", storeMessage.ID.String()),
+		)
+	}
+	if err != nil {
+		return customErrors.NewInternalServerErrorWrap(
+			err,
+			"error in inserting the storeMessage",
+		)
+	}
+
+	return nil
+}
+
+func (m *mongoMessagePersistenceService) Update(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	result, err := m.collection.ReplaceOne(ctx, bson.M{"_id": storeMessage.ID.String()}, toDocument(storeMessage))
+	if err != nil {
+		return customErrors.NewInternalServerErrorWrap(
+			err,
+			"error in updating the storeMessage",
+		)
+	}
+
+	m.logger.Infof("Number of modified documents are: %d", result.ModifiedCount)
+
+	return nil
+}
+
+func (m *mongoMessagePersistenceService) ChangeState(
+	ctx context.Context,
+	messageID uuid.UUID,
+	status persistmessage.MessageStatus,
+) error {
+	storeMessage, err := m.GetById(ctx, messageID)
+	if err != nil {
+		return err
+	}
+
+	if status == persistmessage.Processed {
+		storeMessage.MarkAsProcessed(time.Now())
+	} else {
+		storeMessage.ChangeState(status)
+	}
+
+	return m.Update(ctx, storeMessage)
+}
+
+func (m *mongoMessagePersistenceService) GetAllActive(
+	ctx context.Context,
+) ([]*persistmessage.StoreMessage, error) {
+	return m.find(ctx, bson.M{"messageStatus": persistmessage.Stored})
+}
+
+func (m *mongoMessagePersistenceService) GetById(
+	ctx context.Context,
+	id uuid.UUID,
+) (*persistmessage.StoreMessage, error) {
+	var document storeMessageDocument
+
+	err := m.collection.FindOne(ctx, bson.M{"_id": id.String()}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, customErrors.NewNotFoundErrorWrap(
+			err,
+			fmt.Sprintf(
+				"storeMessage with id `%s` not found in the database",
+				id.String(),
+			),
+		)
+	}
+	if err != nil {
+		return nil, customErrors.NewInternalServerErrorWrap(
+			err,
+			fmt.Sprintf("error in loading storeMessage with id `%s`", id.String()),
+		)
+	}
+
+	return document.toStoreMessage()
+}
+
+func (m *mongoMessagePersistenceService) Remove(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) (bool, error) {
+	id := storeMessage.ID
+
+	result, err := m.collection.DeleteOne(ctx, bson.M{"_id": id.String()})
+	if err != nil {
+		return false, customErrors.NewInternalServerErrorWrap(
+			err,
+			fmt.Sprintf(
+				"error in deleting storeMessage with id `%s` in the database",
+				id.String(),
+			),
+		)
+	}
+
+	if result.DeletedCount == 0 {
+		return false, customErrors.NewNotFoundError(
+			fmt.Sprintf(
+				"storeMessage with id `%s` not found in the database",
+				id.String(),
+			),
+		)
+	}
+
+	return true, nil
+}
+
+func (m *mongoMessagePersistenceService) CleanupMessages(
+	ctx context.Context,
+) error {
+	// processed inbox messages should be kept for the retention period for detecting duplicate messages
+	retentionTime := time.Now().Add(-m.options.RetentionPeriod)
+
+	result, err := m.collection.DeleteMany(ctx, bson.M{
+		"messageStatus": persistmessage.Processed,
+		"$or": []bson.M{
+			{"processedAt": bson.M{"$lt": retentionTime}},
+			{"processedAt": nil, "createdAt": bson.M{"$lt": retentionTime}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	m.logger.Infof("Number of deleted documents are: %d", result.DeletedCount)
+
+	return nil
+}
+
+func (m *mongoMessagePersistenceService) find(
+	ctx context.Context,
+	filter bson.M,
+) ([]*persistmessage.StoreMessage, error) {
+	cursor, err := m.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var documents []*storeMessageDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, err
+	}
+
+	return toStoreMessages(documents)
+}
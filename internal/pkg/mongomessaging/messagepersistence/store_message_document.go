@@ -0,0 +1,72 @@
+package messagepersistence
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// storeMessageDocument is the mongo document of a store message, the id is kept as a string for readable documents and
+// queries
+type storeMessageDocument struct {
+	ID            string                             `bson:"_id"`
+	DataType      string                             `bson:"dataType"`
+	Data          string                             `bson:"data"`
+	CreatedAt     time.Time                          `bson:"createdAt"`
+	RetryCount    int                                `bson:"retryCount"`
+	MessageStatus persistmessage.MessageStatus       `bson:"messageStatus"`
+	DeliveryType  persistmessage.MessageDeliveryType `bson:"deliveryType"`
+	NextRetryAt   *time.Time                         `bson:"nextRetryAt"`
+	ProcessedAt   *time.Time                         `bson:"processedAt"`
+	ClaimToken    string                             `bson:"claimToken"`
+}
+
+func toDocument(storeMessage *persistmessage.StoreMessage) *storeMessageDocument {
+	return &storeMessageDocument{
+		ID:            storeMessage.ID.String(),
+		DataType:      storeMessage.DataType,
+		Data:          storeMessage.Data,
+		CreatedAt:     storeMessage.CreatedAt,
+		RetryCount:    storeMessage.RetryCount,
+		MessageStatus: storeMessage.MessageStatus,
+		DeliveryType:  storeMessage.DeliveryType,
+		NextRetryAt:   storeMessage.NextRetryAt,
+		ProcessedAt:   storeMessage.ProcessedAt,
+		ClaimToken:    storeMessage.ClaimToken,
+	}
+}
+
+func (d *storeMessageDocument) toStoreMessage() (*persistmessage.StoreMessage, error) {
+	id, err := uuid.FromString(d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistmessage.StoreMessage{
+		ID:            id,
+		DataType:      d.DataType,
+		Data:          d.Data,
+		CreatedAt:     d.CreatedAt,
+		RetryCount:    d.RetryCount,
+		MessageStatus: d.MessageStatus,
+		DeliveryType:  d.DeliveryType,
+		NextRetryAt:   d.NextRetryAt,
+		ProcessedAt:   d.ProcessedAt,
+		ClaimToken:    d.ClaimToken,
+	}, nil
+}
+
+func toStoreMessages(documents []*storeMessageDocument) ([]*persistmessage.StoreMessage, error) {
+	storeMessages := make([]*persistmessage.StoreMessage, 0, len(documents))
+	for _, document := range documents {
+		storeMessage, err := document.toStoreMessage()
+		if err != nil {
+			return nil, err
+		}
+		storeMessages = append(storeMessages, storeMessage)
+	}
+
+	return storeMessages, nil
+}
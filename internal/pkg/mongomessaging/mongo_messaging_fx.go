@@ -0,0 +1,71 @@
+package mongomessaging
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongomessaging/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongomessaging/messagepersistence"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
+)
+
+// Module provides the mongo message persistence service for the outbox, inbox and internal messages, it should be used
+// with the mongodb module, and the messages are atomic with the other writes of the mongo unit of work when the mongo
+// transactions are enabled
+var Module = fx.Module(
+	"mongomessagingfx",
+	fx.Provide(
+		config.ProvideConfig,
+		messagepersistence.NewMongoMessageService,
+		messagepersistence.NewMessageDispatcher,
+	),
+	fx.Invoke(createIndexes),
+	fx.Invoke(registerHooks),
+)
+
+// createIndexes creates the index of the pending messages query of the message dispatcher
+func createIndexes(
+	client *mongo.Client,
+	mongoOptions *mongodb.MongoDbOptions,
+	options *config.MongoMessagingOptions,
+) error {
+	collection := client.Database(mongoOptions.Database).Collection(options.CollectionName)
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "messageStatus", Value: 1},
+			{Key: "nextRetryAt", Value: 1},
+			{Key: "createdAt", Value: 1},
+		},
+	})
+
+	return err
+}
+
+func registerHooks(
+	lc fx.Lifecycle,
+	dispatcher messagepersistence.MessageDispatcher,
+	options *config.MongoMessagingOptions,
+) {
+	if !options.EnableDispatcher {
+		return
+	}
+
+	lifetimeCtx := context.Background()
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// https://github.com/uber-go/fx/blob/v1.20.0/app.go#L573
+			// this ctx is just for startup dependencies setup and OnStart callbacks, and it has short timeout 15s, and it is not alive in whole lifetime app
+			dispatcher.Start(lifetimeCtx)
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return dispatcher.Stop(ctx)
+		},
+	})
+}
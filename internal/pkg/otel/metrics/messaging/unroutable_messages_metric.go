@@ -0,0 +1,48 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/constants/telemetrytags"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/metrics"
+
+	"github.com/iancoleman/strcase"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// UnroutableMessagesMetric counts the published messages which are returned by the broker because no queue is bound for them
+type UnroutableMessagesMetric interface {
+	Record(ctx context.Context, messageTypeName string, destination string, routingKey string)
+}
+
+type unroutableMessagesMetric struct {
+	counter metric.Int64Counter
+}
+
+func NewUnroutableMessagesMetric(appMetrics metrics.AppMetrics) (UnroutableMessagesMetric, error) {
+	counter, err := appMetrics.Int64Counter(
+		"messaging.unroutable_messages_total",
+		metric.WithUnit("count"),
+		metric.WithDescription("Measures the number of the published messages which are unroutable, per message type"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unroutableMessagesMetric{counter: counter}, nil
+}
+
+func (u *unroutableMessagesMetric) Record(
+	ctx context.Context,
+	messageTypeName string,
+	destination string,
+	routingKey string,
+) {
+	u.counter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String(telemetrytags.App.MessageType, messageTypeName),
+		attribute.String(telemetrytags.App.MessageName, strcase.ToSnake(messageTypeName)),
+		attribute.String(telemetrytags.Messaging.Destination, destination),
+		attribute.String("messaging.rabbitmq.routing_key", routingKey),
+	))
+}
@@ -3,7 +3,9 @@ package postgresgorm
 import (
 	"fmt"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/health/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/gormunitofwork"
 
 	"go.uber.org/fx"
 )
@@ -16,6 +18,10 @@ var Module = fx.Module(
 		provideConfig,
 		NewGorm,
 		NewSQLDB,
+		fx.Annotate(
+			gormunitofwork.NewGormUnitOfWork,
+			fx.As(new(unitofwork.UnitOfWork)),
+		),
 
 		fx.Annotate(
 			NewGormHealthChecker,
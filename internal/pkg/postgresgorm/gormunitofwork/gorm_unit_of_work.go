@@ -0,0 +1,115 @@
+package gormunitofwork
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+
+	"emperror.dev/errors"
+	"gorm.io/gorm"
+)
+
+type gormUnitOfWork struct {
+	db             *gorm.DB
+	logger         logger.Logger
+	savepointIndex atomic.Int64
+}
+
+// NewGormUnitOfWork creates a unit of work on the gorm transactions, the transaction is stored in the context with
+// `gormextensions.SetTxToContext`, so the gorm db contexts join it with `WithTxIfExists`
+func NewGormUnitOfWork(db *gorm.DB, l logger.Logger) unitofwork.UnitOfWork {
+	return &gormUnitOfWork{db: db, logger: l}
+}
+
+func (u *gormUnitOfWork) Do(ctx context.Context, action unitofwork.ActionFunc) error {
+	if tx := gormextensions.GetTxFromContextIfExists(ctx); tx != nil {
+		return u.doInSavepoint(ctx, tx, action)
+	}
+
+	// https://gorm.io/docs/transactions.html#Transaction
+	tx := u.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return errors.WrapIf(tx.Error, "error in beginning the transaction")
+	}
+
+	u.logger.Info("beginning database transaction")
+
+	scopeCtx, scope := unitofwork.Begin(gormextensions.SetTxToContext(ctx, tx))
+
+	defer func() {
+		if r := recover(); r != nil {
+			u.logger.Errorf("panic in the transaction, rolling back transaction with panic: %+v", r)
+			tx.Rollback()
+
+			panic(r)
+		}
+	}()
+
+	if err := action(scopeCtx); err != nil {
+		u.logger.Error("rolling back transaction")
+		tx.Rollback()
+
+		return err
+	}
+
+	if scope.IsRollbackOnly() {
+		u.logger.Error("rolling back transaction, a nested unit of work is failed")
+		tx.Rollback()
+
+		return unitofwork.ErrRollbackOnly
+	}
+
+	u.logger.Info("committing transaction")
+
+	if err := tx.Commit().Error; err != nil {
+		return errors.WrapIf(err, "error in committing the transaction")
+	}
+
+	if err := scope.Complete(ctx); err != nil {
+		// the transaction is committed, so the errors of the hooks just log
+		u.logger.Errorf("error in running the after-commit hooks: %v", err)
+	}
+
+	return nil
+}
+
+// doInSavepoint runs the nested unit of work in a savepoint of the outer transaction, an error just rolls back the
+// writes of the nested unit of work
+func (u *gormUnitOfWork) doInSavepoint(ctx context.Context, tx *gorm.DB, action unitofwork.ActionFunc) error {
+	// https://gorm.io/docs/transactions.html#SavePoint-RollbackTo
+	savepoint := fmt.Sprintf("sp_%d", u.savepointIndex.Add(1))
+	if err := tx.SavePoint(savepoint).Error; err != nil {
+		return errors.WrapIff(err, "error in creating the savepoint `%s`", savepoint)
+	}
+
+	scopeCtx, scope := unitofwork.Begin(ctx)
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.RollbackTo(savepoint)
+
+			panic(r)
+		}
+	}()
+
+	if err := action(scopeCtx); err != nil {
+		u.logger.Errorf("rolling back to the savepoint `%s`", savepoint)
+		scope.Discard()
+
+		if rollbackErr := tx.RollbackTo(savepoint).Error; rollbackErr != nil {
+			return errors.Append(err, rollbackErr)
+		}
+
+		return err
+	}
+
+	if err := scope.Complete(ctx); err != nil {
+		u.logger.Errorf("error in running the after-commit hooks: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+//go:build unit
+// +build unit
+
+package gormunitofwork
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	defaultLogger "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/defaultlogger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/gormdbcontext"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/repository"
+
+	"emperror.dev/errors"
+	"github.com/glebarez/sqlite"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type unitOfWorkProduct struct {
+	ID   uint `gorm:"primaryKey"`
+	Name string
+}
+
+func Test_Do_Should_Commit_Writes_And_Run_After_Commit_Hooks(t *testing.T) {
+	db := newUnitOfWorkTestDB(t)
+	unitOfWork := NewGormUnitOfWork(db, defaultLogger.GetLogger())
+
+	var namesInHook []string
+
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := addProduct(ctx, "product1"); err != nil {
+			return err
+		}
+
+		return unitofwork.AfterCommit(ctx, func(ctx context.Context) error {
+			// the hook doesn't run in the transaction, and it sees the committed writes
+			assert.Nil(t, gormextensions.GetTxFromContextIfExists(ctx))
+			namesInHook = productNames(t, db)
+
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"product1"}, productNames(t, db))
+	assert.Equal(t, []string{"product1"}, namesInHook)
+}
+
+func Test_Do_Should_Roll_Back_Writes_And_Drop_Hooks_When_Action_Failed(t *testing.T) {
+	db := newUnitOfWorkTestDB(t)
+	unitOfWork := NewGormUnitOfWork(db, defaultLogger.GetLogger())
+
+	hookCalled := false
+
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := addProduct(ctx, "product1"); err != nil {
+			return err
+		}
+
+		if err := unitofwork.AfterCommit(ctx, func(ctx context.Context) error {
+			hookCalled = true
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		return errors.New("action failed")
+	})
+
+	assert.ErrorContains(t, err, "action failed")
+	assert.Empty(t, productNames(t, db))
+	assert.False(t, hookCalled)
+}
+
+func Test_Do_Should_Roll_Back_Writes_And_Repanic_When_Action_Panicked(t *testing.T) {
+	db := newUnitOfWorkTestDB(t)
+	unitOfWork := NewGormUnitOfWork(db, defaultLogger.GetLogger())
+
+	assert.PanicsWithValue(t, "action panicked", func() {
+		_ = unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+			if err := addProduct(ctx, "product1"); err != nil {
+				return err
+			}
+
+			panic("action panicked")
+		})
+	})
+
+	assert.Empty(t, productNames(t, db))
+}
+
+func Test_Do_Should_Roll_Back_Repository_Writes_With_Outbox_Messages(t *testing.T) {
+	db := newUnitOfWorkTestDB(t)
+	require.NoError(t, db.AutoMigrate(&persistmessage.StoreMessage{}))
+
+	unitOfWork := NewGormUnitOfWork(db, defaultLogger.GetLogger())
+	productRepository := repository.NewGenericGormRepository[*unitOfWorkProduct](db)
+	// the outbox messages are written like the postgres message service, by joining the transaction of the context
+	outboxDBContext := gormdbcontext.NewGormDBContext(db)
+
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := productRepository.Add(ctx, &unitOfWorkProduct{Name: "product1"}); err != nil {
+			return err
+		}
+
+		outboxMessage := persistmessage.NewStoreMessage(uuid.NewV4(), "ProductCreated", "{}", persistmessage.Outbox)
+		if err := outboxDBContext.WithTxIfExists(ctx).DB().Create(outboxMessage).Error; err != nil {
+			return err
+		}
+
+		return errors.New("action failed")
+	})
+
+	assert.ErrorContains(t, err, "action failed")
+	assert.Empty(t, productNames(t, db))
+
+	var outboxMessages int64
+	require.NoError(t, db.Model(&persistmessage.StoreMessage{}).Count(&outboxMessages).Error)
+	assert.Zero(t, outboxMessages)
+}
+
+func Test_Nested_Do_Should_Roll_Back_To_Savepoint_When_Nested_Action_Failed(t *testing.T) {
+	db := newUnitOfWorkTestDB(t)
+	unitOfWork := NewGormUnitOfWork(db, defaultLogger.GetLogger())
+
+	var calls []string
+
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := addProduct(ctx, "product1"); err != nil {
+			return err
+		}
+		if err := unitofwork.AfterCommit(ctx, recordHook(&calls, "outer")); err != nil {
+			return err
+		}
+
+		nestedErr := unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := addProduct(ctx, "product2"); err != nil {
+				return err
+			}
+			if err := unitofwork.AfterCommit(ctx, recordHook(&calls, "failed nested")); err != nil {
+				return err
+			}
+
+			return errors.New("nested action failed")
+		})
+		assert.ErrorContains(t, nestedErr, "nested action failed")
+
+		return unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := addProduct(ctx, "product3"); err != nil {
+				return err
+			}
+
+			return unitofwork.AfterCommit(ctx, recordHook(&calls, "nested"))
+		})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"product1", "product3"}, productNames(t, db))
+	assert.Equal(t, []string{"outer", "nested"}, calls)
+}
+
+func Test_Nested_Do_Should_Be_Rolled_Back_With_Outer_Unit_Of_Work(t *testing.T) {
+	db := newUnitOfWorkTestDB(t)
+	unitOfWork := NewGormUnitOfWork(db, defaultLogger.GetLogger())
+
+	var calls []string
+
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := addProduct(ctx, "product1"); err != nil {
+				return err
+			}
+
+			return unitofwork.AfterCommit(ctx, recordHook(&calls, "nested"))
+		})
+		if err != nil {
+			return err
+		}
+
+		return errors.New("outer action failed")
+	})
+
+	assert.ErrorContains(t, err, "outer action failed")
+	assert.Empty(t, productNames(t, db))
+	assert.Empty(t, calls)
+}
+
+func newUnitOfWorkTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	// a database file instead of the in-memory database, because each connection of the pool has its own in-memory database
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "unit_of_work.db")), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(&unitOfWorkProduct{}))
+
+	t.Cleanup(func() {
+		sqlDB, err := db.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+	})
+
+	return db
+}
+
+func addProduct(ctx context.Context, name string) error {
+	tx, err := gormextensions.GetTxFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&unitOfWorkProduct{Name: name}).Error
+}
+
+func productNames(t *testing.T, db *gorm.DB) []string {
+	t.Helper()
+
+	var names []string
+	require.NoError(t, db.Model(&unitOfWorkProduct{}).Order("id").Pluck("name", &names).Error)
+
+	return names
+}
+
+func recordHook(calls *[]string, name string) unitofwork.AfterCommitFunc {
+	return func(ctx context.Context) error {
+		*calls = append(*calls, name)
+
+		return nil
+	}
+}
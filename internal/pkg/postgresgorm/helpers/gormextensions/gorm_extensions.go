@@ -3,13 +3,16 @@ package gormextensions
 import (
 	"context"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/constants"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/contracts"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/scopes"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 
 	"emperror.dev/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func GetTxFromContext(ctx context.Context) (*gorm.DB, error) {
@@ -50,25 +53,102 @@ func SetTxToContext(ctx context.Context, tx *gorm.DB) *contracts.GormContext {
 
 // Ref: https://dev.to/rafaelgfirmino/pagination-using-gorm-scopes-3k5f
 
+// Paginate returns the page of the list query sorted by the primary key, the list query can't have filters, `orderBy` or
+// cursors because they need the allow-list of a schema, they are applied by `FilterPaginate`
 func Paginate[TDataModel any, TEntity any](
 	ctx context.Context,
 	listQuery *utils.ListQuery,
 	db *gorm.DB,
+) (*utils.ListResult[TEntity], error) {
+	query, err := listquery.ParseWithoutSchema(listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate[TDataModel, TEntity](ctx, listQuery, query, db)
+}
+
+// FilterPaginate returns the page of the items which match the filters of the list query sorted by the `orderBy`, the
+// filters and the sorts are validated against the allow-list of the schema, the page starts after the `after` cursor or
+// ends before the `before` cursor when the list query has a cursor
+func FilterPaginate[TDataModel any, TEntity any](
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+	schema *listquery.Schema,
+	db *gorm.DB,
+) (*utils.ListResult[TEntity], error) {
+	query, err := schema.Parse(listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate[TDataModel, TEntity](ctx, listQuery, query, db)
+}
+
+func paginate[TDataModel any, TEntity any](
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+	query *listquery.Query,
+	db *gorm.DB,
 ) (*utils.ListResult[TEntity], error) {
 	var (
 		items     []TEntity
 		totalRows int64
 	)
 
+	where, err := scopes.SpecificationToExpression(query.Specification)
+	if err != nil {
+		return nil, err
+	}
+
+	dataModel := typeMapper.GenericInstanceByT[TDataModel]()
+	db = db.WithContext(ctx).Model(dataModel)
+	if where != nil {
+		db = db.Where(where)
+	}
+	// the filtered query is shared by the count and the find
+	db = db.Session(&gorm.Session{})
+
+	// https://gorm.io/docs/advanced_query.html#Count
+	if err := db.Count(&totalRows).Error; err != nil {
+		return nil, errors.WrapIf(err, "error in counting the items.")
+	}
+
+	if query.Keyset != nil {
+		keyset, err := scopes.SpecificationToExpression(query.Keyset.Specification)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(keyset)
+	}
+
 	// https://gorm.io/docs/advanced_query.html#Smart-Select-Fields
-	if err := db.Scopes(scopes.FilterPaginate[TDataModel](ctx, listQuery)).Find(&items).Error; err != nil {
-		return nil, errors.WrapIf(err, "error in finding products.")
+	// the offset pages need a stable order, so the items are sorted by the primary key when the list query has no sort
+	if len(query.Sorts) == 0 {
+		db = db.Order(clause.OrderByColumn{Column: clause.PrimaryColumn})
+	}
+
+	err = db.Scopes(scopes.OrderBySorts(query.Sorts)).
+		Offset(query.Offset(listQuery)).
+		Limit(query.Limit(listQuery)).
+		Find(&items).
+		Error
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in finding the items.")
 	}
 
-	return utils.NewListResult[TEntity](
+	items, cursors, err := listquery.Page(query, listQuery, items)
+	if err != nil {
+		return nil, err
+	}
+
+	listResult := utils.NewListResult[TEntity](
 		items,
 		listQuery.GetSize(),
 		listQuery.GetPage(),
 		totalRows,
-	), nil
+	)
+	listResult.PreviousCursor, listResult.NextCursor = cursors.Previous, cursors.Next
+
+	return listResult, nil
 }
@@ -0,0 +1,19 @@
+package pipelines
+
+import (
+	unitofworkpipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork/pipelines"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/pipeline"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/gormunitofwork"
+
+	"gorm.io/gorm"
+)
+
+// NewConsumerTransactionPipeline runs the next consumer pipelines and the consumer handler in a gorm unit of work, it
+// should register before the inbox pipeline to store the inbox message in the handler's transaction
+func NewConsumerTransactionPipeline(
+	l logger.Logger,
+	db *gorm.DB,
+) pipeline.ConsumerPipeline {
+	return unitofworkpipelines.NewConsumerUnitOfWorkPipeline(l, gormunitofwork.NewGormUnitOfWork(db, l))
+}
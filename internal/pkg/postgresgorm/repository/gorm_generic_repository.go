@@ -7,10 +7,12 @@ import (
 	"strings"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
 	gormPostgres "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/scopes"
 	reflectionHelper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/reflectionhelper"
 	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
@@ -44,6 +46,16 @@ func NewGenericGormRepository[TEntity interface{}](
 	}
 }
 
+// dbFor returns the transaction of the unit of work in the context, so the writes of the repository are committed together
+// with the other writes of the unit of work (e.g. the outbox messages), otherwise the db of the repository
+func (r *gormGenericRepository[TDataModel, TEntity]) dbFor(ctx context.Context) *gorm.DB {
+	if tx := gormPostgres.GetTxFromContextIfExists(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
+
+	return r.db.WithContext(ctx)
+}
+
 func (r *gormGenericRepository[TDataModel, TEntity]) Add(
 	ctx context.Context,
 	entity TEntity,
@@ -52,7 +64,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Add(
 	modelType := typeMapper.GetGenericTypeByT[TEntity]()
 
 	if modelType == dataModelType {
-		err := r.db.WithContext(ctx).Create(entity).Error
+		err := r.dbFor(ctx).Create(entity).Error
 		if err != nil {
 			return err
 		}
@@ -63,7 +75,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Add(
 		if err != nil {
 			return err
 		}
-		err = r.db.WithContext(ctx).Create(dataModel).Error
+		err = r.dbFor(ctx).Create(dataModel).Error
 		if err != nil {
 			return err
 		}
@@ -100,7 +112,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) GetById(
 
 	if modelType == dataModelType {
 		var model TEntity
-		if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if err := r.dbFor(ctx).First(&model, id).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return *new(TEntity), customErrors.NewNotFoundErrorWrap(
 					err,
@@ -123,7 +135,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) GetById(
 		return model, nil
 	} else {
 		var dataModel TDataModel
-		if err := r.db.WithContext(ctx).First(&dataModel, id).Error; err != nil {
+		if err := r.dbFor(ctx).First(&dataModel, id).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return *new(TEntity), customErrors.NewNotFoundErrorWrap(err, fmt.Sprintf("can't find the entity with id %s into the database.", id.String()))
 			}
@@ -146,7 +158,25 @@ func (r *gormGenericRepository[TDataModel, TEntity]) GetAll(
 	result, err := gormPostgres.Paginate[TDataModel, TEntity](
 		ctx,
 		listQuery,
-		r.db,
+		r.dbFor(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *gormGenericRepository[TDataModel, TEntity]) FindPage(
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+	schema *listquery.Schema,
+) (*utils.ListResult[TEntity], error) {
+	result, err := gormPostgres.FilterPaginate[TDataModel, TEntity](
+		ctx,
+		listQuery,
+		schema,
+		r.dbFor(ctx),
 	)
 	if err != nil {
 		return nil, err
@@ -163,7 +193,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Search(
 	fields := reflectionHelper.GetAllFields(
 		typeMapper.GetGenericTypeByT[TDataModel](),
 	)
-	query := r.db
+	query := r.dbFor(ctx)
 
 	for _, field := range fields {
 		if field.Type.Kind() != reflect.String {
@@ -196,14 +226,14 @@ func (r *gormGenericRepository[TDataModel, TEntity]) GetByFilter(
 	modelType := typeMapper.GetGenericTypeByT[TEntity]()
 	if modelType == dataModelType {
 		var models []TEntity
-		err := r.db.WithContext(ctx).Where(filters).Find(&models).Error
+		err := r.dbFor(ctx).Where(filters).Find(&models).Error
 		if err != nil {
 			return nil, err
 		}
 		return models, nil
 	} else {
 		var dataModels []TDataModel
-		err := r.db.WithContext(ctx).Where(filters).Find(&dataModels).Error
+		err := r.dbFor(ctx).Where(filters).Find(&dataModels).Error
 		if err != nil {
 			return nil, err
 		}
@@ -219,14 +249,51 @@ func (r *gormGenericRepository[TDataModel, TEntity]) GetByFuncFilter(
 	ctx context.Context,
 	filterFunc func(TEntity) bool,
 ) ([]TEntity, error) {
-	return *new([]TEntity), nil
+	// the filter function can't be translated to sql, so the items are filtered after loading
+	entities, err := r.GetByFilter(ctx, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	var models []TEntity
+	for _, entity := range entities {
+		if filterFunc(entity) {
+			models = append(models, entity)
+		}
+	}
+
+	return models, nil
 }
 
 func (r *gormGenericRepository[TDataModel, TEntity]) FirstOrDefault(
 	ctx context.Context,
 	filters map[string]interface{},
 ) (TEntity, error) {
-	return *new(TEntity), nil
+	dataModelType := typeMapper.GetGenericTypeByT[TDataModel]()
+	modelType := typeMapper.GetGenericTypeByT[TEntity]()
+	if modelType == dataModelType {
+		var model TEntity
+		if err := r.dbFor(ctx).Where(filters).First(&model).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return *new(TEntity), nil
+			}
+			return *new(TEntity), err
+		}
+		return model, nil
+	} else {
+		var dataModel TDataModel
+		if err := r.dbFor(ctx).Where(filters).First(&dataModel).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return *new(TEntity), nil
+			}
+			return *new(TEntity), err
+		}
+		model, err := mapper.Map[TEntity](dataModel)
+		if err != nil {
+			return *new(TEntity), err
+		}
+		return model, nil
+	}
 }
 
 func (r *gormGenericRepository[TDataModel, TEntity]) Update(
@@ -236,7 +303,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Update(
 	dataModelType := typeMapper.GetGenericTypeByT[TDataModel]()
 	modelType := typeMapper.GetGenericTypeByT[TEntity]()
 	if modelType == dataModelType {
-		err := r.db.WithContext(ctx).Save(entity).Error
+		err := r.dbFor(ctx).Save(entity).Error
 		if err != nil {
 			return err
 		}
@@ -245,7 +312,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Update(
 		if err != nil {
 			return err
 		}
-		err = r.db.WithContext(ctx).Save(dataModel).Error
+		err = r.dbFor(ctx).Save(dataModel).Error
 		if err != nil {
 			return err
 		}
@@ -282,7 +349,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Delete(
 		return err
 	}
 
-	err = r.db.WithContext(ctx).Delete(entity, id).Error
+	err = r.dbFor(ctx).Delete(entity, id).Error
 	if err != nil {
 		return err
 	}
@@ -299,7 +366,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) SkipTake(
 	modelType := typeMapper.GetGenericTypeByT[TEntity]()
 	if modelType == dataModelType {
 		var models []TEntity
-		err := r.db.WithContext(ctx).
+		err := r.dbFor(ctx).
 			Offset(skip).
 			Limit(take).
 			Find(&models).
@@ -310,7 +377,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) SkipTake(
 		return models, nil
 	} else {
 		var dataModels []TDataModel
-		err := r.db.WithContext(ctx).Offset(skip).Limit(take).Find(&dataModels).Error
+		err := r.dbFor(ctx).Offset(skip).Limit(take).Find(&dataModels).Error
 		if err != nil {
 			return nil, err
 		}
@@ -327,7 +394,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Count(
 ) int64 {
 	var dataModel TDataModel
 	var count int64
-	r.db.WithContext(ctx).Model(&dataModel).Count(&count)
+	r.dbFor(ctx).Model(&dataModel).Count(&count)
 	return count
 }
 
@@ -339,8 +406,8 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Find(
 	modelType := typeMapper.GetGenericTypeByT[TEntity]()
 	if modelType == dataModelType {
 		var models []TEntity
-		err := r.db.WithContext(ctx).
-			Where(specification.GetQuery(), specification.GetValues()...).
+		err := r.dbFor(ctx).
+			Scopes(scopes.FilterBySpecification(specification)).
 			Find(&models).
 			Error
 		if err != nil {
@@ -349,7 +416,7 @@ func (r *gormGenericRepository[TDataModel, TEntity]) Find(
 		return models, nil
 	} else {
 		var dataModels []TDataModel
-		err := r.db.WithContext(ctx).Where(specification.GetQuery(), specification.GetValues()...).Find(&dataModels).Error
+		err := r.dbFor(ctx).Scopes(scopes.FilterBySpecification(specification)).Find(&dataModels).Error
 		if err != nil {
 			return nil, err
 		}
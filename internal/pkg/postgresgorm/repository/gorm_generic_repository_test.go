@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	defaultLogger "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/defaultlogger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
@@ -307,6 +308,138 @@ func (c *gormGenericRepositoryTest) Test_Where_With_Data_Model() {
 	c.Assert().Equal(len(models), 1)
 }
 
+func (c *gormGenericRepositoryTest) Test_Find() {
+	ctx := context.Background()
+
+	models, err := c.productRepository.Find(
+		ctx,
+		specification.And(
+			specification.Equal("is_available", true),
+			specification.Equal("name", "seed_product1"),
+		),
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, len(models))
+	c.Assert().Equal("seed_product1", models[0].Name)
+}
+
+func (c *gormGenericRepositoryTest) Test_Find_With_Or_And_Not() {
+	ctx := context.Background()
+
+	models, err := c.productRepository.Find(
+		ctx,
+		specification.Or(
+			specification.Equal("name", "seed_product1"),
+			specification.Not(specification.LessOrEqual("weight", 100)),
+		),
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, len(models))
+	c.Assert().Equal("seed_product1", models[0].Name)
+}
+
+func (c *gormGenericRepositoryTest) Test_GetByFuncFilter() {
+	ctx := context.Background()
+
+	models, err := c.productRepository.GetByFuncFilter(
+		ctx,
+		func(product *ProductGorm) bool {
+			return product.Name == "seed_product2"
+		},
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, len(models))
+	c.Assert().Equal("seed_product2", models[0].Name)
+}
+
+func (c *gormGenericRepositoryTest) Test_FirstOrDefault() {
+	ctx := context.Background()
+
+	model, err := c.productRepository.FirstOrDefault(
+		ctx,
+		map[string]interface{}{"name": "seed_product2"},
+	)
+	c.Require().NoError(err)
+	c.Require().NotNil(model)
+	c.Assert().Equal("seed_product2", model.Name)
+
+	model, err = c.productRepository.FirstOrDefault(
+		ctx,
+		map[string]interface{}{"name": "not_existing_product"},
+	)
+	c.Require().NoError(err)
+	c.Assert().Nil(model)
+}
+
+func (c *gormGenericRepositoryTest) Test_Find_With_Data_Model() {
+	ctx := context.Background()
+
+	models, err := c.productRepositoryWithDataModel.Find(
+		ctx,
+		specification.And(
+			specification.Equal("is_available", true),
+			specification.Equal("name", "seed_product1"),
+		),
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, len(models))
+	c.Assert().Equal("seed_product1", models[0].Name)
+}
+
+func (c *gormGenericRepositoryTest) Test_Find_With_Or_And_Not_With_Data_Model() {
+	ctx := context.Background()
+
+	models, err := c.productRepositoryWithDataModel.Find(
+		ctx,
+		specification.Or(
+			specification.Equal("name", "seed_product1"),
+			specification.Not(specification.LessOrEqual("weight", 100)),
+		),
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, len(models))
+	c.Assert().Equal("seed_product1", models[0].Name)
+}
+
+func (c *gormGenericRepositoryTest) Test_GetByFuncFilter_With_Data_Model() {
+	ctx := context.Background()
+
+	models, err := c.productRepositoryWithDataModel.GetByFuncFilter(
+		ctx,
+		func(product *Product) bool {
+			return product.Name == "seed_product2"
+		},
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, len(models))
+	c.Assert().Equal("seed_product2", models[0].Name)
+}
+
+func (c *gormGenericRepositoryTest) Test_FirstOrDefault_With_Data_Model() {
+	ctx := context.Background()
+
+	model, err := c.productRepositoryWithDataModel.FirstOrDefault(
+		ctx,
+		map[string]interface{}{"name": "seed_product2"},
+	)
+	c.Require().NoError(err)
+	c.Require().NotNil(model)
+	c.Assert().Equal("seed_product2", model.Name)
+
+	model, err = c.productRepositoryWithDataModel.FirstOrDefault(
+		ctx,
+		map[string]interface{}{"name": "not_existing_product"},
+	)
+	c.Require().NoError(err)
+	c.Assert().Nil(model)
+}
+
 func (c *gormGenericRepositoryTest) Test_Update() {
 	ctx := context.Background()
 
@@ -0,0 +1,145 @@
+package sagastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/saga"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+
+	"emperror.dev/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SagaInstance struct {
+	SagaName      string `gorm:"primaryKey"`
+	CorrelationId string `gorm:"primaryKey"`
+	State         string
+	Data          []byte
+	Compensations []string `gorm:"serializer:json"`
+	Completed     bool
+	Version       int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (s *SagaInstance) TableName() string {
+	return "saga_instances"
+}
+
+// Migrate creates the saga instances table
+func Migrate(db *gorm.DB) error {
+	return db.Migrator().AutoMigrate(&SagaInstance{})
+}
+
+type postgresSagaStore struct {
+	db *gorm.DB
+}
+
+func NewPostgresSagaStore(db *gorm.DB) saga.SagaStore {
+	return &postgresSagaStore{
+		db: db,
+	}
+}
+
+func (p *postgresSagaStore) Load(
+	ctx context.Context,
+	sagaName string,
+	correlationId string,
+) (*saga.SagaInstance, error) {
+	var instance SagaInstance
+
+	result := p.getDB(ctx).
+		Where("saga_name = ? AND correlation_id = ?", sagaName, correlationId).
+		Limit(1).
+		Find(&instance)
+	if result.Error != nil {
+		return nil, errors.WrapIf(result.Error, "error in loading saga instance")
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return &saga.SagaInstance{
+		CorrelationId: instance.CorrelationId,
+		SagaName:      instance.SagaName,
+		State:         instance.State,
+		Data:          instance.Data,
+		Compensations: instance.Compensations,
+		Completed:     instance.Completed,
+		Version:       instance.Version,
+		CreatedAt:     instance.CreatedAt,
+		UpdatedAt:     instance.UpdatedAt,
+	}, nil
+}
+
+func (p *postgresSagaStore) Save(ctx context.Context, instance *saga.SagaInstance, expectedVersion int64) error {
+	row := &SagaInstance{
+		SagaName:      instance.SagaName,
+		CorrelationId: instance.CorrelationId,
+		State:         instance.State,
+		Data:          instance.Data,
+		Compensations: instance.Compensations,
+		Completed:     instance.Completed,
+		Version:       instance.Version,
+		CreatedAt:     instance.CreatedAt,
+		UpdatedAt:     instance.UpdatedAt,
+	}
+
+	if expectedVersion == 0 {
+		// a conflicting insert doesn't abort the transaction with `ON CONFLICT DO NOTHING`
+		// https://gorm.io/docs/create.html#Upsert-x2F-On-Conflict
+		result := p.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(row)
+		if result.Error != nil {
+			return errors.WrapIf(result.Error, "error in inserting saga instance")
+		}
+
+		if result.RowsAffected == 0 {
+			return saga.ErrSagaConcurrencyConflict
+		}
+
+		return nil
+	}
+
+	// https://gorm.io/docs/update.html#Update-Selected-Fields
+	result := p.getDB(ctx).
+		Model(&SagaInstance{}).
+		Where("saga_name = ? AND correlation_id = ? AND version = ?", row.SagaName, row.CorrelationId, expectedVersion).
+		Select("state", "data", "compensations", "completed", "version", "updated_at").
+		Updates(row)
+	if result.Error != nil {
+		return errors.WrapIf(result.Error, "error in updating saga instance")
+	}
+
+	if result.RowsAffected == 0 {
+		return saga.ErrSagaConcurrencyConflict
+	}
+
+	return nil
+}
+
+func (p *postgresSagaStore) ExecuteInTransaction(
+	ctx context.Context,
+	action func(ctx context.Context) error,
+) error {
+	// joining to the existing transaction
+	if gormextensions.GetTxFromContextIfExists(ctx) != nil {
+		return action(ctx)
+	}
+
+	// https://gorm.io/docs/transactions.html#Transaction
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return action(gormextensions.SetTxToContext(ctx, tx))
+	})
+}
+
+func (p *postgresSagaStore) getDB(ctx context.Context) *gorm.DB {
+	tx := gormextensions.GetTxFromContextIfExists(ctx)
+	if tx != nil {
+		return tx
+	}
+
+	return p.db.WithContext(ctx)
+}
@@ -0,0 +1,153 @@
+//go:build unit
+// +build unit
+
+package sagastore
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config/environment"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/saga"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/external/fxlog"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/zap"
+	gormPostgres "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"gorm.io/gorm"
+)
+
+type SagaStoreTestSuite struct {
+	suite.Suite
+	db         *gorm.DB
+	store      saga.SagaStore
+	app        *fxtest.App
+	dbFilePath string
+}
+
+func TestPostgresSagaStore(t *testing.T) {
+	suite.Run(t, new(SagaStoreTestSuite))
+}
+
+func (s *SagaStoreTestSuite) Test_Load_Should_Return_Nil_For_New_Saga() {
+	instance, err := s.store.Load(context.Background(), "orders", "1")
+	s.Require().NoError(err)
+
+	s.Assert().Nil(instance)
+}
+
+func (s *SagaStoreTestSuite) Test_Save_Should_Insert_And_Update_Instance() {
+	ctx := context.Background()
+
+	err := s.store.Save(ctx, newInstance(1, "AwaitingStock"), 0)
+	s.Require().NoError(err)
+
+	updated := newInstance(2, "AwaitingPayment")
+	updated.Compensations = []string{"release-stock"}
+	err = s.store.Save(ctx, updated, 1)
+	s.Require().NoError(err)
+
+	instance, err := s.store.Load(ctx, "orders", "1")
+	s.Require().NoError(err)
+
+	s.Assert().Equal("AwaitingPayment", instance.State)
+	s.Assert().Equal(int64(2), instance.Version)
+	s.Assert().Equal([]string{"release-stock"}, instance.Compensations)
+	s.Assert().JSONEq(`{"orderId":"1"}`, string(instance.Data))
+}
+
+func (s *SagaStoreTestSuite) Test_Save_Should_Return_Conflict_For_Stale_Version() {
+	ctx := context.Background()
+
+	err := s.store.Save(ctx, newInstance(1, "AwaitingStock"), 0)
+	s.Require().NoError(err)
+
+	err = s.store.Save(ctx, newInstance(1, "AwaitingStock"), 0)
+	s.Assert().True(saga.IsSagaConcurrencyConflict(err))
+
+	err = s.store.Save(ctx, newInstance(2, "AwaitingPayment"), 1)
+	s.Require().NoError(err)
+
+	err = s.store.Save(ctx, newInstance(2, "Failed"), 1)
+	s.Assert().True(saga.IsSagaConcurrencyConflict(err))
+}
+
+func (s *SagaStoreTestSuite) Test_ExecuteInTransaction_Should_Rollback_Instance() {
+	ctx := context.Background()
+
+	err := s.store.ExecuteInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.store.Save(ctx, newInstance(1, "AwaitingStock"), 0); err != nil {
+			return err
+		}
+
+		return errors.New("publishing failed")
+	})
+	s.Require().Error(err)
+
+	instance, err := s.store.Load(ctx, "orders", "1")
+	s.Require().NoError(err)
+
+	s.Assert().Nil(instance)
+}
+
+func newInstance(version int64, state string) *saga.SagaInstance {
+	return &saga.SagaInstance{
+		CorrelationId: "1",
+		SagaName:      "orders",
+		State:         state,
+		Data:          []byte(`{"orderId":"1"}`),
+		Version:       version,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+func (s *SagaStoreTestSuite) SetupTest() {
+	var gormDB *gorm.DB
+	var gormOptions *gormPostgres.GormOptions
+
+	app := fxtest.New(
+		s.T(),
+		config.ModuleFunc(environment.Test),
+		zap.Module,
+		fxlog.FxLogger,
+		gormPostgres.Module,
+		fx.Decorate(
+			func(cfg *gormPostgres.GormOptions) (*gormPostgres.GormOptions, error) {
+				// using sql-lite with a database file
+				cfg.UseSQLLite = true
+
+				return cfg, nil
+			},
+		),
+		fx.Populate(&gormDB),
+		fx.Populate(&gormOptions),
+	).RequireStart()
+
+	s.app = app
+	s.db = gormDB
+	s.dbFilePath = gormOptions.Dns()
+
+	err := Migrate(gormDB)
+	s.Require().NoError(err)
+
+	s.store = NewPostgresSagaStore(gormDB)
+}
+
+func (s *SagaStoreTestSuite) TearDownTest() {
+	sqldb, _ := s.db.DB()
+	err := sqldb.Close()
+	s.Require().NoError(err)
+
+	// removing sql-lite file
+	err = os.Remove(s.dbFilePath)
+	s.Require().NoError(err)
+
+	s.app.RequireStop()
+}
@@ -0,0 +1,45 @@
+package scopes
+
+import (
+	"strings"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrderBySorts sorts the items by the sorts of the list query, in the order of the sorts
+func OrderBySorts(sorts []listquery.Sort) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		expression := SortsToExpression(sorts)
+		if expression == nil {
+			return db
+		}
+
+		return db.Clauses(clause.OrderBy{Expression: expression})
+	}
+}
+
+// SortsToExpression compiles the sorts to the expression of the order by clause, the jsonb paths are sorted by their text
+// values, the expression is nil when there is no sort
+func SortsToExpression(sorts []listquery.Sort) clause.Expression {
+	if len(sorts) == 0 {
+		return nil
+	}
+
+	items := make([]string, 0, len(sorts))
+	vars := make([]interface{}, 0, len(sorts))
+
+	for _, sort := range sorts {
+		item := "? ASC"
+		if sort.Descending {
+			item = "? DESC"
+		}
+
+		items = append(items, item)
+		vars = append(vars, columnExpression(sort.Field, nil))
+	}
+
+	return clause.Expr{SQL: strings.Join(items, ", "), Vars: vars}
+}
@@ -0,0 +1,59 @@
+//go:build unit
+// +build unit
+
+package scopes
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func Test_Order_By_Sorts_Should_Compile_Sorts_To_Order_By_Clause(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	statement := db.Session(&gorm.Session{DryRun: true}).
+		Scopes(OrderBySorts([]listquery.Sort{
+			{Field: specification.NewFieldName("weight"), Descending: true},
+			{Field: specification.NewFieldName("name")},
+		})).
+		Find(&[]*specificationProduct{}).
+		Statement
+
+	assert.Equal(
+		t,
+		"SELECT * FROM `specification_products` ORDER BY `weight` DESC, `name` ASC",
+		statement.SQL.String(),
+	)
+}
+
+func Test_Order_By_Sorts_Should_Sort_Items(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	var products []*specificationProduct
+	err := db.Scopes(
+		FilterBySpecification(specification.NotEqual("name", "product2")),
+		OrderBySorts([]listquery.Sort{{Field: specification.NewFieldName("weight"), Descending: true}}),
+	).Find(&products).Error
+	require.NoError(t, err)
+
+	require.Len(t, products, 2)
+	assert.Equal(t, "product3", products[0].Name)
+	assert.Equal(t, "product1", products[1].Name)
+}
+
+func Test_Order_By_Sorts_Should_Not_Change_Query_Without_Sorts(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	statement := db.Session(&gorm.Session{DryRun: true}).
+		Scopes(OrderBySorts(nil)).
+		Find(&[]*specificationProduct{}).
+		Statement
+
+	assert.Equal(t, "SELECT * FROM `specification_products`", statement.SQL.String())
+}
@@ -1,11 +1,6 @@
 package scopes
 
 import (
-	"context"
-	"fmt"
-	"strings"
-
-	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 
 	uuid "github.com/satori/go.uuid"
@@ -44,45 +39,9 @@ func FilterByID(id uuid.UUID) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
-func FilterPaginate[TDataModel any](
-	ctx context.Context,
-	listQuery *utils.ListQuery,
-) func(db *gorm.DB) *gorm.DB {
+// Paginate skips the items of the previous pages and limits the items to the page size of the list query
+func Paginate(listQuery *utils.ListQuery) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		var totalRows int64
-
-		dataModel := typeMapper.GenericInstanceByT[TDataModel]()
-		// https://gorm.io/docs/advanced_query.html
-		db.WithContext(ctx).Model(dataModel).Count(&totalRows)
-
-		// generate where query
-		query := db.WithContext(ctx).
-			Model(dataModel).
-			Offset(listQuery.GetOffset()).
-			Limit(listQuery.GetLimit()).
-			Order(listQuery.GetOrderBy())
-
-		if listQuery.Filters != nil {
-			for _, filter := range listQuery.Filters {
-				column := filter.Field
-				action := filter.Comparison
-				value := filter.Value
-
-				switch action {
-				case "equals":
-					whereQuery := fmt.Sprintf("%s = ?", column)
-					query = query.Where(whereQuery, value)
-				case "contains":
-					whereQuery := fmt.Sprintf("%s LIKE ?", column)
-					query = query.Where(whereQuery, "%"+value+"%")
-				case "in":
-					whereQuery := fmt.Sprintf("%s IN (?)", column)
-					queryArray := strings.Split(value, ",")
-					query = query.Where(whereQuery, queryArray)
-				}
-			}
-		}
-
-		return query
+		return db.Offset(listQuery.GetOffset()).Limit(listQuery.GetLimit())
 	}
 }
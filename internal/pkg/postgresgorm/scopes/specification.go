@@ -0,0 +1,231 @@
+package scopes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	"emperror.dev/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FilterBySpecification filters the items by the specification, the error of compiling the specification is added to the
+// gorm errors and returned by the runner function
+func FilterBySpecification(spec specification.Specification) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		expression, err := SpecificationToExpression(spec)
+		if err != nil {
+			_ = db.AddError(err)
+			return db
+		}
+
+		if expression == nil {
+			return db
+		}
+
+		return db.Where(expression)
+	}
+}
+
+// SpecificationToExpression compiles the specification to a gorm clause expression, the expression is nil for the
+// specifications which match all the items
+func SpecificationToExpression(spec specification.Specification) (clause.Expression, error) {
+	switch s := spec.(type) {
+	case nil:
+		return nil, nil
+	case specification.AndSpecification:
+		expressions, err := specificationsToExpressions(s.Specifications)
+		if err != nil {
+			return nil, err
+		}
+		if len(expressions) == 0 {
+			return nil, nil
+		}
+
+		return clause.And(expressions...), nil
+	case specification.OrSpecification:
+		if len(s.Specifications) == 0 {
+			return nil, errors.New("or specification needs at least one specification")
+		}
+
+		expressions, err := specificationsToExpressions(s.Specifications)
+		if err != nil {
+			return nil, err
+		}
+		if len(expressions) < len(s.Specifications) {
+			// one of the specifications matches all the items
+			return nil, nil
+		}
+
+		return clause.Or(expressions...), nil
+	case specification.NotSpecification:
+		expression, err := SpecificationToExpression(s.Specification)
+		if err != nil {
+			return nil, err
+		}
+		if expression == nil {
+			return clause.Expr{SQL: "1 = 0"}, nil
+		}
+
+		return clause.Not(expression), nil
+	case specification.FieldSpecification:
+		normalized := specification.Normalize(s)
+		if fieldSpecification, ok := normalized.(specification.FieldSpecification); ok {
+			return fieldSpecificationToExpression(fieldSpecification)
+		}
+
+		return SpecificationToExpression(normalized)
+	default:
+		return nil, errors.Errorf("specification '%T' is not supported by gorm", spec)
+	}
+}
+
+func specificationsToExpressions(specifications []specification.Specification) ([]clause.Expression, error) {
+	expressions := make([]clause.Expression, 0, len(specifications))
+
+	for _, spec := range specifications {
+		expression, err := SpecificationToExpression(spec)
+		if err != nil {
+			return nil, err
+		}
+		if expression != nil {
+			expressions = append(expressions, expression)
+		}
+	}
+
+	return expressions, nil
+}
+
+func fieldSpecificationToExpression(spec specification.FieldSpecification) (clause.Expression, error) {
+	if spec.Field.Column == "" {
+		return nil, errors.New("field specification needs a field")
+	}
+
+	if spec.Operator == specification.OperatorContains {
+		return containsExpression(spec)
+	}
+
+	column := columnExpression(spec.Field, spec.Value)
+
+	switch spec.Operator {
+	case specification.OperatorEqual:
+		return clause.Eq{Column: column, Value: spec.Value}, nil
+	case specification.OperatorNotEqual:
+		return clause.Neq{Column: column, Value: spec.Value}, nil
+	case specification.OperatorGreaterThan:
+		return clause.Gt{Column: column, Value: spec.Value}, nil
+	case specification.OperatorGreaterOrEqual:
+		return clause.Gte{Column: column, Value: spec.Value}, nil
+	case specification.OperatorLessThan:
+		return clause.Lt{Column: column, Value: spec.Value}, nil
+	case specification.OperatorLessOrEqual:
+		return clause.Lte{Column: column, Value: spec.Value}, nil
+	case specification.OperatorIn, specification.OperatorNotIn:
+		values, ok := spec.Value.([]any)
+		if !ok {
+			return nil, errors.Errorf("value of the '%s' operator of field '%s' should be a slice", spec.Operator, spec.Field)
+		}
+
+		in := clause.IN{Column: column, Values: values}
+		if spec.Operator == specification.OperatorNotIn {
+			return clause.Not(in), nil
+		}
+
+		return in, nil
+	case specification.OperatorLike:
+		return clause.Like{Column: column, Value: spec.Value}, nil
+	case specification.OperatorILike:
+		return clause.Expr{SQL: "? ILIKE ?", Vars: []interface{}{column, spec.Value}}, nil
+	case specification.OperatorBetween:
+		valueRange, ok := spec.Value.(specification.Range)
+		if !ok {
+			return nil, errors.Errorf("value of the between operator of field '%s' should be a range", spec.Field)
+		}
+
+		return clause.Expr{
+			SQL:  "? BETWEEN ? AND ?",
+			Vars: []interface{}{column, valueRange.From, valueRange.To},
+		}, nil
+	case specification.OperatorIsNull:
+		// gorm renders the equality with the nil value as `IS NULL`
+		return clause.Eq{Column: column, Value: nil}, nil
+	case specification.OperatorIsNotNull:
+		return clause.Neq{Column: column, Value: nil}, nil
+	default:
+		return nil, errors.Errorf(
+			"operator '%s' of field '%s' is not supported by gorm",
+			spec.Operator,
+			spec.Field,
+		)
+	}
+}
+
+// columnExpression returns the column, or the text value of the jsonb path which is cast to the type of the compared value,
+// because postgres extracts the jsonb values as text
+func columnExpression(field specification.FieldName, value any) interface{} {
+	column := clause.Column{Name: field.Column}
+	if len(field.Path) == 0 {
+		return column
+	}
+
+	sql := "(? #>> ?)"
+	switch reflect.Indirect(reflect.ValueOf(sampleValue(value))).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		sql += "::numeric"
+	case reflect.Bool:
+		sql += "::boolean"
+	}
+
+	return clause.Expr{SQL: sql, Vars: []interface{}{column, jsonPath(field.Path)}}
+}
+
+// containsExpression matches the array columns, or the jsonb arrays of the path, which contain the value
+func containsExpression(spec specification.FieldSpecification) (clause.Expression, error) {
+	column := clause.Column{Name: spec.Field.Column}
+	if len(spec.Field.Path) == 0 {
+		return clause.Expr{SQL: "? = ANY(?)", Vars: []interface{}{spec.Value, column}}, nil
+	}
+
+	value, err := json.Marshal([]any{spec.Value})
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in marshaling the contains value of field '%s'", spec.Field)
+	}
+
+	return clause.Expr{
+		SQL:  "(? #> ?) @> ?::jsonb",
+		Vars: []interface{}{column, jsonPath(spec.Field.Path), string(value)},
+	}, nil
+}
+
+// jsonPath creates the postgres text array of the path, e.g. `{"address","city"}`
+func jsonPath(path []string) string {
+	elements := make([]string, 0, len(path))
+	for _, element := range path {
+		element = strings.ReplaceAll(element, `\`, `\\`)
+		element = strings.ReplaceAll(element, `"`, `\"`)
+		elements = append(elements, fmt.Sprintf(`"%s"`, element))
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(elements, ","))
+}
+
+// sampleValue returns a value which shows the type of the compared values
+func sampleValue(value any) any {
+	switch v := value.(type) {
+	case []any:
+		if len(v) > 0 {
+			return v[0]
+		}
+		return nil
+	case specification.Range:
+		return v.From
+	default:
+		return v
+	}
+}
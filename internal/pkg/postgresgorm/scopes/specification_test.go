@@ -0,0 +1,215 @@
+//go:build unit
+// +build unit
+
+package scopes
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type specificationProduct struct {
+	Id          int `gorm:"primaryKey"`
+	Name        string
+	Weight      int
+	Description *string
+}
+
+func Test_Filter_By_Specification_Should_Compile_Expression_Tree_To_Where_Clause(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	statement := db.Session(&gorm.Session{DryRun: true}).
+		Scopes(FilterBySpecification(specification.And(
+			specification.Equal("name", "product1"),
+			specification.Or(
+				specification.GreaterThan("weight", 10),
+				specification.Not(specification.LessOrEqual("weight", 5)),
+			),
+			specification.IsNull("description"),
+		))).
+		Find(&[]*specificationProduct{}).
+		Statement
+
+	assert.Equal(
+		t,
+		"SELECT * FROM `specification_products` WHERE (`name` = ? AND (`weight` > ? OR `weight` > ?) AND `description` IS NULL)",
+		statement.SQL.String(),
+	)
+	assert.Equal(t, []interface{}{"product1", 10, 5}, statement.Vars)
+}
+
+func Test_Filter_By_Specification_Should_Compile_Operators_And_Json_Paths(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	statement := db.Session(&gorm.Session{DryRun: true}).
+		Scopes(FilterBySpecification(specification.And(
+			specification.In("name", "product1", "product2"),
+			specification.NotIn("weight", 1, 2),
+			specification.ILike("name", "prod%"),
+			specification.Not(specification.Between("weight", 10, 20)),
+			specification.IsNotNull("description"),
+			specification.Contains("tags", "new"),
+			specification.FieldSpecification{
+				Field:    specification.NewFieldName("attributes", "size", "width"),
+				Operator: specification.OperatorGreaterThan,
+				Value:    10,
+			},
+			specification.FieldSpecification{
+				Field:    specification.NewFieldName("attributes", "colors"),
+				Operator: specification.OperatorContains,
+				Value:    "red",
+			},
+		))).
+		Find(&[]*specificationProduct{}).
+		Statement
+
+	assert.Equal(
+		t,
+		"SELECT * FROM `specification_products` WHERE (`name` IN (?,?) AND `weight` NOT IN (?,?) AND `name` ILIKE ? "+
+			"AND NOT (`weight` BETWEEN ? AND ?) AND `description` IS NOT NULL AND ? = ANY(`tags`) "+
+			"AND (`attributes` #>> ?)::numeric > ? AND (`attributes` #> ?) @> ?::jsonb)",
+		statement.SQL.String(),
+	)
+	assert.Equal(
+		t,
+		[]interface{}{
+			"product1", "product2", 1, 2, "prod%", 10, 20, "new",
+			`{"size","width"}`, 10, `{"colors"}`, `["red"]`,
+		},
+		statement.Vars,
+	)
+}
+
+func Test_Filter_By_Specification_Should_Find_Items_With_Operators(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	testCases := []struct {
+		name          string
+		specification specification.Specification
+		expected      []string
+	}{
+		{
+			name:          "in",
+			specification: specification.In("name", "product1", "product3"),
+			expected:      []string{"product1", "product3"},
+		},
+		{
+			name:          "not in",
+			specification: specification.NotIn("name", "product1", "product3"),
+			expected:      []string{"product2"},
+		},
+		{
+			name:          "empty in",
+			specification: specification.In[string]("name"),
+			expected:      nil,
+		},
+		{
+			name:          "empty not in",
+			specification: specification.NotIn[string]("name"),
+			expected:      []string{"product1", "product2", "product3"},
+		},
+		{
+			name:          "not equal",
+			specification: specification.NotEqual("description", "other"),
+			expected:      []string{"product2"},
+		},
+		{
+			name:          "like",
+			specification: specification.Like("name", "%uct_"),
+			expected:      []string{"product1", "product2", "product3"},
+		},
+		{
+			name:          "between",
+			specification: specification.Between("weight", 15, 30),
+			expected:      []string{"product2", "product3"},
+		},
+		{
+			name:          "is not null",
+			specification: specification.IsNotNull("description"),
+			expected:      []string{"product2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var products []*specificationProduct
+			err := db.Scopes(FilterBySpecification(testCase.specification)).Order("id").Find(&products).Error
+			require.NoError(t, err)
+
+			var names []string
+			for _, product := range products {
+				names = append(names, product.Name)
+			}
+			assert.Equal(t, testCase.expected, names)
+		})
+	}
+}
+
+func Test_Filter_By_Specification_Should_Find_Matching_Items(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	var products []*specificationProduct
+	err := db.Scopes(FilterBySpecification(specification.Or(
+		specification.Equal("name", "product1"),
+		specification.GreaterOrEqual("weight", 30),
+	))).Order("id").Find(&products).Error
+	require.NoError(t, err)
+
+	require.Len(t, products, 2)
+	assert.Equal(t, "product1", products[0].Name)
+	assert.Equal(t, "product3", products[1].Name)
+
+	products = nil
+	err = db.Scopes(FilterBySpecification(specification.LessOrEqual("weight", 20))).Order("id").Find(&products).Error
+	require.NoError(t, err)
+
+	require.Len(t, products, 2)
+	assert.Equal(t, "product1", products[0].Name)
+	assert.Equal(t, "product2", products[1].Name)
+}
+
+func Test_Filter_By_Specification_Should_Return_All_Items_For_Empty_And(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	var products []*specificationProduct
+	err := db.Scopes(FilterBySpecification(specification.And())).Find(&products).Error
+	require.NoError(t, err)
+
+	assert.Len(t, products, 3)
+}
+
+func Test_Filter_By_Specification_Should_Return_Error_For_Unsupported_Operator(t *testing.T) {
+	db := newSpecificationTestDB(t)
+
+	var products []*specificationProduct
+	err := db.Scopes(FilterBySpecification(specification.FieldSpecification{
+		Field:    specification.NewFieldName("name"),
+		Operator: "unknown",
+	})).Find(&products).Error
+
+	assert.ErrorContains(t, err, "operator 'unknown' of field 'name' is not supported by gorm")
+}
+
+func newSpecificationTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(&specificationProduct{}))
+
+	description := "description"
+	require.NoError(t, db.Create([]*specificationProduct{
+		{Name: "product1", Weight: 10},
+		{Name: "product2", Weight: 20, Description: &description},
+		{Name: "product3", Weight: 30},
+	}).Error)
+
+	return db
+}
@@ -0,0 +1,14 @@
+package subscriptioncheckpoint
+
+import (
+	"go.uber.org/fx"
+)
+
+// Module creates the subscription checkpoints table and provides the postgres subscription checkpoint repository, the
+// services decorate the `SubscriptionCheckpointRepository` of the event store with it for storing the checkpoints in
+// the transaction of the postgres read models projections
+var Module = fx.Module(
+	"postgressubscriptioncheckpointfx",
+	fx.Provide(NewPostgresSubscriptionCheckpointRepository),
+	fx.Invoke(Migrate),
+)
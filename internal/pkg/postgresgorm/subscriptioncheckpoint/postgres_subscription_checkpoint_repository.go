@@ -0,0 +1,108 @@
+package subscriptioncheckpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+
+	"emperror.dev/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SubscriptionCheckpoint struct {
+	SubscriptionId string `gorm:"primaryKey"`
+	Position       uint64
+	CheckpointAt   time.Time
+}
+
+func (s *SubscriptionCheckpoint) TableName() string {
+	return "subscription_checkpoints"
+}
+
+// Migrate creates the subscription checkpoints table
+func Migrate(db *gorm.DB) error {
+	return db.Migrator().AutoMigrate(&SubscriptionCheckpoint{})
+}
+
+type postgresSubscriptionCheckpointRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresSubscriptionCheckpointRepository(
+	db *gorm.DB,
+) contracts.TransactionalSubscriptionCheckpointRepository {
+	return &postgresSubscriptionCheckpointRepository{
+		db: db,
+	}
+}
+
+func (p *postgresSubscriptionCheckpointRepository) Load(
+	subscriptionId string,
+	ctx context.Context,
+) (uint64, error) {
+	var checkpoint SubscriptionCheckpoint
+
+	result := p.getDB(ctx).
+		Where("subscription_id = ?", subscriptionId).
+		Limit(1).
+		Find(&checkpoint)
+	if result.Error != nil {
+		return 0, errors.WrapIf(result.Error, "error in loading subscription checkpoint")
+	}
+
+	if result.RowsAffected == 0 {
+		return 0, nil
+	}
+
+	return checkpoint.Position, nil
+}
+
+func (p *postgresSubscriptionCheckpointRepository) Store(
+	subscriptionId string,
+	position uint64,
+	ctx context.Context,
+) error {
+	checkpoint := &SubscriptionCheckpoint{
+		SubscriptionId: subscriptionId,
+		Position:       position,
+		CheckpointAt:   time.Now(),
+	}
+
+	// https://gorm.io/docs/create.html#Upsert-x2F-On-Conflict
+	result := p.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "subscription_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"position", "checkpoint_at"}),
+	}).Create(checkpoint)
+	if result.Error != nil {
+		return errors.WrapIf(result.Error, "error in storing subscription checkpoint")
+	}
+
+	return nil
+}
+
+func (p *postgresSubscriptionCheckpointRepository) ExecuteInTransaction(
+	ctx context.Context,
+	action func(ctx context.Context) error,
+) error {
+	// joining to the existing transaction
+	if gormextensions.GetTxFromContextIfExists(ctx) != nil {
+		return action(ctx)
+	}
+
+	// https://gorm.io/docs/transactions.html#Transaction
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return action(gormextensions.SetTxToContext(ctx, tx))
+	})
+}
+
+func (p *postgresSubscriptionCheckpointRepository) getDB(ctx context.Context) *gorm.DB {
+	tx := gormextensions.GetTxFromContextIfExists(ctx)
+	if tx != nil {
+		return tx
+	}
+
+	return p.db.WithContext(ctx)
+}
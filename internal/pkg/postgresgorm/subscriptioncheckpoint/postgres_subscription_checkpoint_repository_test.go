@@ -0,0 +1,125 @@
+//go:build unit
+// +build unit
+
+package subscriptioncheckpoint
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config/environment"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/external/fxlog"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/zap"
+	gormPostgres "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"gorm.io/gorm"
+)
+
+type SubscriptionCheckpointRepositoryTestSuite struct {
+	suite.Suite
+	db         *gorm.DB
+	repository contracts.TransactionalSubscriptionCheckpointRepository
+	app        *fxtest.App
+	dbFilePath string
+}
+
+func TestPostgresSubscriptionCheckpointRepository(t *testing.T) {
+	suite.Run(t, new(SubscriptionCheckpointRepositoryTestSuite))
+}
+
+func (s *SubscriptionCheckpointRepositoryTestSuite) Test_Load_Should_Return_Zero_For_New_Subscription() {
+	position, err := s.repository.Load("new-subscription", context.Background())
+	s.Require().NoError(err)
+
+	s.Assert().Equal(uint64(0), position)
+}
+
+func (s *SubscriptionCheckpointRepositoryTestSuite) Test_Store_Should_Upsert_Checkpoint() {
+	ctx := context.Background()
+
+	err := s.repository.Store("orders", 10, ctx)
+	s.Require().NoError(err)
+
+	err = s.repository.Store("orders", 20, ctx)
+	s.Require().NoError(err)
+
+	position, err := s.repository.Load("orders", ctx)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(uint64(20), position)
+}
+
+func (s *SubscriptionCheckpointRepositoryTestSuite) Test_ExecuteInTransaction_Should_Rollback_Checkpoint_With_Projection() {
+	ctx := context.Background()
+
+	err := s.repository.Store("orders", 10, ctx)
+	s.Require().NoError(err)
+
+	err = s.repository.ExecuteInTransaction(ctx, func(ctx context.Context) error {
+		s.Require().NotNil(gormextensions.GetTxFromContextIfExists(ctx))
+
+		if err := s.repository.Store("orders", 20, ctx); err != nil {
+			return err
+		}
+
+		return errors.New("projection failed")
+	})
+	s.Require().Error(err)
+
+	position, err := s.repository.Load("orders", ctx)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(uint64(10), position)
+}
+
+func (s *SubscriptionCheckpointRepositoryTestSuite) SetupTest() {
+	var gormDB *gorm.DB
+	var gormOptions *gormPostgres.GormOptions
+	var repository contracts.TransactionalSubscriptionCheckpointRepository
+
+	app := fxtest.New(
+		s.T(),
+		config.ModuleFunc(environment.Test),
+		zap.Module,
+		fxlog.FxLogger,
+		gormPostgres.Module,
+		fx.Decorate(
+			func(cfg *gormPostgres.GormOptions) (*gormPostgres.GormOptions, error) {
+				// using sql-lite with a database file
+				cfg.UseSQLLite = true
+
+				return cfg, nil
+			},
+		),
+		// the module creates the checkpoints table
+		Module,
+		fx.Populate(&gormDB),
+		fx.Populate(&gormOptions),
+		fx.Populate(&repository),
+	).RequireStart()
+
+	s.app = app
+	s.db = gormDB
+	s.dbFilePath = gormOptions.Dns()
+	s.repository = repository
+}
+
+func (s *SubscriptionCheckpointRepositoryTestSuite) TearDownTest() {
+	sqldb, _ := s.db.DB()
+	err := sqldb.Close()
+	s.Require().NoError(err)
+
+	// removing sql-lite file
+	err = os.Remove(s.dbFilePath)
+	s.Require().NoError(err)
+
+	s.app.RequireStop()
+}
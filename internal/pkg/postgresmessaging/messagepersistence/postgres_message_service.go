@@ -2,48 +2,483 @@ package messagepersistence
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"time"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/gormunitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresmessaging/config"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 
+	"emperror.dev/errors"
+	"github.com/samber/lo"
 	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postgresMessagePersistenceService struct {
 	messagingDBContext *PostgresMessagePersistenceDBContext
 	messageSerializer  serializer.MessageSerializer
+	bus                bus.Bus
+	options            *config.PostgresMessagingOptions
 	logger             logger.Logger
 }
 
+func NewPostgresMessageService(
+	postgresMessagePersistenceDBContext *PostgresMessagePersistenceDBContext,
+	messageSerializer serializer.MessageSerializer,
+	bus bus.Bus,
+	options *config.PostgresMessagingOptions,
+	l logger.Logger,
+) persistmessage.MessagePersistenceService {
+	return &postgresMessagePersistenceService{
+		messagingDBContext: postgresMessagePersistenceDBContext,
+		messageSerializer:  messageSerializer,
+		bus:                bus,
+		options:            options,
+		logger:             l,
+	}
+}
+
+// Process publishes a stored outbox message or dispatches a stored internal command by its id, the message is claimed
+// like the messages of `ProcessAll`, so it is not processed concurrently with a dispatcher
 func (m *postgresMessagePersistenceService) Process(messageID string, ctx context.Context) error {
-	// TODO implement me
-	panic("implement me")
+	id, err := uuid.FromString(messageID)
+	if err != nil {
+		return customErrors.NewBadRequestErrorWrap(err, fmt.Sprintf("invalid message id `%s`", messageID))
+	}
+
+	storeMessage, err := m.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if storeMessage.MessageStatus != persistmessage.Stored {
+		return nil
+	}
+
+	if !isDispatchable(storeMessage.DeliveryType) {
+		return errors.Errorf(
+			"message with id `%s` and delivery type `%v` can't be processed by the message dispatcher",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+		)
+	}
+
+	// a scheduled message is processed before its time, but not while it is claimed by a dispatcher
+	storeMessages, err := m.claim(ctx, 1, func(db *gorm.DB) *gorm.DB {
+		return db.
+			Where("id = ?", id).
+			Where("COALESCE(claim_token, '') = '' OR next_retry_at IS NULL OR next_retry_at <= ?", time.Now())
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(storeMessages) == 0 {
+		m.logger.Infof("message with id: %v is claimed by another dispatcher or is not due yet", id)
+
+		return nil
+	}
+
+	return m.processStoreMessage(ctx, storeMessages[0])
 }
 
+// ProcessAll claims the pending outbox messages and internal commands in batches, publishes the outbox messages on the
+// bus and sends the internal commands to their handlers. the batches are claimed in short transactions and dispatched
+// outside of them, so a slow broker doesn't hold the row locks, and multiple dispatcher instances can run concurrently
+// without processing a message twice.
 func (m *postgresMessagePersistenceService) ProcessAll(ctx context.Context) error {
-	// TODO implement me
-	panic("implement me")
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		storeMessages, err := m.claim(ctx, m.options.BatchSize, func(db *gorm.DB) *gorm.DB {
+			return db.
+				Where("delivery_type IN ?", dispatchableDeliveryTypes).
+				Where("next_retry_at IS NULL OR next_retry_at <= ?", time.Now())
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, storeMessage := range storeMessages {
+			if err := m.processStoreMessage(ctx, storeMessage); err != nil {
+				return err
+			}
+		}
+
+		if len(storeMessages) < m.options.BatchSize {
+			return nil
+		}
+	}
+}
+
+// claim locks the pending messages of the scope with `FOR UPDATE SKIP LOCKED`, postpones their next processing by the
+// lock timeout and stamps them with a new claim token, the other dispatchers skip the claimed messages until the lock
+// timeout, and the messages of a crashed dispatcher are claimed again after it
+func (m *postgresMessagePersistenceService) claim(
+	ctx context.Context,
+	limit int,
+	scope func(db *gorm.DB) *gorm.DB,
+) ([]*persistmessage.StoreMessage, error) {
+	var storeMessages []*persistmessage.StoreMessage
+
+	err := m.messagingDBContext.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// https://gorm.io/docs/advanced_query.html#Locking
+		result := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Scopes(scope).
+			Where("message_status = ?", persistmessage.Stored).
+			Where("retry_count < ?", m.options.MaxRetryCount).
+			Order("created_at").
+			Limit(limit).
+			Find(&storeMessages)
+		if result.Error != nil {
+			return errors.WrapIf(result.Error, "error in claiming stored messages")
+		}
+
+		if len(storeMessages) == 0 {
+			return nil
+		}
+
+		ids := lo.Map(storeMessages, func(sm *persistmessage.StoreMessage, _ int) uuid.UUID { return sm.ID })
+		leaseExpiresAt := now.Add(m.options.LockTimeout)
+		claimToken := uuid.NewV4().String()
+
+		result = tx.Model(&persistmessage.StoreMessage{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{"next_retry_at": leaseExpiresAt, "claim_token": claimToken})
+		if result.Error != nil {
+			return errors.WrapIf(result.Error, "error in claiming stored messages")
+		}
+
+		for _, storeMessage := range storeMessages {
+			storeMessage.NextRetryAt = &leaseExpiresAt
+			storeMessage.ClaimToken = claimToken
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return storeMessages, nil
+}
+
+// processStoreMessage dispatches the claimed message and persists the result, a dispatch failure doesn't return an error
+// and the message will be retried with exponential backoff until the max retry count, then it is dead-lettered
+func (m *postgresMessagePersistenceService) processStoreMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	var dispatchErr error
+
+	switch storeMessage.DeliveryType {
+	case persistmessage.Outbox:
+		dispatchErr = m.publishClaimedMessage(ctx, storeMessage)
+	case persistmessage.Internal:
+		dispatchErr = m.processInternalCommand(ctx, storeMessage)
+		if dispatchErr == nil {
+			return nil
+		}
+	default:
+		return errors.Errorf(
+			"message with id `%s` and delivery type `%v` can't be processed by the message dispatcher",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+		)
+	}
+
+	claimToken := storeMessage.ClaimToken
+
+	switch {
+	case dispatchErr == nil:
+		storeMessage.MarkAsProcessed(time.Now())
+	case storeMessage.RetryCount+1 >= m.options.MaxRetryCount:
+		storeMessage.IncreaseRetry()
+		storeMessage.MarkAsDeadLettered()
+
+		m.logger.Errorf(
+			"message with id: %v and delivery type: %v is dead-lettered after %d retries, err: %v",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+			storeMessage.RetryCount,
+			dispatchErr,
+		)
+	default:
+		storeMessage.ScheduleRetry(time.Now().Add(m.options.RetryDelay(storeMessage.RetryCount + 1)))
+
+		m.logger.Errorf(
+			"error in processing message with id: %v, delivery type: %v, retry count: %d, err: %v",
+			storeMessage.ID,
+			storeMessage.DeliveryType,
+			storeMessage.RetryCount,
+			dispatchErr,
+		)
+	}
+
+	return m.saveClaimed(ctx, storeMessage, claimToken)
+}
+
+// publishClaimedMessage publishes the outbox message before its claim expires, after the lease the message can be claimed
+// by another dispatcher, so a publish which is slower than the lease is canceled and retried
+func (m *postgresMessagePersistenceService) publishClaimedMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	if storeMessage.NextRetryAt == nil {
+		return m.publishStoreMessage(ctx, storeMessage)
+	}
+
+	leaseCtx, cancel := context.WithDeadline(ctx, *storeMessage.NextRetryAt)
+	defer cancel()
+
+	return m.publishStoreMessage(leaseCtx, storeMessage)
+}
+
+// saveClaimed saves the result of the processing while the message still has the claim token of the dispatcher, when
+// the lease expired and another dispatcher claimed the message, the result of the new claim wins
+func (m *postgresMessagePersistenceService) saveClaimed(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+	claimToken string,
+) error {
+	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
+
+	result := dbContext.DB().
+		Model(&persistmessage.StoreMessage{}).
+		Where("id = ? AND claim_token = ?", storeMessage.ID, claimToken).
+		Updates(map[string]interface{}{
+			"message_status": storeMessage.MessageStatus,
+			"retry_count":    storeMessage.RetryCount,
+			"next_retry_at":  storeMessage.NextRetryAt,
+			"processed_at":   storeMessage.ProcessedAt,
+			"claim_token":    storeMessage.ClaimToken,
+		})
+	if result.Error != nil {
+		return customErrors.NewInternalServerErrorWrap(
+			result.Error,
+			"error in updating the storeMessage",
+		)
+	}
+
+	if result.RowsAffected == 0 {
+		m.logger.Warnf(
+			"claim of message with id: %v is expired and the message is claimed by another dispatcher",
+			storeMessage.ID,
+		)
+	}
+
+	return nil
+}
+
+// save updates all fields of the message, also zero values like the nil `next_retry_at`
+func (m *postgresMessagePersistenceService) save(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
+
+	result := dbContext.DB().Save(storeMessage)
+	if result.Error != nil {
+		return customErrors.NewInternalServerErrorWrap(
+			result.Error,
+			"error in updating the storeMessage",
+		)
+	}
+
+	return nil
+}
+
+func (m *postgresMessagePersistenceService) publishStoreMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	messageEnvelope, err := m.messageSerializer.DeserializeEnvelop(
+		[]byte(storeMessage.Data),
+		storeMessage.DataType,
+		m.messageSerializer.ContentType(),
+	)
+	if err != nil {
+		return err
+	}
+
+	meta := metadata.MapToMetadata(messageEnvelope.Headers)
+	topicName := meta.GetString(persistmessage.TopicOrExchangeNameHeader)
+	delete(meta, persistmessage.TopicOrExchangeNameHeader)
+
+	if topicName != "" {
+		return m.bus.PublishMessageWithTopicName(ctx, messageEnvelope.Message, meta, topicName)
+	}
+
+	return m.bus.PublishMessage(ctx, messageEnvelope.Message, meta)
+}
+
+// processInternalCommand sends the internal command and marks it as processed in one transaction, the command handler
+// joins the transaction with `WithTxIfExists`, so its writes are committed or rolled back together with the processed
+// state and a failed commit doesn't leave an executed but still stored command
+func (m *postgresMessagePersistenceService) processInternalCommand(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	unitOfWork := gormunitofwork.NewGormUnitOfWork(m.messagingDBContext.DB(), m.logger)
+
+	return unitOfWork.Do(ctx, func(ctx context.Context) error {
+		tx, err := gormextensions.GetTxFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		// the claim of the message may be expired and claimed by another dispatcher, so we lock the row and check its
+		// state and claim token again in the transaction
+		var current *persistmessage.StoreMessage
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, "id = ?", storeMessage.ID)
+		if result.Error != nil {
+			return errors.WrapIff(result.Error, "error in locking the internal command with id `%s`", storeMessage.ID)
+		}
+
+		if current.MessageStatus != persistmessage.Stored || current.ClaimToken != storeMessage.ClaimToken {
+			m.logger.Infof(
+				"internal command with id: %v is already processed or claimed by another dispatcher",
+				storeMessage.ID,
+			)
+			*storeMessage = *current
+
+			return nil
+		}
+
+		if err := m.sendInternalCommand(ctx, storeMessage); err != nil {
+			return err
+		}
+
+		processed := *storeMessage
+		processed.MarkAsProcessed(time.Now())
+
+		if err := m.save(ctx, &processed); err != nil {
+			return err
+		}
+
+		*storeMessage = processed
+
+		return nil
+	})
+}
+
+var dispatchableDeliveryTypes = []persistmessage.MessageDeliveryType{persistmessage.Outbox, persistmessage.Internal}
+
+func isDispatchable(deliveryType persistmessage.MessageDeliveryType) bool {
+	return lo.Contains(dispatchableDeliveryTypes, deliveryType)
+}
+
+func (m *postgresMessagePersistenceService) sendInternalCommand(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	internalCommand, err := cqrs.NewInternalCommandInstance(storeMessage.DataType)
+	if err != nil {
+		return err
+	}
+
+	err = m.messageSerializer.Serializer().Unmarshal([]byte(storeMessage.Data), internalCommand)
+	if err != nil {
+		return errors.WrapIff(err, "error in deserializing internal command `%s`", storeMessage.DataType)
+	}
+
+	return cqrs.SendInternalCommand(ctx, internalCommand)
 }
 
 func (m *postgresMessagePersistenceService) AddPublishMessage(
 	messageEnvelope types.MessageEnvelope,
 	ctx context.Context,
 ) error {
-	// TODO implement me
-	panic("implement me")
+	return m.AddMessageCore(ctx, messageEnvelope, persistmessage.Outbox)
 }
 
 func (m *postgresMessagePersistenceService) AddReceivedMessage(
 	messageEnvelope types.MessageEnvelope,
 	ctx context.Context,
 ) error {
-	// TODO implement me
-	panic("implement me")
+	return m.AddMessageCore(ctx, messageEnvelope, persistmessage.Inbox)
+}
+
+func (m *postgresMessagePersistenceService) AddInternalMessage(
+	internalCommand cqrs.InternalCommand,
+	ctx context.Context,
+) error {
+	storeMessage, err := m.newInternalStoreMessage(internalCommand)
+	if err != nil {
+		return err
+	}
+
+	return m.addInternalStoreMessage(ctx, storeMessage)
+}
+
+func (m *postgresMessagePersistenceService) ScheduleInternalMessage(
+	internalCommand cqrs.InternalCommand,
+	processAt time.Time,
+	ctx context.Context,
+) error {
+	storeMessage, err := m.newInternalStoreMessage(internalCommand)
+	if err != nil {
+		return err
+	}
+
+	storeMessage.ScheduleAt(processAt)
+
+	return m.addInternalStoreMessage(ctx, storeMessage)
+}
+
+func (m *postgresMessagePersistenceService) newInternalStoreMessage(
+	internalCommand cqrs.InternalCommand,
+) (*persistmessage.StoreMessage, error) {
+	if internalCommand == nil {
+		return nil, errors.New("internalCommand is nil")
+	}
+
+	data, err := m.messageSerializer.Serializer().Marshal(internalCommand)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in serializing internal command `%T`", internalCommand)
+	}
+
+	// the dispatcher creates the command by its full type name from the internal commands registry
+	return persistmessage.NewStoreMessage(
+		uuid.NewV4(),
+		typeMapper.GetFullTypeName(internalCommand),
+		string(data),
+		persistmessage.Internal,
+	), nil
+}
+
+func (m *postgresMessagePersistenceService) addInternalStoreMessage(
+	ctx context.Context,
+	storeMessage *persistmessage.StoreMessage,
+) error {
+	err := m.Add(ctx, storeMessage)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Infof(
+		"Internal command %s with id: %v saved in persistence message store",
+		storeMessage.DataType,
+		storeMessage.ID,
+	)
+
+	return nil
 }
 
 func (m *postgresMessagePersistenceService) AddMessageCore(
@@ -55,29 +490,20 @@ func (m *postgresMessagePersistenceService) AddMessageCore(
 		return errors.New("messageEnvelope.Message is nil")
 	}
 
-	var id string
-	switch message := messageEnvelope.Message.(type) {
-	case types.IMessage:
-		id = message.GeMessageId()
-	// case IInternalCommand:
-	//	id = message.InternalCommandId
-	default:
-		id = uuid.NewV4().String()
-	}
-
-	data, err := m.messageSerializer.SerializeEnvelop(messageEnvelope)
+	id, err := uuid.FromString(messageEnvelope.Message.GeMessageId())
 	if err != nil {
-		return err
+		id = uuid.NewV4()
 	}
 
-	uuidId, err := uuid.FromString(id)
+	data, err := m.messageSerializer.SerializeEnvelop(messageEnvelope)
 	if err != nil {
 		return err
 	}
 
+	// we use the full type name of the actual message, because typemapper resolves the message by this name for deserializing in the dispatcher
 	storeMessage := persistmessage.NewStoreMessage(
-		uuidId,
-		messageEnvelope.Message.GetMessageFullTypeName(),
+		id,
+		typeMapper.GetFullTypeName(messageEnvelope.Message),
 		string(data.Data),
 		deliveryType,
 	)
@@ -96,28 +522,25 @@ func (m *postgresMessagePersistenceService) AddMessageCore(
 	return nil
 }
 
-func NewPostgresMessageService(
-	postgresMessagePersistenceDBContext *PostgresMessagePersistenceDBContext,
-	l logger.Logger,
-) persistmessage.MessagePersistenceService {
-	return &postgresMessagePersistenceService{
-		messagingDBContext: postgresMessagePersistenceDBContext,
-		logger:             l,
-	}
-}
-
 func (m *postgresMessagePersistenceService) Add(
 	ctx context.Context,
 	storeMessage *persistmessage.StoreMessage,
 ) error {
 	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
 
-	// https://gorm.io/docs/create.html
-	result := dbContext.DB().Create(storeMessage)
+	// https://gorm.io/docs/create.html#Upsert-x2F-On-Conflict
+	// `ON CONFLICT DO NOTHING` doesn't abort the current transaction for a duplicate message, so the caller can still load the existing message in the transaction
+	result := dbContext.DB().Clauses(clause.OnConflict{DoNothing: true}).Create(storeMessage)
 	if result.Error != nil {
-		return customErrors.NewConflictErrorWrap(
+		return customErrors.NewInternalServerErrorWrap(
 			result.Error,
-			"storeMessage already exists",
+			"error in inserting the storeMessage",
+		)
+	}
+
+	if result.RowsAffected == 0 {
+		return customErrors.NewConflictError(
+			fmt.Sprintf("storeMessage with id `%s` already exists", storeMessage.ID.String()),
 		)
 	}
 
@@ -153,16 +576,15 @@ func (m *postgresMessagePersistenceService) ChangeState(
 ) error {
 	storeMessage, err := m.GetById(ctx, messageID)
 	if err != nil {
-		return customErrors.NewNotFoundErrorWrap(
-			err,
-			fmt.Sprintf(
-				"storeMessage with id `%s` not found in the database",
-				messageID.String(),
-			),
-		)
+		return err
+	}
+
+	if status == persistmessage.Processed {
+		storeMessage.MarkAsProcessed(time.Now())
+	} else {
+		storeMessage.ChangeState(status)
 	}
 
-	storeMessage.MessageStatus = status
 	err = m.Update(ctx, storeMessage)
 
 	return err
@@ -173,28 +595,11 @@ func (m *postgresMessagePersistenceService) GetAllActive(
 ) ([]*persistmessage.StoreMessage, error) {
 	var storeMessages []*persistmessage.StoreMessage
 
-	predicate := func(sm *persistmessage.StoreMessage) bool {
-		return sm.MessageStatus == persistmessage.Stored
-	}
-
-	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
-	result := dbContext.DB().Where(predicate).Find(&storeMessages)
-	if result.Error != nil {
-		return nil, result.Error
-	}
-
-	return storeMessages, nil
-}
-
-func (m *postgresMessagePersistenceService) GetByFilter(
-	ctx context.Context,
-	predicate func(*persistmessage.StoreMessage) bool,
-) ([]*persistmessage.StoreMessage, error) {
-	var storeMessages []*persistmessage.StoreMessage
-
 	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
-	result := dbContext.DB().Where(predicate).Find(&storeMessages)
-
+	result := dbContext.DB().
+		Where("message_status = ?", persistmessage.Stored).
+		Order("created_at").
+		Find(&storeMessages)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -208,12 +613,14 @@ func (m *postgresMessagePersistenceService) GetById(
 ) (*persistmessage.StoreMessage, error) {
 	var storeMessage *persistmessage.StoreMessage
 
+	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
+
 	// https://gorm.io/docs/query.html#Retrieving-objects-with-primary-key
 	// https://gorm.io/docs/query.html#Struct-amp-Map-Conditions
 	// https://gorm.io/docs/query.html#Inline-Condition
 	// https://gorm.io/docs/advanced_query.html
-	result := m.messagingDBContext.DB().Find(&storeMessage, id)
-	if result.Error != nil {
+	result := dbContext.DB().First(&storeMessage, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil, customErrors.NewNotFoundErrorWrap(
 			result.Error,
 			fmt.Sprintf(
@@ -222,6 +629,12 @@ func (m *postgresMessagePersistenceService) GetById(
 			),
 		)
 	}
+	if result.Error != nil {
+		return nil, customErrors.NewInternalServerErrorWrap(
+			result.Error,
+			fmt.Sprintf("error in loading storeMessage with id `%s`", id.String()),
+		)
+	}
 
 	m.logger.Infof("Number of affected rows are: %d", result.RowsAffected)
 
@@ -236,13 +649,7 @@ func (m *postgresMessagePersistenceService) Remove(
 
 	storeMessage, err := m.GetById(ctx, id)
 	if err != nil {
-		return false, customErrors.NewNotFoundErrorWrap(
-			err,
-			fmt.Sprintf(
-				"storeMessage with id `%s` not found in the database",
-				id.String(),
-			),
-		)
+		return false, err
 	}
 
 	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
@@ -266,14 +673,14 @@ func (m *postgresMessagePersistenceService) Remove(
 func (m *postgresMessagePersistenceService) CleanupMessages(
 	ctx context.Context,
 ) error {
-	predicate := func(sm *persistmessage.StoreMessage) bool {
-		return sm.MessageStatus == persistmessage.Processed
-	}
-
 	dbContext := m.messagingDBContext.WithTxIfExists(ctx)
 
+	// processed inbox messages should be kept for the retention period for detecting duplicate messages
+	retentionTime := time.Now().Add(-m.options.RetentionPeriod)
+
 	result := dbContext.DB().
-		Where(predicate).
+		Where("message_status = ?", persistmessage.Processed).
+		Where("processed_at < ? OR (processed_at IS NULL AND created_at < ?)", retentionTime, retentionTime).
 		Delete(&persistmessage.StoreMessage{})
 
 	if result.Error != nil {
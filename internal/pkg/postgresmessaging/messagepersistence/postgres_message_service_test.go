@@ -12,7 +12,14 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config/environment"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/mocks"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/pipeline"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	defaultLogger "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/defaultlogger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/external/fxlog"
@@ -20,9 +27,14 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/pipelines"
+	messagingConfig "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresmessaging/config"
 
 	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/samber/lo"
 	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxtest"
@@ -35,6 +47,7 @@ type postgresMessageServiceTest struct {
 	logger              logger.Logger
 	messagingRepository persistmessage.MessagePersistenceService
 	dbContext           *PostgresMessagePersistenceDBContext
+	bus                 *mocks.Bus
 	storeMessages       []*persistmessage.StoreMessage
 	ctx                 context.Context
 	dbFilePath          string
@@ -70,6 +83,7 @@ func TestPostgresMessageService(t *testing.T) {
 func (c *postgresMessageServiceTest) SetupTest() {
 	var gormDBContext *PostgresMessagePersistenceDBContext
 	var gormOptions *postgresgorm.GormOptions
+	var messageSerializer serializer.MessageSerializer
 
 	app := fxtest.New(
 		c.T(),
@@ -89,11 +103,28 @@ func (c *postgresMessageServiceTest) SetupTest() {
 		fx.Provide(NewPostgresMessagePersistenceDBContext),
 		fx.Populate(&gormDBContext),
 		fx.Populate(&gormOptions),
+		fx.Populate(&messageSerializer),
 	).RequireStart()
 
 	c.dbContext = gormDBContext
 	c.dbFilePath = gormOptions.Dns()
 	c.app = app
+	c.ctx = context.Background()
+	c.bus = mocks.NewBus(c.T())
+	c.messagingRepository = NewPostgresMessageService(
+		gormDBContext,
+		messageSerializer,
+		c.bus,
+		&messagingConfig.PostgresMessagingOptions{
+			BatchSize:       10,
+			MaxRetryCount:   3,
+			RetryBaseDelay:  time.Minute,
+			RetryMaxDelay:   time.Hour,
+			LockTimeout:     time.Minute,
+			RetentionPeriod: time.Hour,
+		},
+		c.logger,
+	)
 
 	c.initDB()
 }
@@ -160,6 +191,534 @@ func (c *postgresMessageServiceTest) Test_Add() {
 	c.Assert().Equal(message.ID, m.ID)
 }
 
+func (c *postgresMessageServiceTest) Test_Add_Should_Return_Conflict_Error_For_Duplicate_Message() {
+	c.BeginTx()
+	defer c.CommitTx()
+
+	err := c.messagingRepository.Add(c.ctx, c.storeMessages[0])
+	c.Assert().True(customErrors.IsConflictError(err))
+
+	// the duplicate doesn't abort the transaction
+	m, err := c.messagingRepository.GetById(c.ctx, c.storeMessages[0].ID)
+	c.Require().NoError(err)
+	c.Assert().Equal(c.storeMessages[0].ID, m.ID)
+}
+
+func (c *postgresMessageServiceTest) Test_GetById_Should_Not_Return_Not_Found_Error_For_Database_Error() {
+	err := c.dbContext.DB().Migrator().DropTable(&persistmessage.StoreMessage{})
+	c.Require().NoError(err)
+
+	_, err = c.messagingRepository.GetById(c.ctx, c.storeMessages[0].ID)
+	c.Require().Error(err)
+	c.Assert().False(customErrors.IsNotFoundError(err))
+}
+
+func (c *postgresMessageServiceTest) Test_AddPublishMessage() {
+	message := newTestMessage()
+
+	err := c.messagingRepository.AddPublishMessage(
+		*types.NewMessageEnvelope(message, map[string]interface{}{"key": "value"}),
+		c.ctx,
+	)
+	c.Require().NoError(err)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+
+	c.Assert().Equal(persistmessage.Outbox, m.DeliveryType)
+	c.Assert().Equal(persistmessage.Stored, m.MessageStatus)
+	c.Assert().Equal("*messagepersistence.testMessage", m.DataType)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Publish_Stored_Outbox_Messages() {
+	message := newTestMessage()
+
+	err := c.messagingRepository.AddPublishMessage(
+		*types.NewMessageEnvelope(message, map[string]interface{}{"key": "value"}),
+		c.ctx,
+	)
+	c.Require().NoError(err)
+
+	c.bus.EXPECT().
+		PublishMessage(mock.Anything, mock.MatchedBy(func(m types.IMessage) bool {
+			return m.GeMessageId() == message.MessageId && m.(*testMessage).Data == message.Data
+		}), mock.MatchedBy(func(meta map[string]interface{}) bool {
+			return meta["key"] == "value"
+		})).
+		Return(nil).
+		Once()
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+
+	c.Assert().Equal(persistmessage.Processed, m.MessageStatus)
+	c.Assert().NotNil(m.ProcessedAt)
+
+	// processed messages should not publish again
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Schedule_Retry_When_Publish_Failed() {
+	message := newTestMessage()
+
+	err := c.messagingRepository.AddPublishMessage(
+		*types.NewMessageEnvelope(message, nil),
+		c.ctx,
+	)
+	c.Require().NoError(err)
+
+	c.bus.EXPECT().
+		PublishMessage(mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("broker is not available")).
+		Once()
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+
+	c.Assert().Equal(persistmessage.Stored, m.MessageStatus)
+	c.Assert().Equal(1, m.RetryCount)
+	c.Assert().NotNil(m.NextRetryAt)
+	c.Assert().True(m.NextRetryAt.After(time.Now()))
+
+	// message is not due yet, so it should not publish again
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Publish_Outside_Of_The_Claim_Transaction() {
+	message := newTestMessage()
+
+	err := c.messagingRepository.AddPublishMessage(*types.NewMessageEnvelope(message, nil), c.ctx)
+	c.Require().NoError(err)
+
+	c.bus.EXPECT().
+		PublishMessage(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ types.IMessage, _ metadata.Metadata) error {
+			// the claimed row is not locked while publishing, and the claim hides it from the other dispatchers
+			var storeMessage *persistmessage.StoreMessage
+			err := c.dbContext.DB().First(&storeMessage, "id = ?", message.MessageId).Error
+			c.Require().NoError(err)
+			c.Require().NotNil(storeMessage.NextRetryAt)
+			c.Assert().True(storeMessage.NextRetryAt.After(time.Now()))
+
+			return c.dbContext.DB().
+				Model(&persistmessage.StoreMessage{}).
+				Where("id = ?", message.MessageId).
+				Update("data", storeMessage.Data).
+				Error
+		}).
+		Once()
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+	c.Assert().Equal(persistmessage.Processed, m.MessageStatus)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Dead_Letter_Message_After_Max_Retry_Count() {
+	message := newTestMessage()
+
+	err := c.messagingRepository.AddPublishMessage(*types.NewMessageEnvelope(message, nil), c.ctx)
+	c.Require().NoError(err)
+
+	err = c.dbContext.DB().
+		Model(&persistmessage.StoreMessage{}).
+		Where("id = ?", message.MessageId).
+		Update("retry_count", 2).
+		Error
+	c.Require().NoError(err)
+
+	c.bus.EXPECT().
+		PublishMessage(mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("broker is not available")).
+		Once()
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+
+	c.Assert().Equal(persistmessage.DeadLettered, m.MessageStatus)
+	c.Assert().Equal(3, m.RetryCount)
+	c.Assert().Nil(m.NextRetryAt)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Not_Save_Result_When_Claim_Is_Lost() {
+	message := newTestMessage()
+
+	err := c.messagingRepository.AddPublishMessage(*types.NewMessageEnvelope(message, nil), c.ctx)
+	c.Require().NoError(err)
+
+	c.bus.EXPECT().
+		PublishMessage(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ types.IMessage, _ metadata.Metadata) error {
+			// the publish is bounded by the lease of the claim
+			deadline, ok := ctx.Deadline()
+			c.Require().True(ok)
+			c.Assert().True(deadline.After(time.Now()))
+
+			// the lease is expired and another dispatcher claimed the message
+			return c.dbContext.DB().
+				Model(&persistmessage.StoreMessage{}).
+				Where("id = ?", message.MessageId).
+				Update("claim_token", uuid.NewV4().String()).
+				Error
+		}).
+		Once()
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+
+	c.Assert().Equal(persistmessage.Stored, m.MessageStatus)
+	c.Assert().Nil(m.ProcessedAt)
+}
+
+func (c *postgresMessageServiceTest) Test_Process_Should_Skip_Message_Claimed_By_Another_Dispatcher() {
+	message := newTestMessage()
+
+	err := c.messagingRepository.AddPublishMessage(*types.NewMessageEnvelope(message, nil), c.ctx)
+	c.Require().NoError(err)
+
+	err = c.dbContext.DB().
+		Model(&persistmessage.StoreMessage{}).
+		Where("id = ?", message.MessageId).
+		Updates(map[string]interface{}{
+			"claim_token":   uuid.NewV4().String(),
+			"next_retry_at": time.Now().Add(time.Minute),
+		}).
+		Error
+	c.Require().NoError(err)
+
+	// the bus mock fails the test for an unexpected publish
+	err = c.messagingRepository.Process(message.MessageId, c.ctx)
+	c.Require().NoError(err)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+	c.Assert().Equal(persistmessage.Stored, m.MessageStatus)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Send_Stored_Internal_Commands() {
+	handler := &testInternalCommandHandler{}
+	c.registerInternalCommand(handler)
+
+	command := newTestInternalCommand()
+
+	// internal commands are stored in the transaction of the current request
+	c.BeginTx()
+	err := c.messagingRepository.AddInternalMessage(command, c.ctx)
+	c.CommitTx()
+	c.Require().NoError(err)
+
+	c.ctx = context.Background()
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	c.Require().Len(handler.commands, 1)
+	c.Assert().Equal(command.ProductId, handler.commands[0].ProductId)
+
+	messages := c.internalMessages()
+	c.Require().Len(messages, 1)
+	c.Assert().Equal("*messagepersistence.testInternalCommand", messages[0].DataType)
+	c.Assert().Equal(persistmessage.Processed, messages[0].MessageStatus)
+
+	// processed internal commands should not send again
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+	c.Assert().Len(handler.commands, 1)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Schedule_Retry_When_Internal_Command_Failed() {
+	handler := &testInternalCommandHandler{err: errors.New("handler failed")}
+	c.registerInternalCommand(handler)
+
+	err := c.messagingRepository.AddInternalMessage(newTestInternalCommand(), c.ctx)
+	c.Require().NoError(err)
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	messages := c.internalMessages()
+	c.Require().Len(messages, 1)
+	c.Assert().Equal(persistmessage.Stored, messages[0].MessageStatus)
+	c.Assert().Equal(1, messages[0].RetryCount)
+	c.Assert().True(messages[0].NextRetryAt.After(time.Now()))
+
+	// the command is not due yet, so it should not send again
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+	c.Assert().Len(handler.commands, 1)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Send_Internal_Command_In_The_Transaction_Of_Its_Processed_State() {
+	var handlerTx *gorm.DB
+	handler := &testInternalCommandHandler{
+		onHandle: func(ctx context.Context) {
+			handlerTx = gormextensions.GetTxFromContextIfExists(ctx)
+
+			// the handler writes with the transaction of the dispatcher
+			err := handlerTx.Create(&persistmessage.StoreMessage{
+				ID:            uuid.NewV4(),
+				DataType:      "handler-write",
+				Data:          "{}",
+				MessageStatus: persistmessage.Processed,
+				DeliveryType:  persistmessage.Outbox,
+			}).Error
+			c.Require().NoError(err)
+		},
+	}
+	c.registerInternalCommand(handler)
+
+	err := c.messagingRepository.AddInternalMessage(newTestInternalCommand(), c.ctx)
+	c.Require().NoError(err)
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	c.Require().NotNil(handlerTx)
+	messages := c.internalMessages()
+	c.Require().Len(messages, 1)
+	c.Assert().Equal(persistmessage.Processed, messages[0].MessageStatus)
+	c.Assert().Equal(int64(1), c.countMessagesByDataType("handler-write"))
+
+	// a failed handler rolls back its writes, and the command stays stored for the retry
+	handler.err = errors.New("handler failed")
+
+	err = c.messagingRepository.AddInternalMessage(newTestInternalCommand(), c.ctx)
+	c.Require().NoError(err)
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(int64(1), c.countMessagesByDataType("handler-write"))
+	storedMessages := lo.Filter(c.internalMessages(), func(sm *persistmessage.StoreMessage, _ int) bool {
+		return sm.MessageStatus == persistmessage.Stored
+	})
+	c.Require().Len(storedMessages, 1)
+	c.Assert().Equal(1, storedMessages[0].RetryCount)
+}
+
+func (c *postgresMessageServiceTest) Test_ProcessAll_Should_Send_Scheduled_Internal_Command_When_It_Is_Due() {
+	handler := &testInternalCommandHandler{}
+	c.registerInternalCommand(handler)
+
+	err := c.messagingRepository.ScheduleInternalMessage(newTestInternalCommand(), time.Now().Add(time.Hour), c.ctx)
+	c.Require().NoError(err)
+
+	err = c.messagingRepository.ProcessAll(c.ctx)
+	c.Require().NoError(err)
+	c.Assert().Empty(handler.commands)
+
+	messages := c.internalMessages()
+	c.Require().Len(messages, 1)
+	c.Assert().Equal(persistmessage.Stored, messages[0].MessageStatus)
+	c.Assert().Equal(0, messages[0].RetryCount)
+
+	err = c.messagingRepository.Process(messages[0].ID.String(), c.ctx)
+	c.Require().NoError(err)
+	c.Assert().Len(handler.commands, 1)
+}
+
+func (c *postgresMessageServiceTest) Test_CleanupMessages() {
+	processedAt := time.Now().Add(-2 * time.Hour)
+	oldMessage := &persistmessage.StoreMessage{
+		ID:            uuid.NewV4(),
+		MessageStatus: persistmessage.Processed,
+		Data:          "old test data",
+		DataType:      "string",
+		CreatedAt:     processedAt,
+		ProcessedAt:   &processedAt,
+		DeliveryType:  persistmessage.Inbox,
+	}
+	err := c.messagingRepository.Add(c.ctx, oldMessage)
+	c.Require().NoError(err)
+
+	err = c.messagingRepository.CleanupMessages(c.ctx)
+	c.Require().NoError(err)
+
+	var messages []*persistmessage.StoreMessage
+	err = c.dbContext.DB().Find(&messages).Error
+	c.Require().NoError(err)
+
+	// just messages older than the retention period should be removed
+	c.Assert().Len(messages, len(c.storeMessages))
+	c.Assert().NotContains(
+		lo.Map(messages, func(sm *persistmessage.StoreMessage, _ int) uuid.UUID { return sm.ID }),
+		oldMessage.ID,
+	)
+}
+
+func (c *postgresMessageServiceTest) Test_InboxConsumerPipeline_Should_Skip_Duplicate_Messages() {
+	message := newTestMessage()
+	consumeContext := types.NewMessageConsumeContext(
+		message,
+		nil,
+		"application/json",
+		message.GetMessageTypeName(),
+		time.Now(),
+		1,
+		message.MessageId,
+		"",
+	)
+
+	inboxPipeline := pipeline.NewInboxConsumerPipeline(c.messagingRepository, c.logger)
+	txPipeline := pipelines.NewConsumerTransactionPipeline(c.logger, c.dbContext.DB())
+
+	handledCount := 0
+	handler := func(ctx context.Context) error {
+		return inboxPipeline.Handle(ctx, consumeContext, func(ctx context.Context) error {
+			handledCount++
+			return nil
+		})
+	}
+
+	err := txPipeline.Handle(c.ctx, consumeContext, handler)
+	c.Require().NoError(err)
+
+	// redelivery of the same message
+	err = txPipeline.Handle(c.ctx, consumeContext, handler)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, handledCount)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+
+	c.Assert().Equal(persistmessage.Inbox, m.DeliveryType)
+	c.Assert().Equal(persistmessage.Processed, m.MessageStatus)
+}
+
+func (c *postgresMessageServiceTest) Test_InboxConsumerPipeline_Should_Rollback_Inbox_When_Handler_Failed() {
+	message := newTestMessage()
+	consumeContext := types.NewMessageConsumeContext(
+		message,
+		nil,
+		"application/json",
+		message.GetMessageTypeName(),
+		time.Now(),
+		1,
+		message.MessageId,
+		"",
+	)
+
+	inboxPipeline := pipeline.NewInboxConsumerPipeline(c.messagingRepository, c.logger)
+	txPipeline := pipelines.NewConsumerTransactionPipeline(c.logger, c.dbContext.DB())
+
+	err := txPipeline.Handle(c.ctx, consumeContext, func(ctx context.Context) error {
+		return inboxPipeline.Handle(ctx, consumeContext, func(ctx context.Context) error {
+			return errors.New("handler failed")
+		})
+	})
+	c.Require().Error(err)
+
+	_, err = c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Assert().True(customErrors.IsNotFoundError(err))
+}
+
+func (c *postgresMessageServiceTest) Test_InboxConsumerPipeline_Should_Handle_Redelivery_When_Handler_Failed_Without_Transaction() {
+	message := newTestMessage()
+	consumeContext := types.NewMessageConsumeContext(
+		message,
+		nil,
+		"application/json",
+		message.GetMessageTypeName(),
+		time.Now(),
+		1,
+		message.MessageId,
+		"",
+	)
+
+	inboxPipeline := pipeline.NewInboxConsumerPipeline(c.messagingRepository, c.logger)
+
+	err := inboxPipeline.Handle(c.ctx, consumeContext, func(ctx context.Context) error {
+		return errors.New("handler failed")
+	})
+	c.Require().Error(err)
+
+	// the inbox message of the failed handler is removed, so the redelivery is handled
+	handledCount := 0
+	err = inboxPipeline.Handle(c.ctx, consumeContext, func(ctx context.Context) error {
+		handledCount++
+		return nil
+	})
+	c.Require().NoError(err)
+	c.Assert().Equal(1, handledCount)
+
+	m, err := c.messagingRepository.GetById(c.ctx, uuid.FromStringOrNil(message.MessageId))
+	c.Require().NoError(err)
+	c.Assert().Equal(persistmessage.Processed, m.MessageStatus)
+}
+
+func (c *postgresMessageServiceTest) Test_InboxConsumerPipeline_Should_Skip_Message_Handled_By_Concurrent_Delivery() {
+	message := newTestMessage()
+	consumeContext := types.NewMessageConsumeContext(
+		message,
+		nil,
+		"application/json",
+		message.GetMessageTypeName(),
+		time.Now(),
+		1,
+		message.MessageId,
+		"",
+	)
+
+	// the inbox message of a delivery which is still handled
+	err := c.messagingRepository.AddReceivedMessage(*types.NewMessageEnvelope(message, nil), c.ctx)
+	c.Require().NoError(err)
+
+	inboxPipeline := pipeline.NewInboxConsumerPipeline(c.messagingRepository, c.logger)
+
+	handledCount := 0
+	err = inboxPipeline.Handle(c.ctx, consumeContext, func(ctx context.Context) error {
+		handledCount++
+		return nil
+	})
+	c.Require().NoError(err)
+	c.Assert().Equal(0, handledCount)
+}
+
+func (c *postgresMessageServiceTest) registerInternalCommand(handler *testInternalCommandHandler) {
+	err := mediatr.RegisterRequestHandler[*testInternalCommand, *mediatr.Unit](handler)
+	c.Require().NoError(err)
+
+	err = cqrs.RegisterInternalCommand[*testInternalCommand, *mediatr.Unit]()
+	c.Require().NoError(err)
+
+	c.T().Cleanup(func() {
+		mediatr.ClearRequestRegistrations()
+		cqrs.ClearInternalCommandRegistrations()
+	})
+}
+
+func (c *postgresMessageServiceTest) countMessagesByDataType(dataType string) int64 {
+	var count int64
+	err := c.dbContext.DB().Model(&persistmessage.StoreMessage{}).Where("data_type = ?", dataType).Count(&count).Error
+	c.Require().NoError(err)
+
+	return count
+}
+
+func (c *postgresMessageServiceTest) internalMessages() []*persistmessage.StoreMessage {
+	var messages []*persistmessage.StoreMessage
+	err := c.dbContext.DB().Where("delivery_type = ?", persistmessage.Internal).Find(&messages).Error
+	c.Require().NoError(err)
+
+	return messages
+}
+
 func (c *postgresMessageServiceTest) initDB() {
 	err := migrateGorm(c.dbContext.DB())
 	c.Require().NoError(err)
@@ -220,3 +779,48 @@ func seedData(
 
 	return messages, nil
 }
+
+type testMessage struct {
+	*types.Message
+	Data string
+}
+
+func newTestMessage() *testMessage {
+	return &testMessage{
+		Message: types.NewMessage(uuid.NewV4().String()),
+		Data:    "test data",
+	}
+}
+
+type testInternalCommand struct {
+	cqrs.InternalCommand
+	ProductId uuid.UUID
+}
+
+func newTestInternalCommand() *testInternalCommand {
+	return &testInternalCommand{
+		InternalCommand: cqrs.NewInternalCommandByT[*testInternalCommand](),
+		ProductId:       uuid.NewV4(),
+	}
+}
+
+type testInternalCommandHandler struct {
+	commands []*testInternalCommand
+	err      error
+	onHandle func(ctx context.Context)
+}
+
+func (h *testInternalCommandHandler) Handle(
+	ctx context.Context,
+	command *testInternalCommand,
+) (*mediatr.Unit, error) {
+	h.commands = append(h.commands, command)
+	if h.onHandle != nil {
+		h.onHandle(ctx)
+	}
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	return &mediatr.Unit{}, nil
+}
@@ -1,7 +1,10 @@
 package postgresmessaging
 
 import (
+	"context"
+
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresmessaging/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresmessaging/messagepersistence"
 
 	"go.uber.org/fx"
@@ -11,10 +14,13 @@ import (
 var Module = fx.Module(
 	"postgresmessagingfx",
 	fx.Provide(
+		config.ProvideConfig,
 		messagepersistence.NewPostgresMessagePersistenceDBContext,
 		messagepersistence.NewPostgresMessageService,
+		messagepersistence.NewMessageDispatcher,
 	),
 	fx.Invoke(migrateMessaging),
+	fx.Invoke(registerHooks),
 )
 
 func migrateMessaging(db *gorm.DB) error {
@@ -22,3 +28,28 @@ func migrateMessaging(db *gorm.DB) error {
 
 	return err
 }
+
+func registerHooks(
+	lc fx.Lifecycle,
+	dispatcher messagepersistence.MessageDispatcher,
+	options *config.PostgresMessagingOptions,
+) {
+	if !options.EnableDispatcher {
+		return
+	}
+
+	lifetimeCtx := context.Background()
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// https://github.com/uber-go/fx/blob/v1.20.0/app.go#L573
+			// this ctx is just for startup dependencies setup and OnStart callbacks, and it has short timeout 15s, and it is not alive in whole lifetime app
+			dispatcher.Start(lifetimeCtx)
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return dispatcher.Stop(ctx)
+		},
+	})
+}
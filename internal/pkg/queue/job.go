@@ -0,0 +1,142 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/utils"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"emperror.dev/errors"
+	"github.com/hibiken/asynq"
+	"github.com/iancoleman/strcase"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+)
+
+// JobHandlersGroupName is the fx group of the job handlers which are registered on the worker
+const JobHandlersGroupName = "job-handlers"
+
+var jobTracer = tracing.NewAppTracer("github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/queue")
+
+// JobHandler handles the typed jobs of a task type
+type JobHandler[TJob any] interface {
+	Handle(ctx context.Context, job TJob) error
+}
+
+// JobTyper overrides the task type of a job, by default the task type is the snake case name of the job type
+type JobTyper interface {
+	JobType() string
+}
+
+// TaskHandler is a job handler which is registered on the worker mux for its task type
+type TaskHandler interface {
+	asynq.Handler
+	TaskType() string
+}
+
+// jobEnvelope is the task payload, the headers carry the OTel context of the enqueuing span
+type jobEnvelope struct {
+	Headers map[string]string `json:"headers"`
+	Data    []byte            `json:"data"`
+}
+
+type jobHandler[TJob any] struct {
+	handler    JobHandler[TJob]
+	serializer serializer.Serializer
+}
+
+// NewJobHandler adapts the typed job handler to a task handler for registering on the worker
+func NewJobHandler[TJob any](handler JobHandler[TJob], serializer serializer.Serializer) TaskHandler {
+	return &jobHandler[TJob]{handler: handler, serializer: serializer}
+}
+
+func (h *jobHandler[TJob]) TaskType() string {
+	return JobType[TJob]()
+}
+
+// ProcessTask deserializes the job and handles it in a span of the enqueuing trace, an invalid payload is not retried
+func (h *jobHandler[TJob]) ProcessTask(ctx context.Context, task *asynq.Task) error {
+	var envelope jobEnvelope
+	if err := h.serializer.Unmarshal(task.Payload(), &envelope); err != nil {
+		return errors.Wrap(asynq.SkipRetry, fmt.Sprintf("error in deserializing job '%s': %v", task.Type(), err))
+	}
+
+	job := typeMapper.GenericInstanceByT[TJob]()
+	if err := h.serializer.Unmarshal(envelope.Data, &job); err != nil {
+		return errors.Wrap(asynq.SkipRetry, fmt.Sprintf("error in deserializing job '%s': %v", task.Type(), err))
+	}
+
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(envelope.Headers))
+	ctx, span := jobTracer.Start(
+		ctx,
+		fmt.Sprintf("%s process", task.Type()),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attribute.String("job.type", task.Type())),
+	)
+	defer span.End()
+
+	err := h.handler.Handle(ctx, job)
+
+	return utils.TraceStatusFromSpan(span, err)
+}
+
+// JobType returns the task type of the job
+func JobType[TJob any]() string {
+	if typer, ok := any(typeMapper.GenericInstanceByT[TJob]()).(JobTyper); ok {
+		return typer.JobType()
+	}
+
+	return strcase.ToSnake(typeMapper.GetGenericNonePointerTypeNameByT[TJob]())
+}
+
+// newJobTask serializes the job with the OTel context of the current span
+func newJobTask(
+	ctx context.Context,
+	taskType string,
+	job interface{},
+	serializer serializer.Serializer,
+	opts ...asynq.Option,
+) (*asynq.Task, error) {
+	data, err := serializer.Marshal(job)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in serializing job '%s'", taskType)
+	}
+
+	envelope := &jobEnvelope{Headers: map[string]string{}, Data: data}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(envelope.Headers))
+
+	payload, err := serializer.Marshal(envelope)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in serializing job '%s'", taskType)
+	}
+
+	return asynq.NewTask(taskType, payload, opts...), nil
+}
+
+// AsJobHandler annotates the given constructor to state that it provides a task handler to the "job-handlers" group, the
+// constructor should return the handler with `NewJobHandler`
+func AsJobHandler(handler interface{}) interface{} {
+	return fx.Annotate(
+		handler,
+		fx.ResultTags(fmt.Sprintf(`group:"%s"`, JobHandlersGroupName)),
+	)
+}
+
+type JobHandlersParams struct {
+	fx.In
+
+	Handlers []TaskHandler `group:"job-handlers"`
+}
+
+// RegisterJobHandlers registers the job handlers which are provided with `AsJobHandler` on the worker mux
+func RegisterJobHandlers(mux *asynq.ServeMux, params JobHandlersParams) {
+	for _, handler := range params.Handlers {
+		mux.Handle(handler.TaskType(), handler)
+	}
+}
@@ -0,0 +1,59 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
+
+	"emperror.dev/errors"
+	"github.com/hibiken/asynq"
+)
+
+// JobClient enqueues the serialized jobs on asynq, the typed jobs are enqueued with `Enqueue`
+type JobClient interface {
+	Enqueue(ctx context.Context, taskType string, job interface{}, opts ...asynq.Option) (*asynq.TaskInfo, error)
+}
+
+// taskEnqueuer is the part of `asynq.Client` which is used by the job client
+type taskEnqueuer interface {
+	EnqueueContext(ctx context.Context, task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error)
+}
+
+type jobClient struct {
+	client     taskEnqueuer
+	serializer serializer.Serializer
+}
+
+func NewJobClient(client *asynq.Client, serializer serializer.Serializer) JobClient {
+	return &jobClient{client: client, serializer: serializer}
+}
+
+func (c *jobClient) Enqueue(
+	ctx context.Context,
+	taskType string,
+	job interface{},
+	opts ...asynq.Option,
+) (*asynq.TaskInfo, error) {
+	task, err := newJobTask(ctx, taskType, job, c.serializer)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := c.client.EnqueueContext(ctx, task, opts...)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in enqueuing job '%s'", taskType)
+	}
+
+	return info, nil
+}
+
+// Enqueue enqueues the job for its handler, the options like `asynq.Queue`, `asynq.ProcessIn` and `asynq.MaxRetry` are
+// applied to the task
+func Enqueue[TJob any](
+	ctx context.Context,
+	client JobClient,
+	job TJob,
+	opts ...asynq.Option,
+) (*asynq.TaskInfo, error) {
+	return client.Enqueue(ctx, JobType[TJob](), job, opts...)
+}
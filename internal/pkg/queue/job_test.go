@@ -0,0 +1,146 @@
+//go:build unit
+// +build unit
+
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer/json"
+
+	"emperror.dev/errors"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_Job_Type_Should_Use_Snake_Case_Type_Name(t *testing.T) {
+	assert.Equal(t, "generate_report_job", JobType[*GenerateReportJob]())
+	assert.Equal(t, "outbox:cleanup", JobType[*CleanupOutboxJob]())
+}
+
+func Test_Enqueue_Should_Enqueue_Serialized_Job_With_Options(t *testing.T) {
+	enqueuer := &recordingEnqueuer{}
+	client := &jobClient{client: enqueuer, serializer: json.NewDefaultJsonSerializer()}
+
+	_, err := Enqueue(context.Background(), client, &GenerateReportJob{ReportName: "sales"}, asynq.Queue("reports"))
+	require.NoError(t, err)
+
+	require.Len(t, enqueuer.tasks, 1)
+	assert.Equal(t, "generate_report_job", enqueuer.tasks[0].Type())
+	require.Len(t, enqueuer.opts, 1)
+	assert.Equal(t, asynq.QueueOpt, enqueuer.opts[0].Type())
+}
+
+func Test_Job_Handler_Should_Handle_Job_In_Enqueuing_Trace(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(previous)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	serializer := json.NewDefaultJsonSerializer()
+	task, err := newJobTask(ctx, JobType[*GenerateReportJob](), &GenerateReportJob{ReportName: "sales"}, serializer)
+	require.NoError(t, err)
+
+	handler := &generateReportHandler{}
+	err = NewJobHandler[*GenerateReportJob](handler, serializer).ProcessTask(context.Background(), task)
+	require.NoError(t, err)
+
+	require.NotNil(t, handler.job)
+	assert.Equal(t, "sales", handler.job.ReportName)
+	assert.Equal(t, spanContext.TraceID(), handler.traceId)
+}
+
+func Test_Job_Handler_Should_Skip_Retry_For_Invalid_Payload(t *testing.T) {
+	handler := NewJobHandler[*GenerateReportJob](&generateReportHandler{}, json.NewDefaultJsonSerializer())
+
+	err := handler.ProcessTask(context.Background(), asynq.NewTask(handler.TaskType(), []byte("invalid")))
+	assert.True(t, errors.Is(err, asynq.SkipRetry))
+}
+
+func Test_Register_Job_Handlers_Should_Route_Tasks_By_Job_Type(t *testing.T) {
+	serializer := json.NewDefaultJsonSerializer()
+	reports := &generateReportHandler{}
+	cleanups := &cleanupOutboxHandler{}
+
+	mux := asynq.NewServeMux()
+	RegisterJobHandlers(mux, JobHandlersParams{Handlers: []TaskHandler{
+		NewJobHandler[*GenerateReportJob](reports, serializer),
+		NewJobHandler[*CleanupOutboxJob](cleanups, serializer),
+	}})
+
+	task, err := newJobTask(context.Background(), JobType[*CleanupOutboxJob](), &CleanupOutboxJob{BatchSize: 10}, serializer)
+	require.NoError(t, err)
+
+	require.NoError(t, mux.ProcessTask(context.Background(), task))
+	assert.Nil(t, reports.job)
+	require.NotNil(t, cleanups.job)
+	assert.Equal(t, 10, cleanups.job.BatchSize)
+}
+
+func Test_Queue_Priorities_Should_Use_Default_Queue_When_No_Queue_Is_Configured(t *testing.T) {
+	assert.Equal(t, map[string]int{DefaultQueue: 1}, (&QueueOptions{}).QueuePriorities())
+
+	queues := map[string]int{"critical": 6, "default": 3, "low": 1}
+	assert.Equal(t, queues, (&QueueOptions{Queues: queues}).QueuePriorities())
+}
+
+type GenerateReportJob struct {
+	ReportName string
+}
+
+type CleanupOutboxJob struct {
+	BatchSize int
+}
+
+func (j *CleanupOutboxJob) JobType() string {
+	return "outbox:cleanup"
+}
+
+type generateReportHandler struct {
+	job     *GenerateReportJob
+	traceId trace.TraceID
+}
+
+func (h *generateReportHandler) Handle(ctx context.Context, job *GenerateReportJob) error {
+	h.job = job
+	h.traceId = trace.SpanContextFromContext(ctx).TraceID()
+
+	return nil
+}
+
+type cleanupOutboxHandler struct {
+	job *CleanupOutboxJob
+}
+
+func (h *cleanupOutboxHandler) Handle(ctx context.Context, job *CleanupOutboxJob) error {
+	h.job = job
+
+	return nil
+}
+
+type recordingEnqueuer struct {
+	tasks []*asynq.Task
+	opts  []asynq.Option
+}
+
+func (r *recordingEnqueuer) EnqueueContext(
+	ctx context.Context,
+	task *asynq.Task,
+	opts ...asynq.Option,
+) (*asynq.TaskInfo, error) {
+	r.tasks = append(r.tasks, task)
+	r.opts = append(r.opts, opts...)
+
+	return &asynq.TaskInfo{Type: task.Type()}, nil
+}
@@ -0,0 +1,118 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	redis2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/redis"
+
+	"emperror.dev/errors"
+	"github.com/hibiken/asynq"
+	"go.uber.org/fx"
+)
+
+// PeriodicJobsGroupName is the fx group of the periodic jobs which are registered on the scheduler
+const PeriodicJobsGroupName = "periodic-jobs"
+
+// PeriodicJob enqueues the job by the cron spec, e.g. `@every 1h` or `0 3 * * *`
+type PeriodicJob struct {
+	CronSpec string
+	TaskType string
+	Job      interface{}
+	Options  []asynq.Option
+}
+
+func NewPeriodicJob[TJob any](cronSpec string, job TJob, opts ...asynq.Option) *PeriodicJob {
+	return &PeriodicJob{
+		CronSpec: cronSpec,
+		TaskType: JobType[TJob](),
+		Job:      job,
+		Options:  opts,
+	}
+}
+
+// AsPeriodicJob annotates the given constructor to state that it provides a periodic job to the "periodic-jobs" group
+func AsPeriodicJob(periodicJob interface{}) interface{} {
+	return fx.Annotate(
+		periodicJob,
+		fx.ResultTags(fmt.Sprintf(`group:"%s"`, PeriodicJobsGroupName)),
+	)
+}
+
+type PeriodicJobsParams struct {
+	fx.In
+
+	PeriodicJobs []*PeriodicJob `group:"periodic-jobs"`
+}
+
+// NewScheduler creates the scheduler of the periodic jobs, the scheduler should run just in one instance of a service because
+// each scheduler enqueues the periodic jobs
+func NewScheduler(
+	config *redis2.RedisOptions,
+	options *QueueOptions,
+	logger logger.Logger,
+) (*asynq.Scheduler, error) {
+	location, err := time.LoadLocation(options.Location)
+	if err != nil {
+		return nil, errors.WrapIff(err, "invalid location '%s' for the periodic jobs", options.Location)
+	}
+
+	return asynq.NewScheduler(
+		redisClientOpt(config),
+		&asynq.SchedulerOpts{
+			Location: location,
+			Logger:   logger,
+			PostEnqueueFunc: func(info *asynq.TaskInfo, err error) {
+				if err != nil {
+					logger.Errorf("error in enqueuing periodic job: %v", err)
+				}
+			},
+		},
+	), nil
+}
+
+// RegisterPeriodicJobs registers the periodic jobs which are provided with `AsPeriodicJob` on the scheduler
+func RegisterPeriodicJobs(
+	scheduler *asynq.Scheduler,
+	serializer serializer.Serializer,
+	params PeriodicJobsParams,
+) error {
+	for _, periodicJob := range params.PeriodicJobs {
+		task, err := newJobTask(
+			context.Background(),
+			periodicJob.TaskType,
+			periodicJob.Job,
+			serializer,
+			periodicJob.Options...,
+		)
+		if err != nil {
+			return err
+		}
+
+		if _, err := scheduler.Register(periodicJob.CronSpec, task); err != nil {
+			return errors.WrapIff(
+				err,
+				"error in registering periodic job '%s' with cron spec '%s'",
+				periodicJob.TaskType,
+				periodicJob.CronSpec,
+			)
+		}
+	}
+
+	return nil
+}
+
+func HookScheduler(lifecycle fx.Lifecycle, scheduler *asynq.Scheduler) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return scheduler.Start()
+		},
+		OnStop: func(ctx context.Context) error {
+			scheduler.Shutdown()
+			return nil
+		},
+	})
+}
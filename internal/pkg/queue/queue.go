@@ -7,8 +7,10 @@ import (
 var (
 	ClientModule    = fx.Module("queue-client", ClientProviders, ClientInvokes)
 	ClientProviders = fx.Options(
+		fx.Provide(provideConfig),
 		fx.Provide(NewServeMux),
 		fx.Provide(NewClient),
+		fx.Provide(NewJobClient),
 	)
 	ClientInvokes = fx.Options(
 		fx.Invoke(HookClient),
@@ -19,6 +21,22 @@ var (
 		fx.Provide(NewServer),
 	)
 	WorkerInvokes = fx.Options(
+		fx.Invoke(RegisterJobHandlers),
 		fx.Invoke(HookServer),
 	)
+
+	// SchedulerModule enqueues the periodic jobs which are provided with `AsPeriodicJob`, it needs the `ClientModule`
+	SchedulerModule = fx.Module(
+		"queue-scheduler",
+		fx.Provide(NewScheduler),
+		fx.Invoke(RegisterPeriodicJobs),
+		fx.Invoke(HookScheduler),
+	)
+
+	// SagaTimeoutsModule schedules the saga timeouts on asynq and delivers them back to their sagas, it needs the `WorkerModule`
+	SagaTimeoutsModule = fx.Module(
+		"queue-saga-timeouts",
+		fx.Provide(NewSagaTimeoutScheduler),
+		fx.Invoke(RegisterSagaTimeoutHandler),
+	)
 )
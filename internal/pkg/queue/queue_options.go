@@ -0,0 +1,42 @@
+package queue
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/config/environment"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	"github.com/iancoleman/strcase"
+)
+
+// DefaultQueue is the asynq queue of the jobs which are enqueued without the `asynq.Queue` option
+const DefaultQueue = "default"
+
+var optionName = strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[QueueOptions]())
+
+type QueueOptions struct {
+	// Concurrency is the maximum number of the jobs which are processed concurrently by a worker
+	Concurrency int `mapstructure:"concurrency"     default:"10"`
+	// Queues are the queue names with their priorities, a queue with a higher priority is processed more often
+	Queues map[string]int `mapstructure:"queues"`
+	// StrictPriority processes the lower priority queues only when the higher priority queues are empty
+	StrictPriority bool `mapstructure:"strictPriority"`
+	// ShutdownTimeout is the waiting time for the active jobs before stopping the worker
+	ShutdownTimeout time.Duration `mapstructure:"shutdownTimeout" default:"8s"`
+	// Location is the time zone of the cron specs of the periodic jobs
+	Location string `mapstructure:"location"        default:"UTC"`
+}
+
+// QueuePriorities returns the configured queues, or the default queue when no queue is configured
+func (o *QueueOptions) QueuePriorities() map[string]int {
+	if len(o.Queues) == 0 {
+		return map[string]int{DefaultQueue: 1}
+	}
+
+	return o.Queues
+}
+
+func provideConfig(environment environment.Environment) (*QueueOptions, error) {
+	return config.BindConfigKey[*QueueOptions](optionName, environment)
+}
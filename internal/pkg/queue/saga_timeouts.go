@@ -0,0 +1,85 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/saga"
+
+	"emperror.dev/errors"
+	"github.com/hibiken/asynq"
+)
+
+// SagaTimeoutTaskType is the asynq task type of the saga timeouts
+const SagaTimeoutTaskType = "saga:timeout"
+
+type sagaTimeoutScheduler struct {
+	client *asynq.Client
+}
+
+func NewSagaTimeoutScheduler(client *asynq.Client) saga.TimeoutScheduler {
+	return &sagaTimeoutScheduler{client: client}
+}
+
+// Schedule enqueues the timeout to be processed at its due time, the task id deduplicates the timeouts which are scheduled again
+// by the redelivered saga messages
+func (s *sagaTimeoutScheduler) Schedule(ctx context.Context, timeout *saga.Timeout) error {
+	payload, err := json.Marshal(timeout)
+	if err != nil {
+		return errors.WrapIf(err, "error in serializing saga timeout")
+	}
+
+	_, err = s.client.EnqueueContext(
+		ctx,
+		asynq.NewTask(SagaTimeoutTaskType, payload),
+		asynq.ProcessAt(timeout.DueAt),
+		asynq.TaskID(sagaTimeoutTaskId(timeout)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	if err != nil {
+		return errors.WrapIff(err, "error in enqueuing timeout '%s' of saga '%s'", timeout.Name, timeout.SagaName)
+	}
+
+	return nil
+}
+
+// NewSagaTimeoutHandler routes the saga timeout tasks to their sagas by the saga name
+func NewSagaTimeoutHandler(sagas []saga.Saga) asynq.Handler {
+	sagasByName := make(map[string]saga.Saga, len(sagas))
+	for _, s := range sagas {
+		sagasByName[s.Name()] = s
+	}
+
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		var timeout saga.Timeout
+		if err := json.Unmarshal(task.Payload(), &timeout); err != nil {
+			return errors.Wrap(asynq.SkipRetry, err.Error())
+		}
+
+		s, ok := sagasByName[timeout.SagaName]
+		if !ok {
+			return errors.Wrapf(asynq.SkipRetry, "saga '%s' is not registered", timeout.SagaName)
+		}
+
+		return s.HandleTimeout(ctx, &timeout)
+	})
+}
+
+// RegisterSagaTimeoutHandler registers the timeouts handler of the sagas which are provided with `saga.AsSaga`
+func RegisterSagaTimeoutHandler(mux *asynq.ServeMux, params saga.SagasParams) {
+	mux.Handle(SagaTimeoutTaskType, NewSagaTimeoutHandler(params.Sagas))
+}
+
+func sagaTimeoutTaskId(timeout *saga.Timeout) string {
+	return fmt.Sprintf(
+		"%s:%s:%s:%s:%s",
+		SagaTimeoutTaskType,
+		timeout.SagaName,
+		timeout.CorrelationId,
+		timeout.State,
+		timeout.Name,
+	)
+}
@@ -0,0 +1,68 @@
+//go:build unit
+// +build unit
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/saga"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+
+	"emperror.dev/errors"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Saga_Timeout_Handler_Should_Route_Timeout_To_Its_Saga(t *testing.T) {
+	orders := &testSaga{name: "orders"}
+	payments := &testSaga{name: "payments"}
+	handler := NewSagaTimeoutHandler([]saga.Saga{orders, payments})
+
+	timeout := &saga.Timeout{SagaName: "payments", CorrelationId: "1", Name: "payment", State: "AwaitingPayment"}
+	payload, err := json.Marshal(timeout)
+	require.NoError(t, err)
+
+	err = handler.ProcessTask(context.Background(), asynq.NewTask(SagaTimeoutTaskType, payload))
+	require.NoError(t, err)
+
+	assert.Empty(t, orders.timeouts)
+	require.Len(t, payments.timeouts, 1)
+	assert.Equal(t, *timeout, *payments.timeouts[0])
+}
+
+func Test_Saga_Timeout_Handler_Should_Skip_Retry_For_Unknown_Saga(t *testing.T) {
+	handler := NewSagaTimeoutHandler([]saga.Saga{&testSaga{name: "orders"}})
+
+	payload, err := json.Marshal(&saga.Timeout{SagaName: "shipments", CorrelationId: "1", Name: "shipping"})
+	require.NoError(t, err)
+
+	err = handler.ProcessTask(context.Background(), asynq.NewTask(SagaTimeoutTaskType, payload))
+	assert.True(t, errors.Is(err, asynq.SkipRetry))
+}
+
+type testSaga struct {
+	name     string
+	timeouts []*saga.Timeout
+}
+
+func (t *testSaga) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	return nil
+}
+
+func (t *testSaga) Name() string {
+	return t.name
+}
+
+func (t *testSaga) MessageTypes() []types.IMessage {
+	return nil
+}
+
+func (t *testSaga) HandleTimeout(ctx context.Context, timeout *saga.Timeout) error {
+	t.timeouts = append(t.timeouts, timeout)
+
+	return nil
+}
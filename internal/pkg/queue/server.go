@@ -2,7 +2,6 @@ package queue
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	redis2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/redis"
@@ -15,10 +14,19 @@ func NewServeMux() *asynq.ServeMux {
 	return asynq.NewServeMux()
 }
 
-func NewServer(config *redis2.RedisOptions, logger logger.Logger) *asynq.Server {
+func NewServer(config *redis2.RedisOptions, options *QueueOptions, logger logger.Logger) *asynq.Server {
 	return asynq.NewServer(
-		asynq.RedisClientOpt{Addr: fmt.Sprintf("%s:%d", config.Host, config.Port)},
-		asynq.Config{Concurrency: 10},
+		redisClientOpt(config),
+		asynq.Config{
+			Concurrency:     options.Concurrency,
+			Queues:          options.QueuePriorities(),
+			StrictPriority:  options.StrictPriority,
+			ShutdownTimeout: options.ShutdownTimeout,
+			Logger:          logger,
+			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
+				logger.Errorf("error in processing job '%s': %v", task.Type(), err)
+			}),
+		},
 	)
 }
 
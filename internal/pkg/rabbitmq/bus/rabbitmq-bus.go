@@ -3,11 +3,14 @@ package bus
 import (
 	"context"
 	"fmt"
+	"io"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
 	consumer2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/messageheader"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/producer"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/utils"
@@ -23,6 +26,7 @@ import (
 
 	"emperror.dev/errors"
 	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
 )
 
 type RabbitmqBus interface {
@@ -38,6 +42,7 @@ type rabbitmqBus struct {
 	logger                  logger.Logger
 	consumerFactory         consumercontracts.ConsumerFactory
 	producerFactory         producercontracts.ProducerFactory
+	replyConsumer           consumercontracts.ReplyConsumer
 	isConsumedNotifications []func(message types.IMessage)
 	isProducedNotifications []func(message types.IMessage)
 }
@@ -61,6 +66,7 @@ func NewRabbitmqBus(
 		producerFactory:       producerFactory,
 		rabbitmqConfigBuilder: builder,
 		messageTypeConsumers:  map[reflect.Type][]consumer2.Consumer{},
+		replyConsumer:         consumerFactory.CreateReplyConsumer(),
 	}
 
 	producersConfigurationMap := make(
@@ -292,6 +298,17 @@ func (r *rabbitmqBus) Stop() error {
 	}
 	waitGroup.Wait()
 
+	if err := r.replyConsumer.Stop(); err != nil {
+		r.logger.Errorf("error in stopping the reply consumer: %v", err)
+	}
+
+	// close the pooled channels of the producer
+	if closer, ok := r.producer.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			r.logger.Errorf("error in closing the producer channels: %v", err)
+		}
+	}
+
 	//err := r.rabbitmqConnection.Close()
 	//if err == amqp091.ErrClosed {
 	//	return nil
@@ -311,6 +328,14 @@ func (r *rabbitmqBus) PublishMessage(
 	return r.producer.PublishMessage(ctx, message, meta)
 }
 
+func (r *rabbitmqBus) PublishMessages(
+	ctx context.Context,
+	messages []types.IMessage,
+	meta metadata.Metadata,
+) error {
+	return r.producer.PublishMessages(ctx, messages, meta)
+}
+
 func (r *rabbitmqBus) PublishMessageWithTopicName(
 	ctx context.Context,
 	message types.IMessage,
@@ -324,3 +349,51 @@ func (r *rabbitmqBus) PublishMessageWithTopicName(
 		topicOrExchangeName,
 	)
 }
+
+// Request publishes the request with the reply queue of the bus as its reply address. The request always gets a new correlation id,
+// because the responses are matched to the waiting requests by their correlation ids.
+func (r *rabbitmqBus) Request(
+	ctx context.Context,
+	request types.IMessage,
+	meta metadata.Metadata,
+	timeout time.Duration,
+) (types.IMessage, error) {
+	if timeout <= 0 {
+		timeout = bus.DefaultRequestTimeout
+	}
+
+	replyQueue, err := r.replyConsumer.ReplyQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	correlationId := uuid.NewV4().String()
+
+	meta = metadata.FromMetadata(meta)
+	messageHeader.SetCorrelationId(meta, correlationId)
+	messageHeader.SetReplyTo(meta, replyQueue)
+
+	reply := r.replyConsumer.Register(correlationId)
+	defer r.replyConsumer.Unregister(correlationId)
+
+	err = r.producer.PublishMessage(ctx, request, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case response := <-reply:
+		return response.Message, response.Err
+	case <-timer.C:
+		return nil, &bus.RequestTimeoutError{
+			MessageId:     request.GeMessageId(),
+			CorrelationId: correlationId,
+			Timeout:       timeout,
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
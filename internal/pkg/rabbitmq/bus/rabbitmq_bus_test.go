@@ -68,6 +68,7 @@ func Test_AddRabbitMQ(t *testing.T) {
 		conn,
 		serializer,
 		defaultlogger.GetLogger(),
+		nil,
 	)
 
 	b, err := NewRabbitmqBus(
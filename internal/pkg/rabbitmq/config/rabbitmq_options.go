@@ -18,6 +18,10 @@ type RabbitmqOptions struct {
 	AppId               string
 	AutoStart           bool `mapstructure:"autoStart"           default:"true"`
 	Reconnecting        bool `mapstructure:"reconnecting"        default:"true"`
+	// ChannelPoolSize is the number of the long-lived confirm channels which are shared by the producers
+	ChannelPoolSize int `mapstructure:"channelPoolSize" default:"4"`
+	// ConfirmTimeout is the maximum wait time for a publish confirmation of the broker
+	ConfirmTimeout time.Duration `mapstructure:"confirmTimeout" default:"5s"`
 }
 
 type RabbitmqHostOptions struct {
@@ -27,6 +27,13 @@ type RabbitMQConsumerConfiguration struct {
 	BindingOptions  *options.RabbitMQBindingOptions
 	QueueOptions    *options.RabbitMQQueueOptions
 	ExchangeOptions *options.RabbitMQExchangeOptions
+	// DeadLetterOptions declares a dead-letter exchange and queue for the consumer queue, the messages which exhaust their retries are published to the
+	// dead-letter queue by the consumer, the default dead-letter queue is used when the retries are enabled without it. the consumer queue arguments
+	// are not changed, so the dead-letter queue can be enabled for an existing queue, the messages which expire in the consumer queue are just
+	// dead-lettered with an `x-dead-letter-exchange` policy (e.g. `rabbitmqctl set_policy`)
+	DeadLetterOptions *options.RabbitMQDeadLetterOptions
+	// RetryOptions declares the delayed-retry topology, the failed messages are redelivered after the delays instead of requeuing them immediately
+	RetryOptions *options.RabbitMQRetryOptions
 }
 
 func NewDefaultRabbitMQConsumerConfiguration(
@@ -1,9 +1,12 @@
 package configurations
 
 import (
+	"time"
+
 	messageConsumer "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/pipeline"
 	types2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/options"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/types"
 )
 
@@ -33,6 +36,9 @@ type RabbitMQConsumerConfigurationBuilder interface {
 	WithRoutingKey(routingKey string) RabbitMQConsumerConfigurationBuilder
 	WithBindingArgs(args map[string]any) RabbitMQConsumerConfigurationBuilder
 	WithName(name string) RabbitMQConsumerConfigurationBuilder
+	WithDeadLetter(exchangeName string, queueName string) RabbitMQConsumerConfigurationBuilder
+	WithDelayedRetries(delays ...time.Duration) RabbitMQConsumerConfigurationBuilder
+	WithDelayedMessageExchange(enabled bool) RabbitMQConsumerConfigurationBuilder
 	Build() *RabbitMQConsumerConfiguration
 }
 
@@ -208,6 +214,40 @@ func (b *rabbitMQConsumerConfigurationBuilder) WithBindingArgs(
 	return b
 }
 
+// WithDeadLetter declares a dead-letter exchange and queue for the consumer, empty names use `<queue>.dlx` and `<queue>.dlq`
+func (b *rabbitMQConsumerConfigurationBuilder) WithDeadLetter(
+	exchangeName string,
+	queueName string,
+) RabbitMQConsumerConfigurationBuilder {
+	b.rabbitmqConsumerConfigurations.DeadLetterOptions = &options.RabbitMQDeadLetterOptions{
+		ExchangeName: exchangeName,
+		QueueName:    queueName,
+	}
+	return b
+}
+
+// WithDelayedRetries redelivers a failed message once per delay, the messages which exhaust the retries move to the dead-letter queue of `WithDeadLetter`
+// or the default `<queue>.dlq`
+func (b *rabbitMQConsumerConfigurationBuilder) WithDelayedRetries(
+	delays ...time.Duration,
+) RabbitMQConsumerConfigurationBuilder {
+	if b.rabbitmqConsumerConfigurations.RetryOptions == nil {
+		b.rabbitmqConsumerConfigurations.RetryOptions = &options.RabbitMQRetryOptions{}
+	}
+	b.rabbitmqConsumerConfigurations.RetryOptions.Delays = delays
+	return b
+}
+
+func (b *rabbitMQConsumerConfigurationBuilder) WithDelayedMessageExchange(
+	enabled bool,
+) RabbitMQConsumerConfigurationBuilder {
+	if b.rabbitmqConsumerConfigurations.RetryOptions == nil {
+		b.rabbitmqConsumerConfigurations.RetryOptions = &options.RabbitMQRetryOptions{}
+	}
+	b.rabbitmqConsumerConfigurations.RetryOptions.UseDelayedMessageExchange = enabled
+	return b
+}
+
 func (b *rabbitMQConsumerConfigurationBuilder) Build() *RabbitMQConsumerConfiguration {
 	if b.pipelinesBuilder != nil {
 		b.rabbitmqConsumerConfigurations.Pipelines = b.pipelinesBuilder.Build().Pipelines
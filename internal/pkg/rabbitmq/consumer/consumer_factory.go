@@ -45,6 +45,10 @@ func (c *consumerFactory) CreateConsumer(
 		isConsumedNotifications...)
 }
 
+func (c *consumerFactory) CreateReplyConsumer() consumercontracts.ReplyConsumer {
+	return NewRabbitMQReplyConsumer(c.connection, c.eventSerializer, c.logger)
+}
+
 func (c *consumerFactory) Connection() types2.IConnection {
 	return c.connection
 }
@@ -13,5 +13,7 @@ type ConsumerFactory interface {
 		isConsumedNotifications ...func(message messagingTypes.IMessage),
 	) (consumer.Consumer, error)
 
+	CreateReplyConsumer() ReplyConsumer
+
 	Connection() types.IConnection
 }
@@ -0,0 +1,22 @@
+package consumercontracts
+
+import (
+	messagingTypes "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+)
+
+// Reply is the response of a request, or the error of receiving it
+type Reply struct {
+	Message messagingTypes.IMessage
+	Err     error
+}
+
+// ReplyConsumer consumes the responses of the requests from an exclusive reply queue, and hands them to the waiting requesters by
+// their correlation ids
+type ReplyConsumer interface {
+	// ReplyQueue declares the reply queue and starts consuming it if it is not consuming, and returns its name
+	ReplyQueue() (string, error)
+	// Register registers a requester for the response with the correlation id, the requester should unregister after receiving it
+	Register(correlationId string) <-chan Reply
+	Unregister(correlationId string)
+	Stop() error
+}
@@ -0,0 +1,10 @@
+package options
+
+type RabbitMQDeadLetterOptions struct {
+	// ExchangeName is the dead-letter exchange, default is `<queue>.dlx`
+	ExchangeName string
+	// QueueName is the dead-letter queue, default is `<queue>.dlq`
+	QueueName string
+	// RoutingKey is the routing key of the dead-letter queue binding, default is the consumer queue name
+	RoutingKey string
+}
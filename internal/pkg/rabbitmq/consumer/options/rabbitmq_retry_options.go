@@ -0,0 +1,10 @@
+package options
+
+import "time"
+
+type RabbitMQRetryOptions struct {
+	// Delays are the delays of the retry tiers, a failed message is redelivered once per tier and after the last tier it moves to the dead-letter queue
+	Delays []time.Duration
+	// UseDelayedMessageExchange uses the `rabbitmq_delayed_message_exchange` plugin instead of the ttl queues for delaying the retries
+	UseDelayedMessageExchange bool
+}
@@ -44,6 +44,7 @@ type rabbitMQConsumer struct {
 	connection              types.IConnection
 	handlerDefault          consumer.ConsumerHandler
 	channel                 *amqp091.Channel
+	topology                *consumerTopology
 	deliveryRoutines        chan struct{} // chan should init before using channel
 	messageSerializer       serializer.MessageSerializer
 	logger                  logger.Logger
@@ -126,6 +127,8 @@ func (r *rabbitMQConsumer) Start(ctx context.Context) error {
 		queue = utils.GetQueueNameFromType(r.rabbitmqConsumerOptions.ConsumerMessageType)
 	}
 
+	r.topology = newConsumerTopology(r.rabbitmqConsumerOptions, queue)
+
 	r.reConsumeOnDropConnection(ctx)
 
 	// get a new channel on the connection - channel is unique for each consumer
@@ -135,6 +138,13 @@ func (r *rabbitMQConsumer) Start(ctx context.Context) error {
 	}
 	r.channel = ch
 
+	// the retried and the dead-lettered messages are acked after the confirm of their publish
+	if r.topology.publishesFailures() {
+		if err := r.channel.Confirm(false); err != nil {
+			return err
+		}
+	}
+
 	// The prefetch count tells the Rabbit connection how many messages to retrieve from the server per request.
 	prefetchCount := r.rabbitmqConsumerOptions.ConcurrencyLimit * r.rabbitmqConsumerOptions.PrefetchCount
 	if err := r.channel.Qos(prefetchCount, 0, false); err != nil {
@@ -174,6 +184,12 @@ func (r *rabbitMQConsumer) Start(ctx context.Context) error {
 		return err
 	}
 
+	// declare the dead-letter and delayed-retry exchanges and queues of the consumer queue
+	err = r.topology.declare(r.channel)
+	if err != nil {
+		return err
+	}
+
 	msgs, err := r.channel.Consume(
 		queue,
 		r.rabbitmqConsumerOptions.ConsumerId,
@@ -348,7 +364,7 @@ func (r *rabbitMQConsumer) handleReceived(
 		}
 
 		nack = func() {
-			if err := delivery.Nack(false, true); err != nil {
+			if err := r.reject(ctx, delivery); err != nil {
 				r.logger.Error(
 					"error in sending Nack to RabbitMQ consumer: %v",
 					consumertracing.FinishConsumerSpan(beforeConsumeSpan, err),
@@ -362,6 +378,38 @@ func (r *rabbitMQConsumer) handleReceived(
 	r.handle(ctx, ack, nack, consumeContext)
 }
 
+// reject moves a failed message to its next retry tier or the dead-letter queue based on its `x-retry-count` header, without
+// a configured dead-letter or retry topology the message is requeued
+func (r *rabbitMQConsumer) reject(ctx context.Context, delivery amqp091.Delivery) error {
+	switch r.topology.failureAction(delivery.Headers) {
+	case RetryFailure:
+		err := r.topology.publishRetry(ctx, r.channel, delivery)
+		if err != nil {
+			r.logger.Errorf("[rabbitMQConsumer.reject] %v, requeuing the message", err)
+			return delivery.Nack(false, true)
+		}
+
+		return delivery.Ack(false)
+	case DeadLetterFailure:
+		err := r.topology.publishDeadLetter(ctx, r.channel, delivery)
+		if err != nil {
+			r.logger.Errorf("[rabbitMQConsumer.reject] %v, requeuing the message", err)
+			return delivery.Nack(false, true)
+		}
+
+		r.logger.Errorf(
+			"[rabbitMQConsumer.reject] message '%s' moved to the dead-letter queue '%s' after %d retries",
+			delivery.MessageId,
+			r.topology.deadLetterQueue,
+			getRetryCount(delivery.Headers),
+		)
+
+		return delivery.Ack(false)
+	default:
+		return delivery.Nack(false, true)
+	}
+}
+
 func (r *rabbitMQConsumer) handle(
 	ctx context.Context,
 	ack func(),
@@ -471,9 +519,59 @@ func (r *rabbitMQConsumer) createConsumeContext(
 		delivery.MessageId,
 		delivery.CorrelationId,
 	)
+
+	// the consumer of a request message responds to the reply address of the request
+	if delivery.ReplyTo != "" {
+		consumeContext = messagingTypes.NewRequestConsumeContext(
+			consumeContext,
+			delivery.ReplyTo,
+			func(ctx context.Context, response messagingTypes.IMessage) error {
+				return r.respond(ctx, delivery, response)
+			},
+		)
+	}
+
 	return consumeContext, nil
 }
 
+// respond publishes the response to the reply queue of the request through the default exchange, with the correlation id of the request
+func (r *rabbitMQConsumer) respond(
+	ctx context.Context,
+	delivery amqp091.Delivery,
+	response messagingTypes.IMessage,
+) error {
+	serializedObj, err := r.messageSerializer.Serialize(response)
+	if err != nil {
+		return err
+	}
+
+	err = r.channel.PublishWithContext(
+		ctx,
+		"",
+		delivery.ReplyTo,
+		false,
+		false,
+		amqp091.Publishing{
+			CorrelationId: delivery.CorrelationId,
+			MessageId:     response.GeMessageId(),
+			Timestamp:     time.Now(),
+			Type:          response.GetMessageTypeName(),
+			ContentType:   serializedObj.ContentType,
+			Body:          serializedObj.Data,
+		},
+	)
+	if err != nil {
+		return errors.WrapIff(
+			err,
+			"failed to respond the request '%s' to the reply queue '%s'",
+			delivery.MessageId,
+			delivery.ReplyTo,
+		)
+	}
+
+	return nil
+}
+
 func (r *rabbitMQConsumer) deserializeData(
 	contentType string,
 	eventType string,
@@ -64,6 +64,7 @@ func Test_Consumer_With_Fake_Message(t *testing.T) {
 		conn,
 		eventSerializer,
 		defaultLogger2.GetLogger(),
+		nil,
 	)
 
 	fakeHandler := consumer.NewRabbitMQFakeTestConsumerHandler[ProducerConsumerMessage]()
@@ -0,0 +1,321 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/configurations"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/options"
+
+	"emperror.dev/errors"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	// RetryCountHeader keeps the number of the delayed redeliveries of a message across the retry tiers
+	RetryCountHeader = "x-retry-count"
+	delayHeader      = "x-delay"
+
+	delayedMessageExchangeType = "x-delayed-message"
+)
+
+// FailureAction is what happens to a message after its handlers failed
+type FailureAction int
+
+const (
+	// RequeueFailure requeues the message immediately, it is the behavior when no dead-letter or retry topology is configured
+	RequeueFailure FailureAction = iota
+	// RetryFailure republishes the message to the next retry tier
+	RetryFailure
+	// DeadLetterFailure publishes the message to the dead-letter exchange
+	DeadLetterFailure
+)
+
+// consumerTopology declares the dead-letter and delayed-retry exchanges and queues of a consumer queue, and decides what happens to
+// a message after its handlers failed
+type consumerTopology struct {
+	options              *configurations.RabbitMQConsumerConfiguration
+	queueName            string
+	deadLetterExchange   string
+	deadLetterQueue      string
+	deadLetterRoutingKey string
+	delayedExchange      string
+}
+
+func newConsumerTopology(
+	consumerConfiguration *configurations.RabbitMQConsumerConfiguration,
+	queueName string,
+) *consumerTopology {
+	topology := &consumerTopology{options: consumerConfiguration, queueName: queueName}
+
+	// the messages which exhaust their retries are kept in the default dead-letter queue instead of discarding them
+	deadLetter := consumerConfiguration.DeadLetterOptions
+	if deadLetter == nil && topology.hasRetries() {
+		deadLetter = &options.RabbitMQDeadLetterOptions{}
+	}
+
+	if deadLetter != nil {
+		topology.deadLetterExchange = deadLetter.ExchangeName
+		if topology.deadLetterExchange == "" {
+			topology.deadLetterExchange = fmt.Sprintf("%s.dlx", queueName)
+		}
+
+		topology.deadLetterQueue = deadLetter.QueueName
+		if topology.deadLetterQueue == "" {
+			topology.deadLetterQueue = fmt.Sprintf("%s.dlq", queueName)
+		}
+
+		topology.deadLetterRoutingKey = deadLetter.RoutingKey
+		if topology.deadLetterRoutingKey == "" {
+			topology.deadLetterRoutingKey = queueName
+		}
+	}
+
+	if topology.hasRetries() && consumerConfiguration.RetryOptions.UseDelayedMessageExchange {
+		topology.delayedExchange = fmt.Sprintf("%s.delayed", queueName)
+	}
+
+	return topology
+}
+
+func (t *consumerTopology) hasDeadLetter() bool {
+	return t.deadLetterExchange != ""
+}
+
+func (t *consumerTopology) hasRetries() bool {
+	return t.options.RetryOptions != nil && len(t.options.RetryOptions.Delays) > 0
+}
+
+// publishesFailures reports whether the consumer publishes the failed messages to the retry or the dead-letter
+// exchanges, the consumer channel is put in the confirm mode for them
+func (t *consumerTopology) publishesFailures() bool {
+	return t.hasRetries() || t.hasDeadLetter()
+}
+
+// retryQueueName is the ttl queue of a retry tier, the delay is part of the name because the ttl of an existing queue can't be changed
+func (t *consumerTopology) retryQueueName(delay time.Duration) string {
+	return fmt.Sprintf("%s.retry.%s", t.queueName, delay)
+}
+
+func (t *consumerTopology) declare(channel *amqp091.Channel) error {
+	durable := t.options.QueueOptions.Durable
+	noWait := t.options.NoWait
+
+	if t.hasDeadLetter() {
+		err := channel.ExchangeDeclare(t.deadLetterExchange, amqp091.ExchangeDirect, durable, false, false, noWait, nil)
+		if err != nil {
+			return errors.WrapIff(err, "failed to declare dead-letter exchange '%s'", t.deadLetterExchange)
+		}
+
+		_, err = channel.QueueDeclare(t.deadLetterQueue, durable, false, false, noWait, nil)
+		if err != nil {
+			return errors.WrapIff(err, "failed to declare dead-letter queue '%s'", t.deadLetterQueue)
+		}
+
+		err = channel.QueueBind(t.deadLetterQueue, t.deadLetterRoutingKey, t.deadLetterExchange, noWait, nil)
+		if err != nil {
+			return errors.WrapIff(err, "failed to bind dead-letter queue '%s'", t.deadLetterQueue)
+		}
+	}
+
+	if !t.hasRetries() {
+		return nil
+	}
+
+	if t.delayedExchange != "" {
+		err := channel.ExchangeDeclare(
+			t.delayedExchange,
+			delayedMessageExchangeType,
+			durable,
+			false,
+			false,
+			noWait,
+			amqp091.Table{"x-delayed-type": amqp091.ExchangeDirect},
+		)
+		if err != nil {
+			return errors.WrapIff(err, "failed to declare delayed message exchange '%s'", t.delayedExchange)
+		}
+
+		err = channel.QueueBind(t.queueName, t.queueName, t.delayedExchange, noWait, nil)
+		if err != nil {
+			return errors.WrapIff(err, "failed to bind queue '%s' to delayed message exchange", t.queueName)
+		}
+
+		return nil
+	}
+
+	// the expired messages of a retry queue are dead-lettered through the default exchange back to the consumer queue
+	for _, delay := range t.options.RetryOptions.Delays {
+		retryQueue := t.retryQueueName(delay)
+		_, err := channel.QueueDeclare(retryQueue, durable, false, false, noWait, amqp091.Table{
+			"x-message-ttl":             delay.Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": t.queueName,
+		})
+		if err != nil {
+			return errors.WrapIff(err, "failed to declare retry queue '%s'", retryQueue)
+		}
+	}
+
+	return nil
+}
+
+// failureAction decides what happens to a message after its handlers failed, based on its redeliveries count
+func (t *consumerTopology) failureAction(headers amqp091.Table) FailureAction {
+	return GetFailureAction(t.options, getRetryCount(headers))
+}
+
+// GetFailureAction decides what happens to a failed message of the consumer after the given number of retries, the
+// messages which exhausted their retries move to the default dead-letter queue when the consumer has no dead-letter options
+func GetFailureAction(
+	consumerConfiguration *configurations.RabbitMQConsumerConfiguration,
+	retryCount int,
+) FailureAction {
+	retryOptions := consumerConfiguration.RetryOptions
+	if retryOptions == nil || len(retryOptions.Delays) == 0 {
+		if consumerConfiguration.DeadLetterOptions != nil {
+			return DeadLetterFailure
+		}
+
+		return RequeueFailure
+	}
+
+	if retryCount < len(retryOptions.Delays) {
+		return RetryFailure
+	}
+
+	return DeadLetterFailure
+}
+
+// publishRetry republishes the message to its next retry tier with an incremented retry count
+func (t *consumerTopology) publishRetry(
+	ctx context.Context,
+	channel *amqp091.Channel,
+	delivery amqp091.Delivery,
+) error {
+	retryCount := getRetryCount(delivery.Headers)
+	delay := t.options.RetryOptions.Delays[retryCount]
+
+	publishing := newPublishing(delivery)
+	publishing.Headers[RetryCountHeader] = int32(retryCount + 1)
+
+	exchange := ""
+	routingKey := t.retryQueueName(delay)
+	if t.delayedExchange != "" {
+		exchange = t.delayedExchange
+		routingKey = t.queueName
+		publishing.Headers[delayHeader] = delay.Milliseconds()
+	}
+
+	err := publishConfirmed(ctx, channel, exchange, routingKey, publishing)
+	if err != nil {
+		return errors.WrapIff(err, "failed to publish message '%s' for retry %d", delivery.MessageId, retryCount+1)
+	}
+
+	return nil
+}
+
+// publishDeadLetter publishes the message to the dead-letter exchange, the consumer publishes the dead letters instead of
+// the `x-dead-letter-exchange` argument of the consumer queue, because the arguments of an existing queue can't be
+// changed and redeclaring it with other arguments fails with `PRECONDITION_FAILED`
+func (t *consumerTopology) publishDeadLetter(
+	ctx context.Context,
+	channel *amqp091.Channel,
+	delivery amqp091.Delivery,
+) error {
+	err := publishConfirmed(ctx, channel, t.deadLetterExchange, t.deadLetterRoutingKey, newPublishing(delivery))
+	if err != nil {
+		return errors.WrapIff(err, "failed to publish message '%s' to the dead-letter exchange", delivery.MessageId)
+	}
+
+	return nil
+}
+
+// newPublishing copies the properties and the body of the delivery, the user id is not copied because the broker
+// rejects a user id which is not the user of the connection
+func newPublishing(delivery amqp091.Delivery) amqp091.Publishing {
+	headers := amqp091.Table{}
+	for key, value := range delivery.Headers {
+		headers[key] = value
+	}
+
+	deliveryMode := delivery.DeliveryMode
+	if deliveryMode == 0 {
+		deliveryMode = amqp091.Persistent
+	}
+
+	return amqp091.Publishing{
+		Headers:         headers,
+		ContentType:     delivery.ContentType,
+		ContentEncoding: delivery.ContentEncoding,
+		DeliveryMode:    deliveryMode,
+		Priority:        delivery.Priority,
+		CorrelationId:   delivery.CorrelationId,
+		ReplyTo:         delivery.ReplyTo,
+		Expiration:      delivery.Expiration,
+		MessageId:       delivery.MessageId,
+		Timestamp:       delivery.Timestamp,
+		Type:            delivery.Type,
+		AppId:           delivery.AppId,
+		Body:            delivery.Body,
+	}
+}
+
+// publishConfirmed publishes the message and waits for the confirm of the broker, so the delivery is just acked after
+// the broker took the responsibility of the republished message
+func publishConfirmed(
+	ctx context.Context,
+	channel *amqp091.Channel,
+	exchange string,
+	routingKey string,
+	publishing amqp091.Publishing,
+) error {
+	confirmation, err := channel.PublishWithDeferredConfirmWithContext(ctx, exchange, routingKey, false, false, publishing)
+	if err != nil {
+		return err
+	}
+
+	// the deferred confirmation is nil when the channel is not in the confirm mode
+	if confirmation == nil {
+		return errors.New("channel is not in the confirm mode")
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !acked {
+		return errors.New("message is not confirmed by the broker")
+	}
+
+	return nil
+}
+
+func getRetryCount(headers amqp091.Table) int {
+	switch count := headers[RetryCountHeader].(type) {
+	case int:
+		return count
+	case int8:
+		return int(count)
+	case int16:
+		return int(count)
+	case int32:
+		return int(count)
+	case int64:
+		return int(count)
+	case uint8:
+		return int(count)
+	case uint16:
+		return int(count)
+	case uint32:
+		return int(count)
+	case float32:
+		return int(count)
+	case float64:
+		return int(count)
+	default:
+		return 0
+	}
+}
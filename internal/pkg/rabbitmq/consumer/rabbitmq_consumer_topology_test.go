@@ -0,0 +1,109 @@
+//go:build unit
+// +build unit
+
+package consumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/configurations"
+
+	"github.com/rabbitmq/amqp091-go"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type TopologyMessage struct {
+	*types.Message
+}
+
+func newTopologyMessage() *TopologyMessage {
+	return &TopologyMessage{Message: types.NewMessage(uuid.NewV4().String())}
+}
+
+func Test_Topology_Without_Dead_Letter_And_Retries_Should_Requeue(t *testing.T) {
+	cfg := configurations.NewRabbitMQConsumerConfigurationBuilder(newTopologyMessage()).Build()
+	topology := newConsumerTopology(cfg, "orders")
+
+	assert.Equal(t, RequeueFailure, topology.failureAction(nil))
+	assert.False(t, topology.publishesFailures())
+}
+
+func Test_Topology_Should_Retry_Until_Budget_Then_Dead_Letter(t *testing.T) {
+	cfg := configurations.NewRabbitMQConsumerConfigurationBuilder(newTopologyMessage()).
+		WithQueueArgs(map[string]any{"x-queue-type": "quorum"}).
+		WithDeadLetter("", "").
+		WithDelayedRetries(time.Second, 10*time.Second).
+		Build()
+	topology := newConsumerTopology(cfg, "orders")
+
+	assert.Equal(t, "orders.dlx", topology.deadLetterExchange)
+	assert.Equal(t, "orders.dlq", topology.deadLetterQueue)
+	assert.Equal(t, "orders.retry.10s", topology.retryQueueName(10*time.Second))
+	assert.True(t, topology.publishesFailures())
+
+	assert.Equal(t, RetryFailure, topology.failureAction(amqp091.Table{}))
+	assert.Equal(t, RetryFailure, topology.failureAction(amqp091.Table{RetryCountHeader: int32(1)}))
+	assert.Equal(t, DeadLetterFailure, topology.failureAction(amqp091.Table{RetryCountHeader: int64(2)}))
+}
+
+func Test_Topology_Should_Dead_Letter_Exhausted_Message_To_Default_Queue_Without_Dead_Letter_Options(t *testing.T) {
+	cfg := configurations.NewRabbitMQConsumerConfigurationBuilder(newTopologyMessage()).
+		WithDelayedRetries(time.Second).
+		WithDelayedMessageExchange(true).
+		Build()
+	topology := newConsumerTopology(cfg, "orders")
+
+	assert.Equal(t, "orders.delayed", topology.delayedExchange)
+	assert.Equal(t, "orders.dlx", topology.deadLetterExchange)
+	assert.Equal(t, "orders.dlq", topology.deadLetterQueue)
+	assert.Equal(t, DeadLetterFailure, topology.failureAction(amqp091.Table{RetryCountHeader: int32(1)}))
+}
+
+func Test_New_Publishing_Should_Copy_Delivery_Properties(t *testing.T) {
+	delivery := amqp091.Delivery{
+		Headers:         amqp091.Table{RetryCountHeader: int32(1)},
+		ContentType:     "application/json",
+		ContentEncoding: "gzip",
+		Priority:        5,
+		CorrelationId:   "correlation",
+		ReplyTo:         "replies",
+		Expiration:      "60000",
+		MessageId:       "message",
+		Timestamp:       time.Now(),
+		Type:            "type",
+		UserId:          "guest",
+		AppId:           "app",
+		Body:            []byte("{}"),
+	}
+
+	publishing := newPublishing(delivery)
+	publishing.Headers[RetryCountHeader] = int32(2)
+
+	assert.Equal(t, amqp091.Publishing{
+		Headers:         amqp091.Table{RetryCountHeader: int32(2)},
+		ContentType:     delivery.ContentType,
+		ContentEncoding: delivery.ContentEncoding,
+		DeliveryMode:    amqp091.Persistent,
+		Priority:        delivery.Priority,
+		CorrelationId:   delivery.CorrelationId,
+		ReplyTo:         delivery.ReplyTo,
+		Expiration:      delivery.Expiration,
+		MessageId:       delivery.MessageId,
+		Timestamp:       delivery.Timestamp,
+		Type:            delivery.Type,
+		AppId:           delivery.AppId,
+		Body:            delivery.Body,
+	}, publishing)
+	// the headers of the delivery are not changed
+	assert.Equal(t, int32(1), delivery.Headers[RetryCountHeader])
+}
+
+func Test_Get_Retry_Count(t *testing.T) {
+	assert.Equal(t, 0, getRetryCount(nil))
+	assert.Equal(t, 0, getRetryCount(amqp091.Table{RetryCountHeader: "invalid"}))
+	assert.Equal(t, 3, getRetryCount(amqp091.Table{RetryCountHeader: int32(3)}))
+	assert.Equal(t, 4, getRetryCount(amqp091.Table{RetryCountHeader: uint8(4)}))
+}
@@ -0,0 +1,131 @@
+package consumer
+
+import (
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/consumercontracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/rabbitmqErrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/types"
+
+	"emperror.dev/errors"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+type rabbitMQReplyConsumer struct {
+	connection        types.IConnection
+	messageSerializer serializer.MessageSerializer
+	logger            logger.Logger
+	mu                sync.Mutex
+	channel           *amqp091.Channel
+	queue             string
+	pendingMu         sync.Mutex
+	pending           map[string]chan consumercontracts.Reply
+}
+
+// NewRabbitMQReplyConsumer creates a reply consumer, its reply queue is declared lazily by the first request and is declared again
+// after losing its channel
+func NewRabbitMQReplyConsumer(
+	connection types.IConnection,
+	messageSerializer serializer.MessageSerializer,
+	logger logger.Logger,
+) consumercontracts.ReplyConsumer {
+	return &rabbitMQReplyConsumer{
+		connection:        connection,
+		messageSerializer: messageSerializer,
+		logger:            logger,
+		pending:           make(map[string]chan consumercontracts.Reply),
+	}
+}
+
+func (r *rabbitMQReplyConsumer) ReplyQueue() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.channel != nil && !r.channel.IsClosed() {
+		return r.queue, nil
+	}
+
+	if r.connection == nil {
+		return "", errors.New("connection is nil")
+	}
+
+	ch, err := r.connection.Channel()
+	if err != nil {
+		return "", rabbitmqErrors.ErrDisconnected
+	}
+
+	// a server-named exclusive queue is deleted by the broker when its connection is closed
+	queue, err := ch.QueueDeclare("", false, true, true, false, nil)
+	if err != nil {
+		_ = ch.Close()
+		return "", errors.WrapIf(err, "failed to declare the reply queue")
+	}
+
+	deliveries, err := ch.Consume(queue.Name, "", true, true, false, false, nil)
+	if err != nil {
+		_ = ch.Close()
+		return "", errors.WrapIf(err, "failed to consume the reply queue")
+	}
+
+	r.channel = ch
+	r.queue = queue.Name
+
+	go r.dispatchReplies(deliveries)
+
+	return r.queue, nil
+}
+
+func (r *rabbitMQReplyConsumer) Register(correlationId string) <-chan consumercontracts.Reply {
+	reply := make(chan consumercontracts.Reply, 1)
+
+	r.pendingMu.Lock()
+	r.pending[correlationId] = reply
+	r.pendingMu.Unlock()
+
+	return reply
+}
+
+func (r *rabbitMQReplyConsumer) Unregister(correlationId string) {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+
+	delete(r.pending, correlationId)
+}
+
+func (r *rabbitMQReplyConsumer) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.channel == nil || r.channel.IsClosed() {
+		return nil
+	}
+
+	return r.channel.Close()
+}
+
+// dispatchReplies hands the responses to their requesters, the responses of the timed out requests are dropped
+func (r *rabbitMQReplyConsumer) dispatchReplies(deliveries <-chan amqp091.Delivery) {
+	for delivery := range deliveries {
+		r.pendingMu.Lock()
+		reply, ok := r.pending[delivery.CorrelationId]
+		delete(r.pending, delivery.CorrelationId)
+		r.pendingMu.Unlock()
+
+		if !ok {
+			r.logger.Infof(
+				"[rabbitMQReplyConsumer.dispatchReplies] no requester is waiting for the response with correlation id '%s'",
+				delivery.CorrelationId,
+			)
+			continue
+		}
+
+		message, err := r.messageSerializer.Deserialize(delivery.Body, delivery.Type, delivery.ContentType)
+		if err != nil {
+			err = errors.WrapIff(err, "failed to deserialize the response of type '%s'", delivery.Type)
+		}
+
+		reply <- consumercontracts.Reply{Message: message, Err: err}
+	}
+}
@@ -0,0 +1,306 @@
+package producer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/rabbitmqErrors"
+
+	"emperror.dev/errors"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// confirmsBufferSize is the buffer of the publish confirmations of a channel, the dispatcher drains it continuously
+const confirmsBufferSize = 256
+
+// amqpChannel is the part of `amqp091.Channel` which is used by the producer
+type amqpChannel interface {
+	Confirm(noWait bool) error
+	NotifyPublish(confirm chan amqp091.Confirmation) chan amqp091.Confirmation
+	NotifyReturn(returns chan amqp091.Return) chan amqp091.Return
+	GetNextPublishSeqNo() uint64
+	PublishWithContext(
+		ctx context.Context,
+		exchange string,
+		key string,
+		mandatory bool,
+		immediate bool,
+		msg amqp091.Publishing,
+	) error
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp091.Table) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp091.Table) (amqp091.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp091.Table) error
+	IsClosed() bool
+	Close() error
+}
+
+// confirmChannel is a long-lived channel in the confirm mode, it can be used by the concurrent publishers and maps the
+// confirmations back to the publishers by their delivery tags
+type confirmChannel struct {
+	channel amqpChannel
+	// publishMu keeps the order of the delivery tags and the publishes
+	publishMu sync.Mutex
+	pendingMu sync.Mutex
+	pending   map[uint64]*pendingConfirm
+	closed    bool
+}
+
+type pendingConfirm struct {
+	messageId  string
+	exchange   string
+	routingKey string
+	done       chan publishResult
+}
+
+type publishResult struct {
+	confirmation amqp091.Confirmation
+	// returned is not nil when the broker returned the mandatory message because it is unroutable
+	returned *amqp091.Return
+}
+
+func newConfirmChannel(channel amqpChannel) (*confirmChannel, error) {
+	if err := channel.Confirm(false); err != nil {
+		_ = channel.Close()
+		return nil, errors.WrapIf(err, "failed to put the channel in confirm mode")
+	}
+
+	c := &confirmChannel{
+		channel: channel,
+		pending: make(map[uint64]*pendingConfirm),
+	}
+
+	// the confirmations and returns channels are closed by amqp when the channel is closed
+	returns := channel.NotifyReturn(make(chan amqp091.Return, confirmsBufferSize))
+	confirms := channel.NotifyPublish(make(chan amqp091.Confirmation, confirmsBufferSize))
+	go c.dispatchConfirms(confirms, returns)
+
+	return c, nil
+}
+
+// dispatchConfirms sends the confirmations to their publishers. The broker sends the `basic.return` of an unroutable mandatory message
+// before its `basic.ack`, and amqp delivers the returns and the confirmations in the publish order, so when a confirmation is
+// handled the returns of the previous publishes are already matched and its own return, if any, is the oldest received one. The
+// oldest return can also belong to a later publish whose ack came before a delayed ack, so it is checked against the publish too,
+// because the message ids are not unique (e.g. a retried message). The returns are kept just for the in-flight publishes of this
+// channel and are dropped with it.
+func (c *confirmChannel) dispatchConfirms(confirms chan amqp091.Confirmation, returns chan amqp091.Return) {
+	var returned []amqp091.Return
+
+	for confirm := range confirms {
+		returns = drainReturns(returns, &returned)
+
+		c.pendingMu.Lock()
+		pending, ok := c.pending[confirm.DeliveryTag]
+		delete(c.pending, confirm.DeliveryTag)
+		c.pendingMu.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		result := publishResult{confirmation: confirm}
+		if len(returned) > 0 && pending.isReturnedBy(returned[0]) {
+			result.returned = &returned[0]
+			returned = returned[1:]
+		}
+
+		pending.done <- result
+	}
+
+	// the channel is closed, and the pending publishes will never be confirmed
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+
+	c.closed = true
+	for deliveryTag, pending := range c.pending {
+		close(pending.done)
+		delete(c.pending, deliveryTag)
+	}
+}
+
+// drainReturns appends the available returns without blocking, it returns nil when the returns channel is closed
+func drainReturns(returns chan amqp091.Return, returned *[]amqp091.Return) chan amqp091.Return {
+	for returns != nil {
+		select {
+		case ret, ok := <-returns:
+			if !ok {
+				return nil
+			}
+			*returned = append(*returned, ret)
+		default:
+			return returns
+		}
+	}
+
+	return nil
+}
+
+func (p *pendingConfirm) isReturnedBy(ret amqp091.Return) bool {
+	return ret.MessageId == p.messageId && ret.Exchange == p.exchange && ret.RoutingKey == p.routingKey
+}
+
+func (c *confirmChannel) isClosed() bool {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+
+	return c.closed || c.channel.IsClosed()
+}
+
+// publish publishes the message without waiting for its confirmation, the returned confirm should be waited for the confirmation
+func (c *confirmChannel) publish(
+	ctx context.Context,
+	exchange string,
+	routingKey string,
+	msg amqp091.Publishing,
+) (*publishConfirm, error) {
+	c.publishMu.Lock()
+	defer c.publishMu.Unlock()
+
+	done := make(chan publishResult, 1)
+	deliveryTag := c.channel.GetNextPublishSeqNo()
+
+	c.pendingMu.Lock()
+	if c.closed {
+		c.pendingMu.Unlock()
+		return nil, rabbitmqErrors.ErrChannelClosed
+	}
+	c.pending[deliveryTag] = &pendingConfirm{
+		messageId:  msg.MessageId,
+		exchange:   exchange,
+		routingKey: routingKey,
+		done:       done,
+	}
+	c.pendingMu.Unlock()
+
+	err := c.channel.PublishWithContext(ctx, exchange, routingKey, true, false, msg)
+	if err != nil {
+		c.pendingMu.Lock()
+		delete(c.pending, deliveryTag)
+		c.pendingMu.Unlock()
+
+		return nil, err
+	}
+
+	return &publishConfirm{
+		messageId:   msg.MessageId,
+		exchange:    exchange,
+		routingKey:  routingKey,
+		deliveryTag: deliveryTag,
+		done:        done,
+	}, nil
+}
+
+type publishConfirm struct {
+	messageId   string
+	exchange    string
+	routingKey  string
+	deliveryTag uint64
+	done        chan publishResult
+}
+
+func (p *publishConfirm) wait(ctx context.Context, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result, ok := <-p.done:
+		if !ok {
+			return errors.WithMessagef(rabbitmqErrors.ErrChannelClosed, "message '%s'", p.messageId)
+		}
+		if result.returned != nil {
+			return &rabbitmqErrors.UnroutableMessageError{
+				MessageId:  p.messageId,
+				Exchange:   p.exchange,
+				RoutingKey: p.routingKey,
+				ReplyCode:  result.returned.ReplyCode,
+				ReplyText:  result.returned.ReplyText,
+			}
+		}
+		if !result.confirmation.Ack {
+			return &rabbitmqErrors.PublishNackedError{
+				MessageId:   p.messageId,
+				Exchange:    p.exchange,
+				DeliveryTag: p.deliveryTag,
+			}
+		}
+
+		return nil
+	case <-timer.C:
+		return &rabbitmqErrors.ConfirmTimeoutError{
+			MessageId:   p.messageId,
+			Exchange:    p.exchange,
+			DeliveryTag: p.deliveryTag,
+			Timeout:     timeout,
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// channelPool keeps a fixed number of confirm channels and hands them out in round-robin, the closed channels are replaced lazily
+type channelPool struct {
+	mu         sync.Mutex
+	newChannel func() (amqpChannel, error)
+	channels   []*confirmChannel
+	next       atomic.Uint64
+	// onRecreate is called when a closed channel is replaced, e.g. after a reconnection
+	onRecreate func()
+}
+
+func newChannelPool(size int, newChannel func() (amqpChannel, error), onRecreate func()) *channelPool {
+	if size <= 0 {
+		size = 1
+	}
+
+	return &channelPool{
+		newChannel: newChannel,
+		channels:   make([]*confirmChannel, size),
+		onRecreate: onRecreate,
+	}
+}
+
+func (p *channelPool) get() (*confirmChannel, error) {
+	index := int(p.next.Add(1) % uint64(len(p.channels)))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := p.channels[index]
+	if current != nil && !current.isClosed() {
+		return current, nil
+	}
+
+	channel, err := p.newChannel()
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to open a rabbitmq channel")
+	}
+
+	confirmCh, err := newConfirmChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+	p.channels[index] = confirmCh
+
+	if current != nil && p.onRecreate != nil {
+		p.onRecreate()
+	}
+
+	return confirmCh, nil
+}
+
+func (p *channelPool) close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var err error
+	for index, channel := range p.channels {
+		if channel != nil && !channel.channel.IsClosed() {
+			err = errors.Append(err, channel.channel.Close())
+		}
+		p.channels[index] = nil
+	}
+
+	return err
+}
@@ -0,0 +1,245 @@
+//go:build unit
+// +build unit
+
+package producer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/rabbitmqErrors"
+
+	"emperror.dev/errors"
+	"github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Confirm_Channel_Should_Map_Confirmations_To_Publishers(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeChannel()
+	channel, err := newConfirmChannel(fake)
+	require.NoError(t, err)
+
+	first, err := channel.publish(ctx, "orders", "orders", amqp091.Publishing{MessageId: "1"})
+	require.NoError(t, err)
+	second, err := channel.publish(ctx, "orders", "orders", amqp091.Publishing{MessageId: "2"})
+	require.NoError(t, err)
+
+	// the confirmations arrive out of order
+	fake.confirm(2, false)
+	fake.confirm(1, true)
+
+	assert.NoError(t, first.wait(ctx, time.Second))
+
+	err = second.wait(ctx, time.Second)
+	assert.True(t, rabbitmqErrors.IsPublishNackedError(err))
+}
+
+func Test_Confirm_Channel_Should_Return_Typed_Timeout_Error(t *testing.T) {
+	ctx := context.Background()
+	channel, err := newConfirmChannel(newFakeChannel())
+	require.NoError(t, err)
+
+	confirm, err := channel.publish(ctx, "orders", "orders", amqp091.Publishing{MessageId: "1"})
+	require.NoError(t, err)
+
+	err = confirm.wait(ctx, 10*time.Millisecond)
+	assert.True(t, rabbitmqErrors.IsConfirmTimeoutError(err))
+
+	var confirmTimeoutError *rabbitmqErrors.ConfirmTimeoutError
+	require.True(t, errors.As(err, &confirmTimeoutError))
+	assert.Equal(t, "1", confirmTimeoutError.MessageId)
+	assert.Equal(t, uint64(1), confirmTimeoutError.DeliveryTag)
+}
+
+func Test_Confirm_Channel_Should_Return_Unroutable_Message_Error(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeChannel()
+	channel, err := newConfirmChannel(fake)
+	require.NoError(t, err)
+
+	unroutable, err := channel.publish(ctx, "orders", "order_created", amqp091.Publishing{MessageId: "1"})
+	require.NoError(t, err)
+	routed, err := channel.publish(ctx, "orders", "order_created", amqp091.Publishing{MessageId: "2"})
+	require.NoError(t, err)
+
+	// the broker returns an unroutable mandatory message before acknowledging it
+	fake.returnMessage("orders", "order_created", "1")
+	fake.confirm(1, true)
+	fake.confirm(2, true)
+
+	err = unroutable.wait(ctx, time.Second)
+	require.True(t, rabbitmqErrors.IsUnroutableMessageError(err))
+
+	var unroutableMessageError *rabbitmqErrors.UnroutableMessageError
+	require.True(t, errors.As(err, &unroutableMessageError))
+	assert.Equal(t, "order_created", unroutableMessageError.RoutingKey)
+	assert.Equal(t, uint16(amqp091.NoRoute), unroutableMessageError.ReplyCode)
+
+	assert.NoError(t, routed.wait(ctx, time.Second))
+}
+
+func Test_Confirm_Channel_Should_Match_Returns_By_Delivery_Order(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeChannel()
+	channel, err := newConfirmChannel(fake)
+	require.NoError(t, err)
+
+	// the message ids are not unique between the publishes
+	routed, err := channel.publish(ctx, "orders", "order_created", amqp091.Publishing{MessageId: "1"})
+	require.NoError(t, err)
+	unroutable, err := channel.publish(ctx, "orders", "order_cancelled", amqp091.Publishing{MessageId: "1"})
+	require.NoError(t, err)
+
+	// the return of the second publish is received before the delayed confirmation of the first one
+	fake.returnMessage("orders", "order_cancelled", "1")
+	fake.confirm(1, true)
+	fake.confirm(2, true)
+
+	assert.NoError(t, routed.wait(ctx, time.Second))
+	assert.True(t, rabbitmqErrors.IsUnroutableMessageError(unroutable.wait(ctx, time.Second)))
+}
+
+func Test_Channel_Pool_Should_Replace_Closed_Channels(t *testing.T) {
+	ctx := context.Background()
+	var created []*fakeChannel
+	recreated := 0
+
+	pool := newChannelPool(
+		1,
+		func() (amqpChannel, error) {
+			fake := newFakeChannel()
+			created = append(created, fake)
+			return fake, nil
+		},
+		func() { recreated++ },
+	)
+
+	channel, err := pool.get()
+	require.NoError(t, err)
+
+	sameChannel, err := pool.get()
+	require.NoError(t, err)
+	assert.Same(t, channel, sameChannel)
+
+	pending, err := channel.publish(ctx, "orders", "orders", amqp091.Publishing{MessageId: "1"})
+	require.NoError(t, err)
+
+	require.NoError(t, created[0].Close())
+	assert.ErrorIs(t, pending.wait(ctx, time.Second), rabbitmqErrors.ErrChannelClosed)
+
+	newChannel, err := pool.get()
+	require.NoError(t, err)
+	assert.NotSame(t, channel, newChannel)
+	assert.Len(t, created, 2)
+	assert.Equal(t, 1, recreated)
+}
+
+type fakeChannel struct {
+	mu       sync.Mutex
+	seq      uint64
+	closed   bool
+	confirms chan amqp091.Confirmation
+	returns  chan amqp091.Return
+}
+
+func newFakeChannel() *fakeChannel {
+	return &fakeChannel{}
+}
+
+func (f *fakeChannel) Confirm(noWait bool) error {
+	return nil
+}
+
+func (f *fakeChannel) NotifyPublish(confirm chan amqp091.Confirmation) chan amqp091.Confirmation {
+	f.confirms = confirm
+	return confirm
+}
+
+func (f *fakeChannel) NotifyReturn(returns chan amqp091.Return) chan amqp091.Return {
+	f.returns = returns
+	return returns
+}
+
+func (f *fakeChannel) GetNextPublishSeqNo() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.seq + 1
+}
+
+func (f *fakeChannel) PublishWithContext(
+	ctx context.Context,
+	exchange string,
+	key string,
+	mandatory bool,
+	immediate bool,
+	msg amqp091.Publishing,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return amqp091.ErrClosed
+	}
+	f.seq++
+
+	return nil
+}
+
+func (f *fakeChannel) ExchangeDeclare(
+	name, kind string,
+	durable, autoDelete, internal, noWait bool,
+	args amqp091.Table,
+) error {
+	return nil
+}
+
+func (f *fakeChannel) QueueDeclare(
+	name string,
+	durable, autoDelete, exclusive, noWait bool,
+	args amqp091.Table,
+) (amqp091.Queue, error) {
+	return amqp091.Queue{Name: name}, nil
+}
+
+func (f *fakeChannel) QueueBind(name, key, exchange string, noWait bool, args amqp091.Table) error {
+	return nil
+}
+
+func (f *fakeChannel) IsClosed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.closed
+}
+
+func (f *fakeChannel) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.closed {
+		f.closed = true
+		close(f.confirms)
+		close(f.returns)
+	}
+
+	return nil
+}
+
+func (f *fakeChannel) returnMessage(exchange string, routingKey string, messageId string) {
+	f.returns <- amqp091.Return{
+		MessageId:  messageId,
+		Exchange:   exchange,
+		RoutingKey: routingKey,
+		ReplyCode:  amqp091.NoRoute,
+		ReplyText:  "NO_ROUTE",
+	}
+}
+
+func (f *fakeChannel) confirm(deliveryTag uint64, ack bool) {
+	f.confirms <- amqp091.Confirmation{DeliveryTag: deliveryTag, Ack: ack}
+}
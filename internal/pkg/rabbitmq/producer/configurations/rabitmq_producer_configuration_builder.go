@@ -18,6 +18,7 @@ type RabbitMQProducerConfigurationBuilder interface {
 	WithExpiration(expiration string) RabbitMQProducerConfigurationBuilder
 	WithReplyTo(replyTo string) RabbitMQProducerConfigurationBuilder
 	WithContentEncoding(contentEncoding string) RabbitMQProducerConfigurationBuilder
+	WithAlternateExchange(alternateExchange string) RabbitMQProducerConfigurationBuilder
 	Build() *RabbitMQProducerConfiguration
 }
 
@@ -117,6 +118,14 @@ func (b *rabbitMQProducerConfigurationBuilder) WithContentEncoding(
 	return b
 }
 
+// WithAlternateExchange declares an alternate exchange and a queue with the same name for keeping the unroutable messages of the exchange
+func (b *rabbitMQProducerConfigurationBuilder) WithAlternateExchange(
+	alternateExchange string,
+) RabbitMQProducerConfigurationBuilder {
+	b.rabbitmqProducerOptions.ExchangeOptions.AlternateExchange = alternateExchange
+	return b
+}
+
 func (b *rabbitMQProducerConfigurationBuilder) Build() *RabbitMQProducerConfiguration {
 	return b.rabbitmqProducerOptions
 }
@@ -8,4 +8,6 @@ type RabbitMQExchangeOptions struct {
 	AutoDelete bool
 	Durable    bool
 	Args       map[string]any
+	// AlternateExchange receives the messages which are unroutable in the exchange, instead of returning them to the producer
+	AlternateExchange string
 }
@@ -5,6 +5,8 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
 	serializer "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/metrics"
+	messagingMetrics "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/metrics/messaging"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/config"
 	producerConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/producer/configurations"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/producer/producercontracts"
@@ -16,6 +18,7 @@ type producerFactory struct {
 	logger          logger.Logger
 	eventSerializer serializer.MessageSerializer
 	rabbitmqOptions *config.RabbitmqOptions
+	appMetrics      metrics.AppMetrics
 }
 
 func NewProducerFactory(
@@ -23,8 +26,10 @@ func NewProducerFactory(
 	connection types2.IConnection,
 	eventSerializer serializer.MessageSerializer,
 	l logger.Logger,
+	appMetrics metrics.AppMetrics,
 ) producercontracts.ProducerFactory {
 	return &producerFactory{
+		appMetrics:      appMetrics,
 		rabbitmqOptions: rabbitmqOptions,
 		logger:          l,
 		connection:      connection,
@@ -36,11 +41,21 @@ func (p *producerFactory) CreateProducer(
 	rabbitmqProducersConfiguration map[string]*producerConfigurations.RabbitMQProducerConfiguration,
 	isProducedNotifications ...func(message types.IMessage),
 ) (producer.Producer, error) {
+	var unroutableMetric messagingMetrics.UnroutableMessagesMetric
+	if p.appMetrics != nil {
+		metric, err := messagingMetrics.NewUnroutableMessagesMetric(p.appMetrics)
+		if err != nil {
+			return nil, err
+		}
+		unroutableMetric = metric
+	}
+
 	return NewRabbitMQProducer(
 		p.rabbitmqOptions,
 		p.connection,
 		rabbitmqProducersConfiguration,
 		p.logger,
 		p.eventSerializer,
+		unroutableMetric,
 		isProducedNotifications...)
 }
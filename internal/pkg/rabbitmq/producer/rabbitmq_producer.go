@@ -2,6 +2,7 @@ package producer
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	messageHeader "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/messageheader"
@@ -12,8 +13,10 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/serializer"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	messagingMetrics "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/metrics/messaging"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/producer/configurations"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/rabbitmqErrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/types"
 
 	"emperror.dev/errors"
@@ -21,6 +24,12 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultChannelPoolSize = 4
+	defaultConfirmTimeout  = 5 * time.Second
 )
 
 type rabbitMQProducer struct {
@@ -30,6 +39,10 @@ type rabbitMQProducer struct {
 	messageSerializer       serializer.MessageSerializer
 	producersConfigurations map[string]*configurations.RabbitMQProducerConfiguration
 	isProducedNotifications []func(message types2.IMessage)
+	channelPool             *channelPool
+	confirmTimeout          time.Duration
+	declaredExchanges       sync.Map
+	unroutableMetric        messagingMetrics.UnroutableMessagesMetric
 }
 
 func NewRabbitMQProducer(
@@ -38,6 +51,7 @@ func NewRabbitMQProducer(
 	rabbitmqProducersConfiguration map[string]*configurations.RabbitMQProducerConfiguration,
 	logger logger.Logger,
 	eventSerializer serializer.MessageSerializer,
+	unroutableMetric messagingMetrics.UnroutableMessagesMetric,
 	isProducedNotifications ...func(message types2.IMessage),
 ) (producer.Producer, error) {
 	p := &rabbitMQProducer{
@@ -46,8 +60,33 @@ func NewRabbitMQProducer(
 		connection:              connection,
 		messageSerializer:       eventSerializer,
 		producersConfigurations: rabbitmqProducersConfiguration,
+		confirmTimeout:          cfg.ConfirmTimeout,
+		unroutableMetric:        unroutableMetric,
+	}
+
+	if p.confirmTimeout <= 0 {
+		p.confirmTimeout = defaultConfirmTimeout
 	}
 
+	channelPoolSize := cfg.ChannelPoolSize
+	if channelPoolSize <= 0 {
+		channelPoolSize = defaultChannelPoolSize
+	}
+
+	p.channelPool = newChannelPool(
+		channelPoolSize,
+		func() (amqpChannel, error) {
+			return connection.Channel()
+		},
+		// the exchanges should be declared again after a reconnection, because the broker could be restarted
+		func() {
+			p.declaredExchanges.Range(func(key, value any) bool {
+				p.declaredExchanges.Delete(key)
+				return true
+			})
+		},
+	)
+
 	p.isProducedNotifications = isProducedNotifications
 
 	return p, nil
@@ -78,6 +117,104 @@ func (r *rabbitMQProducer) PublishMessageWithTopicName(
 	meta metadata.Metadata,
 	topicOrExchangeName string,
 ) error {
+	return r.publish(ctx, []types2.IMessage{message}, meta, topicOrExchangeName)
+}
+
+// PublishMessages publishes the messages on a single channel and waits for all of their confirmations, so a batch costs one
+// round-trip instead of one per message
+func (r *rabbitMQProducer) PublishMessages(
+	ctx context.Context,
+	messages []types2.IMessage,
+	meta metadata.Metadata,
+) error {
+	return r.publish(ctx, messages, meta, "")
+}
+
+// Close closes the pooled channels of the producer
+func (r *rabbitMQProducer) Close() error {
+	return r.channelPool.close()
+}
+
+type pendingPublish struct {
+	message types2.IMessage
+	span    trace.Span
+	confirm *publishConfirm
+}
+
+func (r *rabbitMQProducer) publish(
+	ctx context.Context,
+	messages []types2.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	// https://github.com/rabbitmq/rabbitmq-tutorials/blob/master/go/publisher_confirms.go
+	if r.connection == nil {
+		return errors.New("connection is nil")
+	}
+
+	if r.connection.IsClosed() {
+		return errors.New("connection is closed, wait for connection alive")
+	}
+
+	// all messages of a batch are published on the same channel to keep their order
+	channel, err := r.channelPool.get()
+	if err != nil {
+		return err
+	}
+
+	var publishErr error
+	pendingPublishes := make([]*pendingPublish, 0, len(messages))
+
+	for _, message := range messages {
+		pending, err := r.publishMessage(ctx, channel, message, meta, topicOrExchangeName)
+		if err != nil {
+			publishErr = errors.Append(publishErr, err)
+			continue
+		}
+
+		pendingPublishes = append(pendingPublishes, pending)
+	}
+
+	for _, pending := range pendingPublishes {
+		err := pending.confirm.wait(ctx, r.confirmTimeout)
+		if rabbitmqErrors.IsUnroutableMessageError(err) && r.unroutableMetric != nil {
+			r.unroutableMetric.Record(
+				ctx,
+				pending.message.GetMessageTypeName(),
+				pending.confirm.exchange,
+				pending.confirm.routingKey,
+			)
+		}
+		if err != nil {
+			publishErr = errors.Append(publishErr, producer3.FinishProducerSpan(pending.span, err))
+			continue
+		}
+
+		if len(r.isProducedNotifications) > 0 {
+			for _, notification := range r.isProducedNotifications {
+				if notification != nil {
+					notification(pending.message)
+				}
+			}
+		}
+
+		_ = producer3.FinishProducerSpan(pending.span, nil)
+	}
+
+	return publishErr
+}
+
+func (r *rabbitMQProducer) publishMessage(
+	ctx context.Context,
+	channel *confirmChannel,
+	message types2.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) (*pendingPublish, error) {
 	producerConfiguration := r.getProducerConfigurationByMessage(message)
 
 	if producerConfiguration == nil {
@@ -116,7 +253,7 @@ func (r *rabbitMQProducer) PublishMessageWithTopicName(
 
 	serializedObj, err := r.messageSerializer.Serialize(message)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ctx, beforeProduceSpan := producer3.StartProducerSpan(
@@ -127,40 +264,11 @@ func (r *rabbitMQProducer) PublishMessageWithTopicName(
 		producerOptions,
 	)
 
-	// https://github.com/rabbitmq/rabbitmq-tutorials/blob/master/go/publisher_confirms.go
-	if r.connection == nil {
-		return producer3.FinishProducerSpan(
-			beforeProduceSpan,
-			errors.New("connection is nil"),
-		)
-	}
-
-	if r.connection.IsClosed() {
-		return producer3.FinishProducerSpan(
-			beforeProduceSpan,
-			errors.New("connection is closed, wait for connection alive"),
-		)
-	}
-
-	// create a unique channel on the connection and in the end close the channel
-	channel, err := r.connection.Channel()
-	if err != nil {
-		return producer3.FinishProducerSpan(beforeProduceSpan, err)
-	}
-	defer channel.Close()
-
 	err = r.ensureExchange(producerConfiguration, channel, exchange)
 	if err != nil {
-		return producer3.FinishProducerSpan(beforeProduceSpan, err)
+		return nil, producer3.FinishProducerSpan(beforeProduceSpan, err)
 	}
 
-	if err := channel.Confirm(false); err != nil {
-		return producer3.FinishProducerSpan(beforeProduceSpan, err)
-	}
-
-	confirms := make(chan amqp091.Confirmation)
-	channel.NotifyPublish(confirms)
-
 	props := amqp091.Publishing{
 		CorrelationId:   messageHeader.GetCorrelationId(meta),
 		MessageId:       message.GeMessageId(),
@@ -177,34 +285,17 @@ func (r *rabbitMQProducer) PublishMessageWithTopicName(
 		ContentEncoding: producerConfiguration.ContentEncoding,
 	}
 
-	err = channel.PublishWithContext(
-		ctx,
-		exchange,
-		routingKey,
-		true,
-		false,
-		props,
-	)
-	if err != nil {
-		return producer3.FinishProducerSpan(beforeProduceSpan, err)
-	}
-
-	if confirmed := <-confirms; !confirmed.Ack {
-		return producer3.FinishProducerSpan(
-			beforeProduceSpan,
-			errors.New("ack not confirmed"),
-		)
+	// the reply address of a request overrides the configured reply address
+	if replyTo := messageHeader.GetReplyTo(meta); replyTo != "" {
+		props.ReplyTo = replyTo
 	}
 
-	if len(r.isProducedNotifications) > 0 {
-		for _, notification := range r.isProducedNotifications {
-			if notification != nil {
-				notification(message)
-			}
-		}
+	confirm, err := channel.publish(ctx, exchange, routingKey, props)
+	if err != nil {
+		return nil, producer3.FinishProducerSpan(beforeProduceSpan, err)
 	}
 
-	return producer3.FinishProducerSpan(beforeProduceSpan, err)
+	return &pendingPublish{message: message, span: beforeProduceSpan, confirm: confirm}, nil
 }
 
 func (r *rabbitMQProducer) getMetadata(
@@ -234,23 +325,71 @@ func (r *rabbitMQProducer) getMetadata(
 	return meta
 }
 
+// ensureExchange declares the exchange once, the declared exchanges are cached until a channel is recreated
 func (r *rabbitMQProducer) ensureExchange(
 	producersConfigurations *configurations.RabbitMQProducerConfiguration,
-	channel *amqp091.Channel,
+	channel *confirmChannel,
 	exchangeName string,
 ) error {
-	err := channel.ExchangeDeclare(
+	if _, ok := r.declaredExchanges.Load(exchangeName); ok {
+		return nil
+	}
+
+	exchangeOptions := producersConfigurations.ExchangeOptions
+	args := exchangeOptions.Args
+
+	if exchangeOptions.AlternateExchange != "" {
+		err := r.declareAlternateExchange(channel, exchangeOptions.AlternateExchange, exchangeOptions.Durable)
+		if err != nil {
+			return err
+		}
+
+		args = amqp091.Table{}
+		for key, value := range exchangeOptions.Args {
+			args[key] = value
+		}
+		args["alternate-exchange"] = exchangeOptions.AlternateExchange
+	}
+
+	err := channel.channel.ExchangeDeclare(
 		exchangeName,
-		string(producersConfigurations.ExchangeOptions.Type),
-		producersConfigurations.ExchangeOptions.Durable,
-		producersConfigurations.ExchangeOptions.AutoDelete,
+		string(exchangeOptions.Type),
+		exchangeOptions.Durable,
+		exchangeOptions.AutoDelete,
 		false,
 		false,
-		producersConfigurations.ExchangeOptions.Args,
+		args,
 	)
 	if err != nil {
 		return err
 	}
 
+	r.declaredExchanges.Store(exchangeName, struct{}{})
+
+	return nil
+}
+
+// declareAlternateExchange declares a fanout alternate exchange with a queue for keeping the unroutable messages, without a
+// bound queue the alternate exchange drops the messages too
+func (r *rabbitMQProducer) declareAlternateExchange(
+	channel *confirmChannel,
+	alternateExchange string,
+	durable bool,
+) error {
+	err := channel.channel.ExchangeDeclare(alternateExchange, amqp091.ExchangeFanout, durable, false, false, false, nil)
+	if err != nil {
+		return errors.WrapIff(err, "failed to declare alternate exchange '%s'", alternateExchange)
+	}
+
+	_, err = channel.channel.QueueDeclare(alternateExchange, durable, false, false, false, nil)
+	if err != nil {
+		return errors.WrapIff(err, "failed to declare queue of alternate exchange '%s'", alternateExchange)
+	}
+
+	err = channel.channel.QueueBind(alternateExchange, "", alternateExchange, false, nil)
+	if err != nil {
+		return errors.WrapIff(err, "failed to bind queue of alternate exchange '%s'", alternateExchange)
+	}
+
 	return nil
 }
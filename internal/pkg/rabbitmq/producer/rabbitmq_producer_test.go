@@ -67,6 +67,7 @@ func Test_Publish_Message(t *testing.T) {
 		conn,
 		eventSerializer,
 		defaultLogger.GetLogger(),
+		nil,
 	)
 
 	rabbitmqProducer, err := producerFactory.CreateProducer(nil)
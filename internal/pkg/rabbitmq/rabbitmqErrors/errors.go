@@ -1,7 +1,78 @@
 package rabbitmqErrors
 
 import (
+	"fmt"
+	"time"
+
 	"emperror.dev/errors"
 )
 
-var ErrDisconnected = errors.New("disconnected from rabbitmq, trying to reconnect")
+var (
+	ErrDisconnected = errors.New("disconnected from rabbitmq, trying to reconnect")
+	// ErrChannelClosed is returned for the pending publish confirmations of a channel which is closed before receiving them
+	ErrChannelClosed = errors.New("rabbitmq channel closed before receiving the publish confirmation")
+)
+
+// ConfirmTimeoutError is returned when the broker doesn't confirm a published message within the confirm timeout, the message may
+// or may not be delivered
+type ConfirmTimeoutError struct {
+	MessageId   string
+	Exchange    string
+	DeliveryTag uint64
+	Timeout     time.Duration
+}
+
+func (e *ConfirmTimeoutError) Error() string {
+	return fmt.Sprintf(
+		"publish confirmation of message '%s' to exchange '%s' timed out after %s",
+		e.MessageId,
+		e.Exchange,
+		e.Timeout,
+	)
+}
+
+// PublishNackedError is returned when the broker negatively acknowledges a published message
+type PublishNackedError struct {
+	MessageId   string
+	Exchange    string
+	DeliveryTag uint64
+}
+
+func (e *PublishNackedError) Error() string {
+	return fmt.Sprintf("publish of message '%s' to exchange '%s' is not acknowledged by the broker", e.MessageId, e.Exchange)
+}
+
+func IsConfirmTimeoutError(err error) bool {
+	var confirmTimeoutError *ConfirmTimeoutError
+	return errors.As(err, &confirmTimeoutError)
+}
+
+func IsPublishNackedError(err error) bool {
+	var publishNackedError *PublishNackedError
+	return errors.As(err, &publishNackedError)
+}
+
+// UnroutableMessageError is returned when the broker returns a mandatory message because no queue is bound for its routing key
+type UnroutableMessageError struct {
+	MessageId  string
+	Exchange   string
+	RoutingKey string
+	ReplyCode  uint16
+	ReplyText  string
+}
+
+func (e *UnroutableMessageError) Error() string {
+	return fmt.Sprintf(
+		"message '%s' to exchange '%s' with routing key '%s' is unroutable: %d %s",
+		e.MessageId,
+		e.Exchange,
+		e.RoutingKey,
+		e.ReplyCode,
+		e.ReplyText,
+	)
+}
+
+func IsUnroutableMessageError(err error) bool {
+	var unroutableMessageError *UnroutableMessageError
+	return errors.As(err, &unroutableMessageError)
+}
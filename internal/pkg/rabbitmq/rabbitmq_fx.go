@@ -44,7 +44,10 @@ var (
 			fx.As(new(bus.RabbitmqBus)),
 		)),
 		fx.Provide(rabbitmqconsumer.NewConsumerFactory),
-		fx.Provide(rabbitmqproducer.NewProducerFactory),
+		fx.Provide(fx.Annotate(
+			rabbitmqproducer.NewProducerFactory,
+			fx.ParamTags(``, ``, ``, ``, `optional:"true"`),
+		)),
 		fx.Provide(fx.Annotate(
 			NewRabbitMQHealthChecker,
 			fx.As(new(contracts.Health)),
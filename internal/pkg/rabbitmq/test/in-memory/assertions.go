@@ -0,0 +1,93 @@
+package in_memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+)
+
+const (
+	defaultAssertionTimeout = 5 * time.Second
+	assertionPollInterval   = 10 * time.Millisecond
+)
+
+// ShouldPublish waits for a published message of type T which satisfies the condition, a nil condition matches any message of type T
+func ShouldPublish[T types.IMessage](
+	t testing.TB,
+	bus *RabbitmqInMemoryHarnesses,
+	condition func(T) bool,
+	timeout ...time.Duration,
+) T {
+	t.Helper()
+
+	return waitForMessage(t, "published", bus.PublishedMessages, condition, timeout...)
+}
+
+// ShouldConsume waits for a message of type T which is acknowledged by its consumers and satisfies the condition
+func ShouldConsume[T types.IMessage](
+	t testing.TB,
+	bus *RabbitmqInMemoryHarnesses,
+	condition func(T) bool,
+	timeout ...time.Duration,
+) T {
+	t.Helper()
+
+	return waitForMessage(t, "consumed", bus.ConsumedMessages, condition, timeout...)
+}
+
+// ShouldDeadLetter waits for a message of type T which is moved to the dead-letter queue and satisfies the condition
+func ShouldDeadLetter[T types.IMessage](
+	t testing.TB,
+	bus *RabbitmqInMemoryHarnesses,
+	condition func(T) bool,
+	timeout ...time.Duration,
+) T {
+	t.Helper()
+
+	return waitForMessage(t, "dead-lettered", bus.DeadLetteredMessages, condition, timeout...)
+}
+
+func waitForMessage[T types.IMessage](
+	t testing.TB,
+	state string,
+	messages func() []types.IMessage,
+	condition func(T) bool,
+	timeout ...time.Duration,
+) T {
+	t.Helper()
+
+	wait := defaultAssertionTimeout
+	if len(timeout) > 0 {
+		wait = timeout[0]
+	}
+
+	deadline := time.Now().Add(wait)
+	for {
+		if message, ok := findMessage(messages(), condition); ok {
+			return message
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("no %s message of type '%T' satisfied the condition in %s", state, *new(T), wait)
+			return *new(T)
+		}
+
+		time.Sleep(assertionPollInterval)
+	}
+}
+
+func findMessage[T types.IMessage](messages []types.IMessage, condition func(T) bool) (T, bool) {
+	for _, message := range messages {
+		m, ok := message.(T)
+		if !ok {
+			continue
+		}
+
+		if condition == nil || condition(m) {
+			return m, true
+		}
+	}
+
+	return *new(T), false
+}
@@ -0,0 +1,295 @@
+//go:build unit
+// +build unit
+
+package in_memory
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	coreBus "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/pipeline"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/configurations"
+	consumerConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/configurations"
+	producerConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/producer/configurations"
+	rabbitmqTypes "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/types"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type OrderPlaced struct {
+	*types.Message
+	OrderId string
+}
+
+func newOrderPlaced(orderId string) *OrderPlaced {
+	return &OrderPlaced{Message: types.NewMessage(uuid.NewV4().String()), OrderId: orderId}
+}
+
+type testHandler struct {
+	failures atomic.Int32
+	handled  atomic.Int32
+}
+
+func (h *testHandler) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	h.handled.Add(1)
+	if h.failures.Load() > 0 {
+		h.failures.Add(-1)
+		return errors.New("handler failed")
+	}
+
+	return nil
+}
+
+type countingPipeline struct {
+	calls atomic.Int32
+}
+
+func (p *countingPipeline) Handle(
+	ctx context.Context,
+	consumeContext types.MessageConsumeContext,
+	next pipeline.ConsumerHandlerFunc,
+) error {
+	p.calls.Add(1)
+	return next(ctx)
+}
+
+func startBus(t *testing.T, builderFunc configurations.RabbitMQConfigurationBuilderFuc) *RabbitmqInMemoryHarnesses {
+	t.Helper()
+
+	bus := NewRabbitmqInMemoryHarnesses(empty.EmptyLogger, builderFunc)
+	require.NoError(t, bus.Start(context.Background()))
+	t.Cleanup(func() { _ = bus.Stop() })
+
+	return bus
+}
+
+func Test_Should_Deliver_Published_Message_Through_Pipelines_And_Handlers(t *testing.T) {
+	handler := &testHandler{}
+	pipe := &countingPipeline{}
+
+	bus := startBus(t, func(builder configurations.RabbitMQConfigurationBuilder) {
+		builder.AddConsumer(
+			&OrderPlaced{},
+			func(consumerBuilder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+				consumerBuilder.
+					WIthPipelines(func(pipelineBuilder pipeline.ConsumerPipelineConfigurationBuilder) {
+						pipelineBuilder.AddPipeline(pipe)
+					}).
+					WithHandlers(func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
+						handlersBuilder.AddHandler(handler)
+					})
+			},
+		)
+	})
+
+	require.NoError(t, bus.PublishMessage(context.Background(), newOrderPlaced("1"), nil))
+
+	ShouldPublish[*OrderPlaced](t, bus, nil)
+	consumed := ShouldConsume[*OrderPlaced](t, bus, func(message *OrderPlaced) bool {
+		return message.OrderId == "1"
+	})
+
+	assert.Equal(t, "1", consumed.OrderId)
+	assert.Equal(t, int32(1), handler.handled.Load())
+	assert.Equal(t, int32(1), pipe.calls.Load())
+	assert.Empty(t, bus.UnroutableMessages())
+}
+
+func Test_Should_Route_By_Topic_Routing_Key(t *testing.T) {
+	matched := &testHandler{}
+	unmatched := &testHandler{}
+
+	bus := startBus(t, func(builder configurations.RabbitMQConfigurationBuilder) {
+		builder.AddProducer(
+			&OrderPlaced{},
+			func(producerBuilder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+				producerBuilder.WithRoutingKey("orders.eu.placed")
+			},
+		)
+		builder.AddConsumer(
+			&OrderPlaced{},
+			func(consumerBuilder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+				consumerBuilder.
+					WithExchangeName("orders").
+					WithExchangeType(rabbitmqTypes.ExchangeTopic).
+					WithRoutingKey("orders.#").
+					WithQueueName("orders_all").
+					WithHandlers(func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
+						handlersBuilder.AddHandler(matched)
+					})
+			},
+		)
+		builder.AddConsumer(
+			&OrderPlaced{},
+			func(consumerBuilder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+				consumerBuilder.
+					WithExchangeName("orders").
+					WithExchangeType(rabbitmqTypes.ExchangeTopic).
+					WithRoutingKey("orders.*.cancelled").
+					WithQueueName("orders_cancelled").
+					WithHandlers(func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
+						handlersBuilder.AddHandler(unmatched)
+					})
+			},
+		)
+	})
+
+	require.NoError(t, bus.PublishMessageWithTopicName(context.Background(), newOrderPlaced("1"), nil, "orders"))
+
+	ShouldConsume[*OrderPlaced](t, bus, nil)
+	assert.Equal(t, int32(1), matched.handled.Load())
+	assert.Equal(t, int32(0), unmatched.handled.Load())
+
+	require.NoError(t, bus.PublishMessageWithTopicName(context.Background(), newOrderPlaced("2"), nil, "payments"))
+	assert.Len(t, bus.UnroutableMessages(), 1)
+}
+
+func Test_Should_Redeliver_Failed_Message(t *testing.T) {
+	handler := &testHandler{}
+	handler.failures.Store(2)
+
+	bus := startBus(t, nil)
+	require.NoError(t, bus.ConnectConsumerHandler(&OrderPlaced{}, handler))
+	require.NoError(t, bus.PublishMessage(context.Background(), newOrderPlaced("1"), nil))
+
+	ShouldConsume[*OrderPlaced](t, bus, nil)
+	assert.Equal(t, int32(3), handler.handled.Load())
+	assert.Empty(t, bus.DeadLetteredMessages())
+}
+
+func Test_Should_Dead_Letter_Message_After_Retries(t *testing.T) {
+	handler := &testHandler{}
+	handler.failures.Store(100)
+
+	bus := startBus(t, func(builder configurations.RabbitMQConfigurationBuilder) {
+		builder.AddConsumer(
+			&OrderPlaced{},
+			func(consumerBuilder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+				consumerBuilder.
+					WithDeadLetter("", "").
+					WithDelayedRetries(time.Second, 2*time.Second).
+					WithHandlers(func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
+						handlersBuilder.AddHandler(handler)
+					})
+			},
+		)
+	})
+
+	require.NoError(t, bus.PublishMessage(context.Background(), newOrderPlaced("1"), nil))
+
+	ShouldDeadLetter[*OrderPlaced](t, bus, nil)
+	assert.Equal(t, int32(3), handler.handled.Load())
+	assert.Empty(t, bus.ConsumedMessages())
+}
+
+func Test_Should_Dead_Letter_Message_After_Retries_Without_Dead_Letter_Options(t *testing.T) {
+	handler := &testHandler{}
+	handler.failures.Store(100)
+
+	bus := startBus(t, func(builder configurations.RabbitMQConfigurationBuilder) {
+		builder.AddConsumer(
+			&OrderPlaced{},
+			func(consumerBuilder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+				consumerBuilder.
+					WithDelayedRetries(time.Second).
+					WithHandlers(func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
+						handlersBuilder.AddHandler(handler)
+					})
+			},
+		)
+	})
+
+	require.NoError(t, bus.PublishMessage(context.Background(), newOrderPlaced("1"), nil))
+
+	// like the rabbitmq consumer, the exhausted message moves to the default dead-letter queue instead of being discarded
+	ShouldDeadLetter[*OrderPlaced](t, bus, nil)
+	assert.Equal(t, int32(2), handler.handled.Load())
+	assert.Empty(t, bus.DiscardedMessages())
+}
+
+func Test_Should_Deliver_Messages_Published_Before_Start(t *testing.T) {
+	handler := &testHandler{}
+
+	bus := NewRabbitmqInMemoryHarnesses(empty.EmptyLogger, nil)
+	require.NoError(t, bus.ConnectConsumerHandler(&OrderPlaced{}, handler))
+	require.NoError(t, bus.PublishMessages(
+		context.Background(),
+		[]types.IMessage{newOrderPlaced("1"), newOrderPlaced("2")},
+		nil,
+	))
+
+	require.NoError(t, bus.Start(context.Background()))
+	defer bus.Stop()
+
+	ShouldConsume[*OrderPlaced](t, bus, func(message *OrderPlaced) bool { return message.OrderId == "2" })
+	assert.Len(t, bus.ConsumedMessages(), 2)
+}
+
+type OrderPlacedAccepted struct {
+	*types.Message
+	OrderId string
+}
+
+type respondingHandler struct{}
+
+func (h *respondingHandler) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	request := consumeContext.Message().(*OrderPlaced)
+
+	return consumeContext.Respond(ctx, &OrderPlacedAccepted{
+		Message: types.NewMessage(uuid.NewV4().String()),
+		OrderId: request.OrderId,
+	})
+}
+
+func Test_Should_Respond_To_Request(t *testing.T) {
+	bus := startBus(t, nil)
+	require.NoError(t, bus.ConnectConsumerHandler(&OrderPlaced{}, &respondingHandler{}))
+
+	response, err := coreBus.Request[*OrderPlaced, *OrderPlacedAccepted](
+		context.Background(),
+		bus,
+		newOrderPlaced("1"),
+		time.Second,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "1", response.OrderId)
+}
+
+func Test_Request_Should_Time_Out_Without_Response(t *testing.T) {
+	bus := startBus(t, nil)
+	require.NoError(t, bus.ConnectConsumerHandler(&OrderPlaced{}, &testHandler{}))
+
+	_, err := bus.Request(context.Background(), newOrderPlaced("1"), nil, 50*time.Millisecond)
+
+	assert.True(t, coreBus.IsRequestTimeoutError(err))
+}
+
+func Test_Respond_Should_Fail_For_Published_Message(t *testing.T) {
+	bus := startBus(t, func(builder configurations.RabbitMQConfigurationBuilder) {
+		builder.AddConsumer(
+			&OrderPlaced{},
+			func(consumerBuilder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+				consumerBuilder.
+					WithDeadLetter("", "").
+					WithHandlers(func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
+						handlersBuilder.AddHandler(&respondingHandler{})
+					})
+			},
+		)
+	})
+
+	require.NoError(t, bus.PublishMessage(context.Background(), newOrderPlaced("1"), nil))
+
+	// the handler can't respond to a message without a reply address, and it moves to the dead-letter queue
+	ShouldDeadLetter[*OrderPlaced](t, bus, nil)
+}
@@ -0,0 +1,264 @@
+package in_memory
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/messageheader"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/pipeline"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	rabbitmqConsumer "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer"
+	consumerConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/configurations"
+	rabbitmqTypes "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/types"
+)
+
+// inMemoryReplyTo is the reply address of the in-memory requests
+const inMemoryReplyTo = "in-memory.reply"
+
+type delivery struct {
+	message      types.IMessage
+	meta         metadata.Metadata
+	deliveryTag  uint64
+	redeliveries int
+}
+
+// inMemoryQueue is the queue of a consumer configuration, it is bound to the consumer exchange with the consumer routing key
+type inMemoryQueue struct {
+	mu            sync.Mutex
+	configuration *consumerConfigurations.RabbitMQConsumerConfiguration
+	handlers      []consumer.ConsumerHandler
+	deliveries    []*delivery
+	deliveryTag   uint64
+	signal        chan struct{}
+	bus           *RabbitmqInMemoryHarnesses
+}
+
+func newInMemoryQueue(
+	configuration *consumerConfigurations.RabbitMQConsumerConfiguration,
+	bus *RabbitmqInMemoryHarnesses,
+) *inMemoryQueue {
+	return &inMemoryQueue{
+		configuration: configuration,
+		handlers:      append([]consumer.ConsumerHandler(nil), configuration.Handlers...),
+		signal:        make(chan struct{}, 1),
+		bus:           bus,
+	}
+}
+
+func (q *inMemoryQueue) connectHandler(handler consumer.ConsumerHandler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.handlers = append(q.handlers, handler)
+}
+
+// isBound checks the message is routed to the queue by its exchange and routing key, the consumer deserializes just its own message type
+func (q *inMemoryQueue) isBound(message types.IMessage, exchange string, routingKey string) bool {
+	if utils.GetMessageBaseReflectType(message) != q.configuration.ConsumerMessageType {
+		return false
+	}
+
+	if exchange != q.configuration.ExchangeOptions.Name {
+		return false
+	}
+
+	switch q.configuration.ExchangeOptions.Type {
+	case rabbitmqTypes.ExchangeFanout:
+		return true
+	case rabbitmqTypes.ExchangeDirect:
+		return routingKey == q.configuration.BindingOptions.RoutingKey
+	default:
+		return topicMatches(
+			strings.Split(q.configuration.BindingOptions.RoutingKey, "."),
+			strings.Split(routingKey, "."),
+		)
+	}
+}
+
+func (q *inMemoryQueue) enqueue(d *delivery) {
+	q.mu.Lock()
+	if d.deliveryTag == 0 {
+		q.deliveryTag++
+		d.deliveryTag = q.deliveryTag
+	}
+	q.deliveries = append(q.deliveries, d)
+	q.mu.Unlock()
+
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (q *inMemoryQueue) dequeue() (*delivery, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.deliveries) == 0 {
+		return nil, false
+	}
+
+	d := q.deliveries[0]
+	q.deliveries = q.deliveries[1:]
+
+	return d, true
+}
+
+func (q *inMemoryQueue) consume(ctx context.Context) {
+	for {
+		d, ok := q.dequeue()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-q.signal:
+				continue
+			}
+		}
+
+		q.handle(ctx, d)
+
+		// other workers of the queue could be waiting for the remaining deliveries
+		q.mu.Lock()
+		remaining := len(q.deliveries)
+		q.mu.Unlock()
+		if remaining > 0 {
+			select {
+			case q.signal <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// handle runs the pipelines and handlers of the consumer, and acks, redelivers or dead-letters the message like the rabbitmq consumer
+func (q *inMemoryQueue) handle(ctx context.Context, d *delivery) {
+	consumeContext := types.NewMessageConsumeContext(
+		d.message,
+		d.meta,
+		messageHeader.GetMessageContentType(d.meta),
+		messageHeader.GetMessageType(d.meta),
+		messageHeader.GetMessageCreated(d.meta),
+		d.deliveryTag,
+		messageHeader.GetMessageId(d.meta),
+		messageHeader.GetCorrelationId(d.meta),
+	)
+
+	if replyTo := messageHeader.GetReplyTo(d.meta); replyTo != "" {
+		correlationId := consumeContext.CorrelationId()
+		consumeContext = types.NewRequestConsumeContext(
+			consumeContext,
+			replyTo,
+			func(ctx context.Context, response types.IMessage) error {
+				q.bus.respond(correlationId, response)
+				return nil
+			},
+		)
+	}
+
+	q.mu.Lock()
+	handlers := append([]consumer.ConsumerHandler(nil), q.handlers...)
+	q.mu.Unlock()
+
+	var err error
+	for _, handler := range handlers {
+		err = q.runHandler(ctx, handler, consumeContext)
+		if err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		q.bus.acked(d.message)
+		return
+	}
+
+	q.bus.logger.Errorf(
+		"[inMemoryQueue.handle] error in handling message '%s' by consumer '%s': %v",
+		consumeContext.MessageId(),
+		q.configuration.Name,
+		err,
+	)
+
+	// with the auto-ack the message is removed from the queue before handling it, and the failed message is lost
+	if q.configuration.AutoAck {
+		q.bus.rejected(d.message, false)
+		return
+	}
+
+	switch rabbitmqConsumer.GetFailureAction(q.configuration, d.redeliveries) {
+	case rabbitmqConsumer.RetryFailure:
+		meta := metadata.FromMetadata(d.meta)
+		meta.Set(rabbitmqConsumer.RetryCountHeader, d.redeliveries+1)
+
+		q.enqueue(&delivery{
+			message:      d.message,
+			meta:         meta,
+			redeliveries: d.redeliveries + 1,
+		})
+	case rabbitmqConsumer.DeadLetterFailure:
+		q.bus.rejected(d.message, true)
+	default:
+		// like the nack of the rabbitmq consumer, the message is requeued until a handler succeeds
+		q.enqueue(&delivery{
+			message:      d.message,
+			meta:         d.meta,
+			redeliveries: d.redeliveries,
+		})
+	}
+}
+
+func (q *inMemoryQueue) runHandler(
+	ctx context.Context,
+	handler consumer.ConsumerHandler,
+	consumeContext types.MessageConsumeContext,
+) error {
+	var next pipeline.ConsumerHandlerFunc = func(ctx context.Context) error {
+		return handler.Handle(ctx, consumeContext)
+	}
+
+	pipelines := q.configuration.Pipelines
+	for i := len(pipelines) - 1; i >= 0; i-- {
+		pipe := pipelines[i]
+		nextHandler := next
+		next = func(ctx context.Context) error {
+			return pipe.Handle(ctx, consumeContext, nextHandler)
+		}
+	}
+
+	return next(ctx)
+}
+
+func (q *inMemoryQueue) concurrency() int {
+	if q.configuration.ConcurrencyLimit <= 0 {
+		return 1
+	}
+
+	return q.configuration.ConcurrencyLimit
+}
+
+// topicMatches matches the routing key words with a topic binding, `*` matches one word and `#` matches zero or more words
+func topicMatches(pattern []string, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if topicMatches(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+
+		return false
+	case "*":
+		return len(words) > 0 && topicMatches(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && topicMatches(pattern[1:], words[1:])
+	}
+}
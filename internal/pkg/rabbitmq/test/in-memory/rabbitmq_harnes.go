@@ -2,20 +2,75 @@ package in_memory
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	coreBus "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
 	consumer2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/messageheader"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/utils"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/bus"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/configurations"
+	consumerConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/configurations"
+	producerConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/producer/configurations"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
 )
 
+// RabbitmqInMemoryHarnesses is an in-process `bus.Bus` for the tests. It uses the rabbitmq configurations of the bus for routing
+// the published messages to the consumers by their message type, exchange and routing key, runs the consumer pipelines and
+// handlers, and mimics the ack, nack and redelivery of the rabbitmq consumer.
 type RabbitmqInMemoryHarnesses struct {
-	publishedMessage []types.IMessage
-	consumedMessage  []types.IMessage
-	consumerHandlers map[types.IMessage][]consumer2.ConsumerHandler
+	mu                      sync.RWMutex
+	logger                  logger.Logger
+	producersConfigurations map[string]*producerConfigurations.RabbitMQProducerConfiguration
+	queues                  []*inMemoryQueue
+	publishedMessage        []types.IMessage
+	consumedMessage         []types.IMessage
+	deadLetteredMessage     []types.IMessage
+	discardedMessage        []types.IMessage
+	unroutableMessage       []types.IMessage
+	isProducedNotifications []func(message types.IMessage)
+	isConsumedNotifications []func(message types.IMessage)
+	// pendingRequests are the requests which are waiting for their responses by their correlation ids
+	pendingRequests map[string]chan types.IMessage
+	// ctx is not nil after starting the bus
+	ctx     context.Context
+	cancel  context.CancelFunc
+	workers sync.WaitGroup
 }
 
-func NewRabbitmqInMemoryHarnesses() *RabbitmqInMemoryHarnesses {
-	return &RabbitmqInMemoryHarnesses{}
+var _ bus.RabbitmqBus = (*RabbitmqInMemoryHarnesses)(nil)
+
+func NewRabbitmqInMemoryHarnesses(
+	logger logger.Logger,
+	rabbitmqBuilderFunc configurations.RabbitMQConfigurationBuilderFuc,
+) *RabbitmqInMemoryHarnesses {
+	builder := configurations.NewRabbitMQConfigurationBuilder()
+	if rabbitmqBuilderFunc != nil {
+		rabbitmqBuilderFunc(builder)
+	}
+	rabbitmqConfiguration := builder.Build()
+
+	r := &RabbitmqInMemoryHarnesses{
+		logger:                  logger,
+		producersConfigurations: make(map[string]*producerConfigurations.RabbitMQProducerConfiguration),
+		pendingRequests:         make(map[string]chan types.IMessage),
+	}
+
+	for _, producerConfiguration := range rabbitmqConfiguration.ProducersConfigurations {
+		r.producersConfigurations[producerConfiguration.ProducerMessageType.String()] = producerConfiguration
+	}
+
+	for _, consumerConfiguration := range rabbitmqConfiguration.ConsumersConfigurations {
+		r.queues = append(r.queues, newInMemoryQueue(consumerConfiguration, r))
+	}
+
+	return r
 }
 
 func (r *RabbitmqInMemoryHarnesses) PublishMessage(
@@ -23,53 +78,360 @@ func (r *RabbitmqInMemoryHarnesses) PublishMessage(
 	message types.IMessage,
 	meta metadata.Metadata,
 ) error {
-	r.publishedMessage = append(r.publishedMessage, message)
+	return r.PublishMessageWithTopicName(ctx, message, meta, "")
+}
+
+func (r *RabbitmqInMemoryHarnesses) PublishMessages(
+	ctx context.Context,
+	messages []types.IMessage,
+	meta metadata.Metadata,
+) error {
+	for _, message := range messages {
+		err := r.PublishMessageWithTopicName(ctx, message, meta, "")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// PublishMessageWithTopicName routes the message to the bound queues, an unroutable message is kept in the `UnroutableMessages`
+// instead of failing the publish, because the queues of the other services are not bound in the tests
 func (r *RabbitmqInMemoryHarnesses) PublishMessageWithTopicName(
 	ctx context.Context,
 	message types.IMessage,
 	meta metadata.Metadata,
 	topicOrExchangeName string,
 ) error {
+	if message == nil {
+		return errors.New("message is nil")
+	}
+
+	exchange, routingKey := r.getDestination(message, topicOrExchangeName)
+	meta = r.getMetadata(message, meta)
+
+	r.mu.Lock()
 	r.publishedMessage = append(r.publishedMessage, message)
+
+	var routed bool
+	for _, queue := range r.queues {
+		if queue.isBound(message, exchange, routingKey) {
+			routed = true
+			queue.enqueue(&delivery{message: message, meta: metadata.FromMetadata(meta)})
+		}
+	}
+
+	if !routed {
+		r.unroutableMessage = append(r.unroutableMessage, message)
+	}
+	notifications := append([]func(message types.IMessage){}, r.isProducedNotifications...)
+	r.mu.Unlock()
+
+	for _, notification := range notifications {
+		if notification != nil {
+			notification(message)
+		}
+	}
+
 	return nil
 }
 
-func (r *RabbitmqInMemoryHarnesses) IsProduced(f func(message types.IMessage)) {
+// Request publishes the request like the rabbitmq bus, its consumer responds to the in-memory reply address
+func (r *RabbitmqInMemoryHarnesses) Request(
+	ctx context.Context,
+	request types.IMessage,
+	meta metadata.Metadata,
+	timeout time.Duration,
+) (types.IMessage, error) {
+	if timeout <= 0 {
+		timeout = coreBus.DefaultRequestTimeout
+	}
+
+	correlationId := uuid.NewV4().String()
+
+	meta = metadata.FromMetadata(meta)
+	messageHeader.SetCorrelationId(meta, correlationId)
+	messageHeader.SetReplyTo(meta, inMemoryReplyTo)
+
+	reply := make(chan types.IMessage, 1)
+	r.mu.Lock()
+	r.pendingRequests[correlationId] = reply
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		delete(r.pendingRequests, correlationId)
+		r.mu.Unlock()
+	}()
+
+	err := r.PublishMessage(ctx, request, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case response := <-reply:
+		return response, nil
+	case <-timer.C:
+		return nil, &coreBus.RequestTimeoutError{
+			MessageId:     request.GeMessageId(),
+			CorrelationId: correlationId,
+			Timeout:       timeout,
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *RabbitmqInMemoryHarnesses) IsProduced(h func(message types.IMessage)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.isProducedNotifications = append(r.isProducedNotifications, h)
 }
 
-func (r *RabbitmqInMemoryHarnesses) AddMessageConsumedHandler(f func(message types.IMessage)) {
+func (r *RabbitmqInMemoryHarnesses) IsConsumed(h func(message types.IMessage)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.isConsumedNotifications = append(r.isConsumedNotifications, h)
 }
 
+// Start starts consuming the queues, the messages which are published before starting stay in the queues
 func (r *RabbitmqInMemoryHarnesses) Start(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ctx != nil {
+		return nil
+	}
+
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	for _, queue := range r.queues {
+		r.startQueue(queue)
+	}
+
 	return nil
 }
 
-func (r *RabbitmqInMemoryHarnesses) Stop(ctx context.Context) error {
+// Stop stops consuming and waits for the running handlers
+func (r *RabbitmqInMemoryHarnesses) Stop() error {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.ctx = nil
+	r.cancel = nil
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	r.workers.Wait()
+
 	return nil
 }
 
+// ConnectConsumerHandler adds the handler to the queues of the message type, and creates a new queue if there is no queue for it
 func (r *RabbitmqInMemoryHarnesses) ConnectConsumerHandler(
 	messageType types.IMessage,
 	consumerHandler consumer2.ConsumerHandler,
 ) error {
-	r.consumerHandlers[messageType] = append(r.consumerHandlers[messageType], consumerHandler)
-	return nil
+	typ := utils.GetMessageBaseReflectType(messageType)
+
+	r.mu.Lock()
+	var connected bool
+	for _, queue := range r.queues {
+		if queue.configuration.ConsumerMessageType == typ {
+			queue.connectHandler(consumerHandler)
+			connected = true
+		}
+	}
+	r.mu.Unlock()
+
+	if connected {
+		return nil
+	}
+
+	return r.ConnectRabbitMQConsumer(
+		messageType,
+		func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+			builder.WithHandlers(func(handlersBuilder consumer2.ConsumerHandlerConfigurationBuilder) {
+				handlersBuilder.AddHandler(consumerHandler)
+			})
+		},
+	)
 }
 
+// ConnectConsumer is not supported, because a consumer can't receive the in-memory deliveries
 func (r *RabbitmqInMemoryHarnesses) ConnectConsumer(
 	messageType types.IMessage,
 	consumer consumer2.Consumer,
 ) error {
+	return errors.New(
+		"in-memory bus doesn't support external consumers, use `ConnectConsumerHandler` or `ConnectRabbitMQConsumer`",
+	)
+}
+
+func (r *RabbitmqInMemoryHarnesses) ConnectRabbitMQConsumer(
+	messageType types.IMessage,
+	consumerBuilderFunc consumerConfigurations.RabbitMQConsumerConfigurationBuilderFuc,
+) error {
+	builder := consumerConfigurations.NewRabbitMQConsumerConfigurationBuilder(messageType)
+	if consumerBuilderFunc != nil {
+		consumerBuilderFunc(builder)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue := newInMemoryQueue(builder.Build(), r)
+	r.queues = append(r.queues, queue)
+
+	if r.ctx != nil {
+		r.startQueue(queue)
+	}
+
 	return nil
 }
 
 func (r *RabbitmqInMemoryHarnesses) PublishedMessages() []types.IMessage {
-	return r.publishedMessage
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]types.IMessage(nil), r.publishedMessage...)
 }
 
+// ConsumedMessages are the messages which are acknowledged by their consumers
 func (r *RabbitmqInMemoryHarnesses) ConsumedMessages() []types.IMessage {
-	return r.consumedMessage
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]types.IMessage(nil), r.consumedMessage...)
+}
+
+// DeadLetteredMessages are the messages which moved to the dead-letter queue of their consumers after the retries
+func (r *RabbitmqInMemoryHarnesses) DeadLetteredMessages() []types.IMessage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]types.IMessage(nil), r.deadLetteredMessage...)
+}
+
+// DiscardedMessages are the failed messages of the auto-ack consumers, which are lost like the rabbitmq consumer
+func (r *RabbitmqInMemoryHarnesses) DiscardedMessages() []types.IMessage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]types.IMessage(nil), r.discardedMessage...)
+}
+
+// UnroutableMessages are the published messages which no queue is bound for them
+func (r *RabbitmqInMemoryHarnesses) UnroutableMessages() []types.IMessage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]types.IMessage(nil), r.unroutableMessage...)
+}
+
+// startQueue should be called under the lock
+func (r *RabbitmqInMemoryHarnesses) startQueue(queue *inMemoryQueue) {
+	ctx := r.ctx
+	for i := 0; i < queue.concurrency(); i++ {
+		r.workers.Add(1)
+		go func() {
+			defer r.workers.Done()
+			queue.consume(ctx)
+		}()
+	}
+}
+
+func (r *RabbitmqInMemoryHarnesses) acked(message types.IMessage) {
+	r.mu.Lock()
+	r.consumedMessage = append(r.consumedMessage, message)
+	notifications := append([]func(message types.IMessage){}, r.isConsumedNotifications...)
+	r.mu.Unlock()
+
+	for _, notification := range notifications {
+		if notification != nil {
+			notification(message)
+		}
+	}
+}
+
+// respond hands the response to its waiting request, the responses of the timed out requests are dropped like the rabbitmq replies
+func (r *RabbitmqInMemoryHarnesses) respond(correlationId string, response types.IMessage) {
+	r.mu.Lock()
+	reply, ok := r.pendingRequests[correlationId]
+	delete(r.pendingRequests, correlationId)
+	r.mu.Unlock()
+
+	if ok {
+		reply <- response
+	}
+}
+
+func (r *RabbitmqInMemoryHarnesses) rejected(message types.IMessage, deadLettered bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if deadLettered {
+		r.deadLetteredMessage = append(r.deadLetteredMessage, message)
+		return
+	}
+
+	r.discardedMessage = append(r.discardedMessage, message)
+}
+
+// getDestination gets the exchange and routing key of the message like the rabbitmq producer
+func (r *RabbitmqInMemoryHarnesses) getDestination(
+	message types.IMessage,
+	topicOrExchangeName string,
+) (string, string) {
+	producerConfiguration := r.producersConfigurations[utils.GetMessageBaseReflectType(message).String()]
+	if producerConfiguration == nil {
+		producerConfiguration = producerConfigurations.NewDefaultRabbitMQProducerConfiguration(message)
+	}
+
+	exchange := topicOrExchangeName
+	if exchange == "" {
+		exchange = producerConfiguration.ExchangeOptions.Name
+	}
+	if exchange == "" {
+		exchange = utils.GetTopicOrExchangeName(message)
+	}
+
+	routingKey := producerConfiguration.RoutingKey
+	if routingKey == "" {
+		routingKey = utils.GetRoutingKey(message)
+	}
+
+	return exchange, routingKey
+}
+
+func (r *RabbitmqInMemoryHarnesses) getMetadata(
+	message types.IMessage,
+	meta metadata.Metadata,
+) metadata.Metadata {
+	meta = metadata.FromMetadata(meta)
+
+	messageHeader.SetMessageType(meta, message.GetMessageTypeName())
+	messageHeader.SetMessageContentType(meta, "application/json")
+	messageHeader.SetMessageName(meta, utils.GetMessageName(message))
+
+	if messageHeader.GetMessageId(meta) == "" {
+		messageHeader.SetMessageId(meta, message.GeMessageId())
+	}
+
+	if messageHeader.GetMessageCreated(meta) == *new(time.Time) {
+		messageHeader.SetMessageCreated(meta, message.GetCreated())
+	}
+
+	if messageHeader.GetCorrelationId(meta) == "" {
+		messageHeader.SetCorrelationId(meta, uuid.NewV4().String())
+	}
+
+	return meta
 }
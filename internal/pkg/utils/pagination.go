@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
 
@@ -23,6 +24,10 @@ type ListResult[T any] struct {
 	TotalItems int64 `json:"totalItems,omitempty" bson:"totalItems"`
 	TotalPage  int   `json:"totalPage,omitempty"  bson:"totalPage"`
 	Items      []T   `json:"items,omitempty"      bson:"items"`
+	// PreviousCursor and NextCursor are the `before` and the `after` cursors of the pages around the items, they are
+	// empty when there is no page
+	PreviousCursor string `json:"previousCursor,omitempty" bson:"previousCursor"`
+	NextCursor     string `json:"nextCursor,omitempty"     bson:"nextCursor"`
 }
 
 func NewListResult[T any](items []T, size int, page int, totalItems int64) *ListResult[T] {
@@ -50,11 +55,26 @@ type FilterModel struct {
 	Comparison string `query:"comparison" json:"comparison"`
 }
 
+// UnmarshalParam binds the filters of the query string with the `field:comparison:value` syntax
+// https://echo.labstack.com/docs/binding#custom-binding
+func (f *FilterModel) UnmarshalParam(param string) error {
+	filter, err := ParseFilter(param)
+	if err != nil {
+		return err
+	}
+	*f = *filter
+
+	return nil
+}
+
 type ListQuery struct {
 	Size    int            `query:"size"    json:"size,omitempty"`
 	Page    int            `query:"page"    json:"page,omitempty"`
 	OrderBy string         `query:"orderBy" json:"orderBy,omitempty"`
-	Filters []*FilterModel `query:"filters" json:"filters,omitempty"`
+	Filters []*FilterModel `query:"filters" json:"filters,omitempty" swaggertype:"array,string"`
+	// After and Before are the cursors of the list results for the keyset pagination, the page is ignored with a cursor
+	After  string `query:"after"  json:"after,omitempty"`
+	Before string `query:"before" json:"before,omitempty"`
 }
 
 func NewListQuery(size int, page int) *ListQuery {
@@ -65,7 +85,7 @@ func NewListQueryFromQueryParams(size string, page string) *ListQuery {
 	p := &ListQuery{Size: defaultSize, Page: defaultPage}
 
 	if sizeNum, err := strconv.Atoi(size); err == nil && sizeNum != 0 {
-		p.Page = sizeNum
+		p.Size = sizeNum
 	}
 
 	if pageNum, err := strconv.Atoi(page); err == nil && pageNum != 0 {
@@ -77,28 +97,25 @@ func NewListQueryFromQueryParams(size string, page string) *ListQuery {
 
 func GetListQueryFromCtx(c echo.Context) (*ListQuery, error) {
 	q := &ListQuery{}
-	var page, size, orderBy string
+	var page, size, orderBy, after, before string
+	var filters []string
 
 	// https://echo.labstack.com/guide/binding/#fast-binding-with-dedicated-helpers
 	err := echo.QueryParamsBinder(c).
-		CustomFunc("filters", func(values []string) []error {
-			for _, v := range values {
-				if v == "" {
-					continue
-				}
-				f := &FilterModel{}
-				if err := c.Bind(f); err != nil {
-					return []error{err}
-				}
-				q.Filters = append(q.Filters, f)
-			}
-			return nil
-		}).
+		Strings("filters", &filters).
 		String("size", &size).
 		String("page", &page).
 		String("orderBy", &orderBy).
+		String("after", &after).
+		String("before", &before).
 		BindError() // returns first binding error
+	if err != nil {
+		return nil, err
+	}
 
+	if q.Filters, err = ParseFilters(filters); err != nil {
+		return nil, err
+	}
 	if err = q.SetPage(page); err != nil {
 		return nil, err
 	}
@@ -106,10 +123,46 @@ func GetListQueryFromCtx(c echo.Context) (*ListQuery, error) {
 		return nil, err
 	}
 	q.SetOrderBy(orderBy)
+	q.After = after
+	q.Before = before
 
 	return q, nil
 }
 
+// ParseFilter parses a filter with the `field:comparison:value` syntax, e.g. `price:gt:10` or `name:contains:pizza`, the
+// value is the rest of the filter, so it can contain `:` (e.g. the times)
+func ParseFilter(filter string) (*FilterModel, error) {
+	parts := strings.SplitN(filter, ":", 3)
+	if len(parts) != 3 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return nil, errors.Errorf("filter '%s' should have the 'field:comparison:value' format", filter)
+	}
+
+	return &FilterModel{
+		Field:      strings.TrimSpace(parts[0]),
+		Comparison: strings.TrimSpace(parts[1]),
+		Value:      parts[2],
+	}, nil
+}
+
+// ParseFilters parses the filters of the query string or the grpc requests, the empty filters are ignored
+func ParseFilters(filters []string) ([]*FilterModel, error) {
+	var models []*FilterModel
+
+	for _, filter := range filters {
+		if filter == "" {
+			continue
+		}
+
+		model, err := ParseFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
 // SetSize Set page size
 func (q *ListQuery) SetSize(sizeQuery string) error {
 	if sizeQuery == "" {
@@ -175,7 +228,15 @@ func (q *ListQuery) GetSize() int {
 
 // GetQueryString get query string
 func (q *ListQuery) GetQueryString() string {
-	return fmt.Sprintf("page=%v&size=%v&orderBy=%s", q.GetPage(), q.GetSize(), q.GetOrderBy())
+	queryString := fmt.Sprintf("page=%v&size=%v&orderBy=%s", q.GetPage(), q.GetSize(), q.GetOrderBy())
+	if q.After != "" {
+		queryString += "&after=" + q.After
+	}
+	if q.Before != "" {
+		queryString += "&before=" + q.Before
+	}
+
+	return queryString
 }
 
 func ListResultToListResultDto[TDto any, TModel any](
@@ -191,10 +252,12 @@ func ListResultToListResultDto[TDto any, TModel any](
 	}
 
 	return &ListResult[TDto]{
-		Items:      items,
-		Size:       listResult.Size,
-		Page:       listResult.Page,
-		TotalItems: listResult.TotalItems,
-		TotalPage:  listResult.TotalPage,
+		Items:          items,
+		Size:           listResult.Size,
+		Page:           listResult.Page,
+		TotalItems:     listResult.TotalItems,
+		TotalPage:      listResult.TotalPage,
+		PreviousCursor: listResult.PreviousCursor,
+		NextCursor:     listResult.NextCursor,
 	}, nil
 }
@@ -0,0 +1,24 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_New_List_Query_From_Query_Params_Should_Set_Size_And_Page(t *testing.T) {
+	listQuery := NewListQueryFromQueryParams("5", "2")
+
+	assert.Equal(t, 5, listQuery.Size)
+	assert.Equal(t, 2, listQuery.Page)
+}
+
+func Test_New_List_Query_From_Query_Params_Should_Use_Defaults_For_Invalid_Params(t *testing.T) {
+	listQuery := NewListQueryFromQueryParams("", "invalid")
+
+	assert.Equal(t, defaultSize, listQuery.Size)
+	assert.Equal(t, defaultPage, listQuery.Page)
+}
@@ -1,11 +1,17 @@
 package rabbitmq
 
 import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	unitofworkpipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork/pipelines"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/pipeline"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
 	rabbitmqConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/configurations"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/consumer/configurations"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/contracts/data"
+	checkProductsAvailabilityRequestsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/features/checking_products_availability/v1/requests"
 	createProductExternalEventV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/features/creating_product/v1/events/integrationevents/externalevents"
 	deleteProductExternalEventV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/features/deleting_products/v1/events/integration_events/external_events"
 	updateProductExternalEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/features/updating_products/v1/events/integration_events/external_events"
@@ -18,7 +24,18 @@ func ConfigProductsRabbitMQ(
 	logger logger.Logger,
 	validator *validator.Validate,
 	tracer tracing.AppTracer,
+	productRepository data.ProductRepository,
+	unitOfWork unitofwork.UnitOfWork,
+	messagePersistenceService persistmessage.MessagePersistenceService,
 ) {
+	// the product events are handled in a unit of work with their inbox message, so a redelivered event is skipped, and
+	// the unit of work pipeline should be added before the inbox pipeline for storing the inbox message in it
+	inboxPipelines := func(pipelinesBuilder pipeline.ConsumerPipelineConfigurationBuilder) {
+		pipelinesBuilder.
+			AddPipeline(unitofworkpipelines.NewConsumerUnitOfWorkPipeline(logger, unitOfWork)).
+			AddPipeline(pipeline.NewInboxConsumerPipeline(messagePersistenceService, logger))
+	}
+
 	// add custom message type mappings
 	// utils.RegisterCustomMessageTypesToRegistrty(map[string]types.IMessage{"productCreatedV1": &creatingProductIntegration.ProductCreatedV1{}})
 
@@ -36,7 +53,8 @@ func ConfigProductsRabbitMQ(
 							),
 						)
 					},
-				)
+				).
+					WIthPipelines(inboxPipelines)
 			}).
 		AddConsumer(
 			deleteProductExternalEventV1.ProductDeletedV1{},
@@ -56,7 +74,8 @@ func ConfigProductsRabbitMQ(
 							tracer,
 						)
 					},
-				)
+				).
+					WIthPipelines(inboxPipelines)
 			}).
 		AddConsumer(
 			updateProductExternalEventsV1.ProductUpdatedV1{},
@@ -76,6 +95,22 @@ func ConfigProductsRabbitMQ(
 							tracer,
 						)
 					},
+				).
+					WIthPipelines(inboxPipelines)
+			}).
+		AddConsumer(
+			checkProductsAvailabilityRequestsV1.CheckProductsAvailabilityV1{},
+			func(builder configurations.RabbitMQConsumerConfigurationBuilder) {
+				builder.WithHandlers(
+					func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
+						handlersBuilder.AddHandler(
+							checkProductsAvailabilityRequestsV1.NewCheckProductsAvailabilityConsumer(
+								logger,
+								productRepository,
+								tracer,
+							),
+						)
+					},
 				)
 			})
 }
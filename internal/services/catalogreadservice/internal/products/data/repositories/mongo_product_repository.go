@@ -64,7 +64,11 @@ func (p *mongoProductRepository) GetAllProducts(
 	defer span.End()
 
 	// https://www.mongodb.com/docs/drivers/go/current/fundamentals/crud/read-operations/query-document/
-	result, err := p.mongoGenericRepository.GetAll(ctx, listQuery)
+	result, err := p.mongoGenericRepository.FindPage(
+		ctx,
+		listQuery,
+		models.ProductListQuerySchema,
+	)
 	if err != nil {
 		return nil, utils2.TraceErrStatusFromSpan(
 			span,
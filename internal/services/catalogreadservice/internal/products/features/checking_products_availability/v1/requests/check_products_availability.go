@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+)
+
+// CheckProductsAvailabilityV1 is the request of the other services for checking the availability of the products in the catalog,
+// it is responded with a `ProductsAvailabilityV1`
+type CheckProductsAvailabilityV1 struct {
+	*types.Message
+	ProductIds []string `json:"productIds"`
+}
+
+// ProductsAvailabilityV1 is the response of a `CheckProductsAvailabilityV1`, the error is set for an invalid request, so the
+// requester doesn't wait for its timeout
+type ProductsAvailabilityV1 struct {
+	*types.Message
+	Products []*ProductAvailability `json:"products"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+type ProductAvailability struct {
+	ProductId string  `json:"productId"`
+	Name      string  `json:"name,omitempty"`
+	Price     float64 `json:"price,omitempty"`
+	Available bool    `json:"available"`
+}
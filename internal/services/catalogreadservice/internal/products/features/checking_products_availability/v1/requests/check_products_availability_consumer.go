@@ -0,0 +1,100 @@
+package requests
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/consumer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/contracts/data"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+type checkProductsAvailabilityConsumer struct {
+	logger            logger.Logger
+	productRepository data.ProductRepository
+	tracer            tracing.AppTracer
+}
+
+func NewCheckProductsAvailabilityConsumer(
+	logger logger.Logger,
+	productRepository data.ProductRepository,
+	tracer tracing.AppTracer,
+) consumer.ConsumerHandler {
+	return &checkProductsAvailabilityConsumer{
+		logger:            logger,
+		productRepository: productRepository,
+		tracer:            tracer,
+	}
+}
+
+func (c *checkProductsAvailabilityConsumer) Handle(
+	ctx context.Context,
+	consumeContext types.MessageConsumeContext,
+) error {
+	request, ok := consumeContext.Message().(*CheckProductsAvailabilityV1)
+	if !ok {
+		return errors.New("error in casting message to CheckProductsAvailabilityV1")
+	}
+
+	// an invalid request fails in every redelivery, so it is responded with the error and acked
+	if len(request.ProductIds) == 0 {
+		return c.respondError(ctx, consumeContext, request, customErrors.NewValidationError("productIds is required"))
+	}
+
+	response := &ProductsAvailabilityV1{
+		Message:  types.NewMessage(uuid.NewV4().String()),
+		Products: make([]*ProductAvailability, 0, len(request.ProductIds)),
+	}
+
+	for _, productId := range request.ProductIds {
+		product, err := c.productRepository.GetProductByProductId(ctx, productId)
+		if err != nil {
+			return errors.WithMessagef(err, "error in getting the product with id: {%s}", productId)
+		}
+
+		availability := &ProductAvailability{ProductId: productId, Available: product != nil}
+		if product != nil {
+			availability.Name = product.Name
+			availability.Price = product.Price
+		}
+
+		response.Products = append(response.Products, availability)
+	}
+
+	err := consumeContext.Respond(ctx, response)
+	if err != nil {
+		return errors.WithMessagef(err, "error in responding the products availability request: {%s}", request.MessageId)
+	}
+
+	c.logger.Info("Products availability request handled.")
+
+	return nil
+}
+
+func (c *checkProductsAvailabilityConsumer) respondError(
+	ctx context.Context,
+	consumeContext types.MessageConsumeContext,
+	request *CheckProductsAvailabilityV1,
+	validationErr error,
+) error {
+	response := &ProductsAvailabilityV1{
+		Message:  types.NewMessage(uuid.NewV4().String()),
+		Products: []*ProductAvailability{},
+		Error:    validationErr.Error(),
+	}
+
+	// the invalid request is acked also when it has no reply address, because there is no requester for the response
+	err := consumeContext.Respond(ctx, response)
+	if err != nil && !errors.Is(err, types.ErrNoReplyAddress) {
+		return errors.WithMessagef(err, "error in responding the products availability request: {%s}", request.MessageId)
+	}
+
+	c.logger.Warnf("Products availability request {%s} is invalid: %v", request.MessageId, validationErr)
+
+	return nil
+}
@@ -39,6 +39,10 @@ func NewCreateProduct(
 	return command, nil
 }
 
+// IsTxRequest for enabling transactions on the mediatr pipeline
+func (p *CreateProduct) IsTxRequest() {
+}
+
 func (p *CreateProduct) Validate() error {
 	return validation.ValidateStruct(p, validation.Field(&p.Id, validation.Required),
 		validation.Field(&p.ProductId, validation.Required),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
@@ -54,7 +55,10 @@ func (c *CreateProductHandler) Handle(
 		)
 	}
 
-	err = c.redisRepository.PutProduct(ctx, createdProduct.Id, createdProduct)
+	// the cache is updated after the commit of the unit of work, so it never keeps a rolled back product
+	err = unitofwork.AfterCommit(ctx, func(ctx context.Context) error {
+		return c.redisRepository.PutProduct(ctx, createdProduct.Id, createdProduct)
+	})
 	if err != nil {
 		return nil, customErrors.NewApplicationErrorWrap(
 			err,
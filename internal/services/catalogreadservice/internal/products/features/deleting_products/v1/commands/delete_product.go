@@ -19,6 +19,10 @@ func NewDeleteProduct(productId uuid.UUID) (*DeleteProduct, error) {
 	return delProduct, nil
 }
 
+// IsTxRequest for enabling transactions on the mediatr pipeline
+func (p *DeleteProduct) IsTxRequest() {
+}
+
 func (p *DeleteProduct) Validate() error {
 	return validation.ValidateStruct(p,
 		validation.Field(&p.ProductId, validation.Required, is.UUIDv4))
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
@@ -67,7 +68,10 @@ func (c *DeleteProductCommand) Handle(
 		)
 	}
 
-	err = c.redisRepository.DeleteProduct(ctx, product.Id)
+	// the cache is updated after the commit of the unit of work, so it never misses a product of a rolled back delete
+	err = unitofwork.AfterCommit(ctx, func(ctx context.Context) error {
+		return c.redisRepository.DeleteProduct(ctx, product.Id)
+	})
 	if err != nil {
 		return nil, customErrors.NewApplicationErrorWrap(
 			err,
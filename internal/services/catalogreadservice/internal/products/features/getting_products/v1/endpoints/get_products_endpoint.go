@@ -64,6 +64,14 @@ func (ep *getProductsEndpoint) handler() echo.HandlerFunc {
 			return badRequestErr
 		}
 		query := &queries.GetProducts{ListQuery: request.ListQuery}
+		if err := query.Validate(); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"query validation failed",
+			)
+
+			return validationErr
+		}
 
 		queryResult, err := mediatr.Send[*queries.GetProducts, *dtos.GetProductsResponseDto](
 			ctx,
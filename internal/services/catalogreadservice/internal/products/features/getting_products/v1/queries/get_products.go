@@ -1,6 +1,9 @@
 package queries
 
-import "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/models"
+)
 
 // Ref: https://golangbot.com/inheritance/
 
@@ -11,3 +14,8 @@ type GetProducts struct {
 func NewGetProducts(query *utils.ListQuery) *GetProducts {
 	return &GetProducts{ListQuery: query}
 }
+
+// Validate checks the filters and the sorts of the list query against the allowed product fields
+func (p *GetProducts) Validate() error {
+	return models.ProductListQuerySchema.Validate(p.ListQuery)
+}
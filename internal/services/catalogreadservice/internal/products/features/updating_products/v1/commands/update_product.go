@@ -30,6 +30,10 @@ func NewUpdateProduct(productId uuid.UUID, name string, description string, pric
 	return product, nil
 }
 
+// IsTxRequest for enabling transactions on the mediatr pipeline
+func (p *UpdateProduct) IsTxRequest() {
+}
+
 func (p *UpdateProduct) Validate() error {
 	return validation.ValidateStruct(p, validation.Field(&p.ProductId, validation.Required, is.UUIDv4),
 		validation.Field(&p.Name, validation.Required, validation.Length(0, 255)),
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+)
+
+// ProductListQuerySchema is the allow-list of the product fields which the list queries can filter and sort by, the
+// public names are the same as the json names of the products
+var ProductListQuerySchema = listquery.NewSchema(
+	listquery.NewKeyField("productId", specification.MustField[Product, string]("ProductId")),
+	listquery.NewField("name", specification.MustField[Product, string]("Name")),
+	listquery.NewField("description", specification.MustField[Product, string]("Description")),
+	listquery.NewField("price", specification.MustField[Product, float64]("Price")),
+	listquery.NewField("createdAt", specification.MustField[Product, time.Time]("CreatedAt")),
+	listquery.NewField("updatedAt", specification.MustField[Product, time.Time]("UpdatedAt")),
+)
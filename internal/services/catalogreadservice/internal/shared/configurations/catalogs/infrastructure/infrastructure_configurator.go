@@ -1,6 +1,8 @@
 package infrastructure
 
 import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	unitofworkpipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork/pipelines"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/fxapp/contracts"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	loggingpipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/pipelines"
@@ -26,7 +28,7 @@ func NewInfrastructureConfigurator(
 
 func (ic *InfrastructureConfigurator) ConfigInfrastructures() {
 	ic.ResolveFunc(
-		func(l logger.Logger, tracer tracing.AppTracer, metrics metrics.AppMetrics) error {
+		func(l logger.Logger, tracer tracing.AppTracer, metrics metrics.AppMetrics, unitOfWork unitofwork.UnitOfWork) error {
 			err := mediatr.RegisterRequestPipelineBehaviors(
 				loggingpipelines.NewMediatorLoggingPipeline(l),
 				tracingpipelines.NewMediatorTracingPipeline(
@@ -37,6 +39,7 @@ func (ic *InfrastructureConfigurator) ConfigInfrastructures() {
 					metrics,
 					metricspipelines.WithLogger(l),
 				),
+				unitofworkpipelines.NewMediatorUnitOfWorkPipeline(l, unitOfWork),
 			)
 
 			return err
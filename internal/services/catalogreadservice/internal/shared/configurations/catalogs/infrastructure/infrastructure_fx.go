@@ -2,17 +2,21 @@ package infrastructure
 
 import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/grpc"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/health"
 	customEcho "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/customecho"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongomessaging"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/metrics"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/configurations"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/redis"
 	rabbitmq2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/configurations/rabbitmq"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogreadservice/internal/products/contracts/data"
 
 	"github.com/go-playground/validator"
 	"go.uber.org/fx"
@@ -26,11 +30,28 @@ var Module = fx.Module(
 	customEcho.Module,
 	grpc.Module,
 	mongodb.Module,
+	// the inbox messages of the consumers are stored in mongo with the writes of their handlers
+	mongomessaging.Module,
 	redis.Module,
 	rabbitmq.ModuleFunc(
-		func(v *validator.Validate, l logger.Logger, tracer tracing.AppTracer) configurations.RabbitMQConfigurationBuilderFuc {
+		func(
+			v *validator.Validate,
+			l logger.Logger,
+			tracer tracing.AppTracer,
+			productRepository data.ProductRepository,
+			unitOfWork unitofwork.UnitOfWork,
+			messagePersistenceService persistmessage.MessagePersistenceService,
+		) configurations.RabbitMQConfigurationBuilderFuc {
 			return func(builder configurations.RabbitMQConfigurationBuilder) {
-				rabbitmq2.ConfigProductsRabbitMQ(builder, l, v, tracer)
+				rabbitmq2.ConfigProductsRabbitMQ(
+					builder,
+					l,
+					v,
+					tracer,
+					productRepository,
+					unitOfWork,
+					messagePersistenceService,
+				)
 			}
 		},
 	),
@@ -0,0 +1,66 @@
+package changefeed
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// subscriberBufferSize is the number of the changes which are kept for a subscriber before dropping it as a slow subscriber
+const subscriberBufferSize = 100
+
+type inMemoryProductChangesFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan *models.ProductChange]struct{}
+}
+
+func NewInMemoryProductChangesFeed() contracts.ProductChangesFeed {
+	return &inMemoryProductChangesFeed{
+		subscribers: make(map[chan *models.ProductChange]struct{}),
+	}
+}
+
+// Publish sends the change to the subscribers without blocking, a slow subscriber is dropped instead of missing changes
+// silently, so its watcher can watch again
+func (f *inMemoryProductChangesFeed) Publish(change *models.ProductChange) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for subscriber := range f.subscribers {
+		select {
+		case subscriber <- change:
+		default:
+			f.remove(subscriber)
+		}
+	}
+}
+
+func (f *inMemoryProductChangesFeed) Subscribe(ctx context.Context) <-chan *models.ProductChange {
+	subscriber := make(chan *models.ProductChange, subscriberBufferSize)
+
+	f.mu.Lock()
+	f.subscribers[subscriber] = struct{}{}
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.remove(subscriber)
+	}()
+
+	return subscriber
+}
+
+func (f *inMemoryProductChangesFeed) remove(subscriber chan *models.ProductChange) {
+	if _, ok := f.subscribers[subscriber]; !ok {
+		return
+	}
+
+	delete(f.subscribers, subscriber)
+	close(subscriber)
+}
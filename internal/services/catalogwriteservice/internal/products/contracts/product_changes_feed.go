@@ -0,0 +1,15 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// ProductChangesFeed broadcasts the product changes of this service instance to the product watchers
+type ProductChangesFeed interface {
+	Publish(change *models.ProductChange)
+	// Subscribe returns the changes which are published after subscribing, the channel is closed when the context is done or
+	// when the subscriber doesn't receive the changes fast enough
+	Subscribe(ctx context.Context) <-chan *models.ProductChange
+}
@@ -0,0 +1,21 @@
+package datamodels
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ProductListQuerySchema is the allow-list of the product fields which the list queries can filter and sort by, the
+// public names are the same as the json names of the product dtos
+var ProductListQuerySchema = listquery.NewSchema(
+	listquery.NewKeyField("id", specification.MustField[ProductDataModel, uuid.UUID]("Id")),
+	listquery.NewField("name", specification.MustField[ProductDataModel, string]("Name")),
+	listquery.NewField("description", specification.MustField[ProductDataModel, string]("Description")),
+	listquery.NewField("price", specification.MustField[ProductDataModel, float64]("Price")),
+	listquery.NewField("createdAt", specification.MustField[ProductDataModel, time.Time]("CreatedAt")),
+	listquery.NewField("updatedAt", specification.MustField[ProductDataModel, time.Time]("UpdatedAt")),
+)
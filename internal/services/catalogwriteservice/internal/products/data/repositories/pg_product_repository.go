@@ -12,6 +12,7 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/repository"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 	data2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/contracts"
+	datamodel "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/models"
 
 	"emperror.dev/errors"
@@ -46,7 +47,11 @@ func (p *postgresProductRepository) GetAllProducts(
 	ctx, span := p.tracer.Start(ctx, "postgresProductRepository.GetAllProducts")
 	defer span.End()
 
-	result, err := p.gormGenericRepository.GetAll(ctx, listQuery)
+	result, err := p.gormGenericRepository.FindPage(
+		ctx,
+		listQuery,
+		datamodel.ProductListQuerySchema,
+	)
 	err = utils2.TraceStatusFromContext(
 		ctx,
 		errors.WrapIf(
@@ -1,9 +1,11 @@
 package fxparams
 
 import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/producer"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/contracts"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/data/dbcontext"
 
 	"go.uber.org/fx"
@@ -16,4 +18,6 @@ type ProductHandlerParams struct {
 	CatalogsDBContext *dbcontext.CatalogsGormDBContext
 	RabbitmqProducer  producer.Producer
 	Tracer            tracing.AppTracer
+	ProductChanges    contracts.ProductChangesFeed
+	MessageStore      persistmessage.MessagePersistenceService
 }
@@ -52,7 +52,8 @@ func NewCreateProductWithValidation(
 	return command, err
 }
 
-func (c *CreateProduct) isTxRequest(){
+// IsTxRequest for enabling transactions on the mediatr pipeline
+func (c *CreateProduct) IsTxRequest() {
 }
 
 func (c *CreateProduct) Validate() error {
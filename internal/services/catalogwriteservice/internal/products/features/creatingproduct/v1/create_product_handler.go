@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
@@ -88,6 +89,21 @@ func (c *createProductHandler) Handle(
 		logger.Fields{"MessageId": productCreated.MessageId},
 	)
 
+	// the watchers are notified after the commit of the unit of work, so they never see a rolled back change
+	err = unitofwork.AfterCommit(ctx, func(ctx context.Context) error {
+		c.ProductChanges.Publish(&models.ProductChange{
+			ChangeType: models.ProductCreated,
+			ProductId:  product.Id,
+			Product:    productDto,
+			OccurredAt: command.CreatedAt,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	createProductResult = &dtos.CreateProductResponseDto{
 		ProductID: product.Id,
 	}
@@ -3,18 +3,25 @@ package v1
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/gormdbcontext"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
 	integrationEvents "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1/events/integrationevents"
+	purgingproductv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/models"
 
 	"github.com/mehdihadeli/go-mediatr"
 )
 
+// deletedProductRetention is the period which a soft deleted product is kept before purging it permanently
+const deletedProductRetention = 30 * 24 * time.Hour
+
 type deleteProductHandler struct {
 	fxparams.ProductHandlerParams
 }
@@ -46,6 +53,19 @@ func (c *deleteProductHandler) Handle(
 		return nil, err
 	}
 
+	// the purge command is stored in the transaction of the delete, so it is scheduled only when the delete is committed
+	err = c.MessageStore.ScheduleInternalMessage(
+		purgingproductv1.NewPurgeProduct(command.ProductID),
+		time.Now().Add(deletedProductRetention),
+		ctx,
+	)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in scheduling 'PurgeProduct' internal command",
+		)
+	}
+
 	productDeleted := integrationEvents.NewProductDeletedV1(
 		command.ProductID.String(),
 	)
@@ -65,6 +85,20 @@ func (c *deleteProductHandler) Handle(
 		logger.Fields{"MessageId": productDeleted.MessageId},
 	)
 
+	// the watchers are notified after the commit of the unit of work, so they never see a rolled back change
+	err = unitofwork.AfterCommit(ctx, func(ctx context.Context) error {
+		c.ProductChanges.Publish(&models.ProductChange{
+			ChangeType: models.ProductDeleted,
+			ProductId:  command.ProductID,
+			OccurredAt: time.Now(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	c.Log.Infow(
 		fmt.Sprintf(
 			"product with id '%s' deleted",
@@ -1,7 +1,9 @@
 package v1
 
 import (
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	datamodel "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
 )
 
 // Ref: https://golangbot.com/inheritance/
@@ -11,5 +13,22 @@ type GetProducts struct {
 }
 
 func NewGetProducts(query *utils.ListQuery) (*GetProducts, error) {
-	return &GetProducts{ListQuery: query}, nil
+	getProducts := &GetProducts{ListQuery: query}
+
+	err := getProducts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return getProducts, nil
+}
+
+// Validate checks the filters and the sorts of the list query against the allowed product fields
+func (p *GetProducts) Validate() error {
+	err := datamodel.ProductListQuerySchema.Validate(p.ListQuery)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
 }
@@ -38,9 +38,10 @@ func (c *getProductsHandler) Handle(
 	ctx context.Context,
 	query *GetProducts,
 ) (*dtos.GetProductsResponseDto, error) {
-	products, err := gormextensions.Paginate[*datamodel.ProductDataModel, *models.Product](
+	products, err := gormextensions.FilterPaginate[*datamodel.ProductDataModel, *models.Product](
 		ctx,
 		query.ListQuery,
+		datamodel.ProductListQuerySchema,
 		c.CatalogsDBContext.DB(),
 	)
 	if err != nil {
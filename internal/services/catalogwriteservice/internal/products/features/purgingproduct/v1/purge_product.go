@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// PurgeProduct is an internal command for removing a soft deleted product permanently, it is scheduled by the delete
+// product handler in the message store and dispatched by the message dispatcher after the retention period
+type PurgeProduct struct {
+	cqrs.InternalCommand
+	ProductID uuid.UUID
+}
+
+func NewPurgeProduct(productID uuid.UUID) *PurgeProduct {
+	return &PurgeProduct{
+		InternalCommand: cqrs.NewInternalCommandByT[*PurgeProduct](),
+		ProductID:       productID,
+	}
+}
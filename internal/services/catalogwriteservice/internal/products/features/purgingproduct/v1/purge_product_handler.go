@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type purgeProductHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+func NewPurgeProductHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*PurgeProduct, *mediatr.Unit] {
+	return &purgeProductHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler registers the handler in mediatr and the command in the internal commands registry, so the message
+// dispatcher can deserialize and send the stored commands
+func (c *purgeProductHandler) RegisterHandler() error {
+	err := mediatr.RegisterRequestHandler[*PurgeProduct, *mediatr.Unit](c)
+	if err != nil {
+		return err
+	}
+
+	return cqrs.RegisterInternalCommand[*PurgeProduct, *mediatr.Unit]()
+}
+
+func (c *purgeProductHandler) Handle(
+	ctx context.Context,
+	command *PurgeProduct,
+) (*mediatr.Unit, error) {
+	// the message dispatcher runs the handler in the transaction of the stored command, so the product is removed
+	// together with marking the command as processed
+	txDBContext := c.CatalogsDBContext.WithTxIfExists(ctx)
+
+	// just the soft deleted products are removed, a restored product should be kept
+	// https://gorm.io/docs/delete.html#Delete-permanently
+	result := txDBContext.DB().
+		WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Delete(&datamodels.ProductDataModel{}, command.ProductID)
+	if result.Error != nil {
+		return nil, customErrors.NewInternalServerErrorWrap(
+			result.Error,
+			fmt.Sprintf("error in purging product with id `%s` in the database", command.ProductID),
+		)
+	}
+
+	c.Log.Infow(
+		fmt.Sprintf(
+			"product with id '%s' purged",
+			command.ProductID,
+		),
+		logger.Fields{"Id": command.ProductID, "RowsAffected": result.RowsAffected},
+	)
+
+	return &mediatr.Unit{}, nil
+}
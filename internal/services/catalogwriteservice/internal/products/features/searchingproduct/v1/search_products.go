@@ -3,6 +3,7 @@ package v1
 import (
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	datamodel "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 )
@@ -35,5 +36,10 @@ func (p *SearchProducts) Validate() error {
 		return customErrors.NewValidationErrorWrap(err, "validation error")
 	}
 
+	err = datamodel.ProductListQuerySchema.Validate(p.ListQuery)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
 	return nil
 }
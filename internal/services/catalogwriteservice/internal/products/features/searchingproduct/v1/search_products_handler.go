@@ -2,7 +2,6 @@ package v1
 
 import (
 	"context"
-	"fmt"
 	"reflect"
 	"strings"
 
@@ -21,6 +20,7 @@ import (
 	"github.com/iancoleman/strcase"
 	"github.com/mehdihadeli/go-mediatr"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type searchProductsHandler struct {
@@ -47,9 +47,10 @@ func (c *searchProductsHandler) Handle(
 ) (*dtos.SearchProductsResponseDto, error) {
 	dbQuery := c.prepareSearchDBQuery(query)
 
-	products, err := gormPostgres.Paginate[*datamodel.ProductDataModel, *models.Product](
+	products, err := gormPostgres.FilterPaginate[*datamodel.ProductDataModel, *models.Product](
 		ctx,
 		query.ListQuery,
+		datamodel.ProductListQuerySchema,
 		dbQuery,
 	)
 	if err != nil {
@@ -81,18 +82,19 @@ func (c *searchProductsHandler) prepareSearchDBQuery(
 		typeMapper.GetGenericTypeByT[*datamodel.ProductDataModel](),
 	)
 
-	dbQuery := c.CatalogsDBContext.DB()
+	var conditions []clause.Expression
 
 	for _, field := range fields {
 		if field.Type.Kind() != reflect.String {
 			continue
 		}
 
-		dbQuery = dbQuery.Or(
-			fmt.Sprintf("%s LIKE ?", strcase.ToSnake(field.Name)),
-			"%"+strings.ToLower(query.SearchText)+"%",
-		)
+		conditions = append(conditions, clause.Like{
+			Column: clause.Column{Name: strcase.ToSnake(field.Name)},
+			Value:  "%" + strings.ToLower(query.SearchText) + "%",
+		})
 	}
 
-	return dbQuery
+	// the search conditions are grouped, so the filters of the list query are combined with all of them
+	return c.CatalogsDBContext.DB().Where(clause.Or(conditions...))
 }
@@ -47,7 +47,7 @@ func NewUpdateProductWithValidation(
 }
 
 // IsTxRequest for enabling transactions on the mediatr pipeline
-func (c *UpdateProduct) isTxRequest() {
+func (c *UpdateProduct) IsTxRequest() {
 }
 
 func (c *UpdateProduct) Validate() error {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
@@ -97,6 +98,21 @@ func (c *updateProductHandler) Handle(
 		)
 	}
 
+	// the watchers are notified after the commit of the unit of work, so they never see a rolled back change
+	err = unitofwork.AfterCommit(ctx, func(ctx context.Context) error {
+		c.ProductChanges.Publish(&models.ProductChange{
+			ChangeType: models.ProductUpdated,
+			ProductId:  command.ProductID,
+			Product:    productDto,
+			OccurredAt: command.UpdatedAt,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	c.Log.Infow(
 		fmt.Sprintf(
 			"product with id '%s' updated",
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	dtoV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/dtos/v1"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type ProductChangeType int
+
+const (
+	ProductCreated ProductChangeType = iota + 1
+	ProductUpdated
+	ProductDeleted
+)
+
+// ProductChange is a change of a product which is streamed to the product watchers
+type ProductChange struct {
+	ChangeType ProductChangeType
+	ProductId  uuid.UUID
+	// Product is nil for the deleted products
+	Product    *dtoV1.ProductDto
+	OccurredAt time.Time
+}
@@ -4,11 +4,13 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/customecho/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/changefeed"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/repositories"
 	creatingproductv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1"
 	deletingproductv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1"
 	gettingproductbyidv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/gettingproductbyid/v1"
 	gettingproductsv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/gettingproducts/v1"
+	purgingproductv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1"
 	searchingproductsv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/searchingproduct/v1"
 	updatingoroductsv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/grpc"
@@ -23,6 +25,7 @@ var Module = fx.Module(
 	// Other provides
 	fx.Provide(repositories.NewPostgresProductRepository),
 	fx.Provide(grpc.NewProductGrpcService),
+	fx.Provide(changefeed.NewInMemoryProductChangesFeed),
 
 	fx.Provide(
 		fx.Annotate(func(catalogsServer contracts.EchoHttpServer) *echo.Group {
@@ -63,6 +66,10 @@ var Module = fx.Module(
 			updatingoroductsv1.NewUpdateProductHandler,
 			"product-handlers",
 		),
+		cqrs.AsHandler(
+			purgingproductv1.NewPurgeProductHandler,
+			"product-handlers",
+		),
 	),
 
 	// add endpoints to DI
@@ -3,6 +3,7 @@ package catalogs
 import (
 	"fmt"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/configurations/catalogs/infrastructure"
@@ -27,6 +28,9 @@ var CatalogsServiceModule = fx.Module(
 
 	// Other provides
 	fx.Provide(provideCatalogsMetrics),
+
+	// publishing messages through the outbox, so they will be stored in the same transaction with the business data
+	fx.Decorate(persistmessage.NewOutboxProducer),
 )
 
 // ref: https://github.com/open-telemetry/opentelemetry-go/blob/main/example/prometheus/main.go
@@ -83,6 +87,22 @@ func provideCatalogsMetrics(
 		return nil, err
 	}
 
+	getProductsGrpcRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_get_products_grpc_requests_total", cfg.ServiceName),
+		api.WithDescription("The total number of get products grpc requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	watchProductsGrpcRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_watch_products_grpc_requests_total", cfg.ServiceName),
+		api.WithDescription("The total number of watch products grpc requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	createProductRabbitMQMessages, err := meter.Float64Counter(
 		fmt.Sprintf(
 			"%s_create_product_rabbitmq_messages_total",
@@ -151,6 +171,7 @@ func provideCatalogsMetrics(
 	return &contracts.CatalogsMetrics{
 		CreateProductRabbitMQMessages: createProductRabbitMQMessages,
 		GetProductByIdGrpcRequests:    getProductByIdGrpcRequests,
+		GetProductsGrpcRequests:       getProductsGrpcRequests,
 		CreateProductGrpcRequests:     createProductGrpcRequests,
 		DeleteProductRabbitMQMessages: deleteProductRabbitMQMessages,
 		DeleteProductGrpcRequests:     deleteProductGrpcRequests,
@@ -159,5 +180,6 @@ func provideCatalogsMetrics(
 		SuccessRabbitMQMessages:       successRabbitMQMessages,
 		UpdateProductRabbitMQMessages: updateProductRabbitMQMessages,
 		UpdateProductGrpcRequests:     updateProductGrpcRequests,
+		WatchProductsGrpcRequests:     watchProductsGrpcRequests,
 	}, nil
 }
@@ -1,6 +1,8 @@
 package infrastructure
 
 import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork"
+	unitofworkpipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/unitofwork/pipelines"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/fxapp/contracts"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	loggingpipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/pipelines"
@@ -8,11 +10,9 @@ import (
 	metricspipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/metrics/mediatr/pipelines"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
 	tracingpipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/mediatr/pipelines"
-	postgrespipelines "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/pipelines"
 	validationpieline "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/validation/pipeline"
 
 	"github.com/mehdihadeli/go-mediatr"
-	"gorm.io/gorm"
 )
 
 type InfrastructureConfigurator struct {
@@ -29,7 +29,7 @@ func NewInfrastructureConfigurator(
 
 func (ic *InfrastructureConfigurator) ConfigInfrastructures() {
 	ic.ResolveFunc(
-		func(l logger.Logger, tracer tracing.AppTracer, metrics metrics.AppMetrics, db *gorm.DB) error {
+		func(l logger.Logger, tracer tracing.AppTracer, metrics metrics.AppMetrics, unitOfWork unitofwork.UnitOfWork) error {
 			err := mediatr.RegisterRequestPipelineBehaviors(
 				loggingpipelines.NewMediatorLoggingPipeline(l),
 				validationpieline.NewMediatorValidationPipeline(l),
@@ -41,7 +41,7 @@ func (ic *InfrastructureConfigurator) ConfigInfrastructures() {
 					metrics,
 					metricspipelines.WithLogger(l),
 				),
-				postgrespipelines.NewMediatorTransactionPipeline(l, db),
+				unitofworkpipelines.NewMediatorUnitOfWorkPipeline(l, unitOfWork),
 			)
 
 			return err
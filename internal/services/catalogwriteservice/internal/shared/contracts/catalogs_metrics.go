@@ -9,7 +9,9 @@ type CatalogsMetrics struct {
 	UpdateProductGrpcRequests     metric.Float64Counter
 	DeleteProductGrpcRequests     metric.Float64Counter
 	GetProductByIdGrpcRequests    metric.Float64Counter
+	GetProductsGrpcRequests       metric.Float64Counter
 	SearchProductGrpcRequests     metric.Float64Counter
+	WatchProductsGrpcRequests     metric.Float64Counter
 	SuccessRabbitMQMessages       metric.Float64Counter
 	ErrorRabbitMQMessages         metric.Float64Counter
 	CreateProductRabbitMQMessages metric.Float64Counter
@@ -22,6 +22,10 @@ const (
 	ProductsService_CreateProduct_FullMethodName  = "/products_service.ProductsService/CreateProduct"
 	ProductsService_UpdateProduct_FullMethodName  = "/products_service.ProductsService/UpdateProduct"
 	ProductsService_GetProductById_FullMethodName = "/products_service.ProductsService/GetProductById"
+	ProductsService_DeleteProduct_FullMethodName  = "/products_service.ProductsService/DeleteProduct"
+	ProductsService_GetProducts_FullMethodName    = "/products_service.ProductsService/GetProducts"
+	ProductsService_SearchProducts_FullMethodName = "/products_service.ProductsService/SearchProducts"
+	ProductsService_WatchProducts_FullMethodName  = "/products_service.ProductsService/WatchProducts"
 )
 
 // ProductsServiceClient is the client API for ProductsService service.
@@ -31,6 +35,11 @@ type ProductsServiceClient interface {
 	CreateProduct(ctx context.Context, in *CreateProductReq, opts ...grpc.CallOption) (*CreateProductRes, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductReq, opts ...grpc.CallOption) (*UpdateProductRes, error)
 	GetProductById(ctx context.Context, in *GetProductByIdReq, opts ...grpc.CallOption) (*GetProductByIdRes, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductReq, opts ...grpc.CallOption) (*DeleteProductRes, error)
+	GetProducts(ctx context.Context, in *GetProductsReq, opts ...grpc.CallOption) (*GetProductsRes, error)
+	SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsRes, error)
+	// WatchProducts streams the product changes which happen after starting the watch
+	WatchProducts(ctx context.Context, in *WatchProductsReq, opts ...grpc.CallOption) (ProductsService_WatchProductsClient, error)
 }
 
 type productsServiceClient struct {
@@ -68,6 +77,65 @@ func (c *productsServiceClient) GetProductById(ctx context.Context, in *GetProdu
 	return out, nil
 }
 
+func (c *productsServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductReq, opts ...grpc.CallOption) (*DeleteProductRes, error) {
+	out := new(DeleteProductRes)
+	err := c.cc.Invoke(ctx, ProductsService_DeleteProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) GetProducts(ctx context.Context, in *GetProductsReq, opts ...grpc.CallOption) (*GetProductsRes, error) {
+	out := new(GetProductsRes)
+	err := c.cc.Invoke(ctx, ProductsService_GetProducts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsRes, error) {
+	out := new(SearchProductsRes)
+	err := c.cc.Invoke(ctx, ProductsService_SearchProducts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) WatchProducts(ctx context.Context, in *WatchProductsReq, opts ...grpc.CallOption) (ProductsService_WatchProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProductsService_ServiceDesc.Streams[0], ProductsService_WatchProducts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productsServiceWatchProductsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProductsService_WatchProductsClient interface {
+	Recv() (*ProductChange, error)
+	grpc.ClientStream
+}
+
+type productsServiceWatchProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *productsServiceWatchProductsClient) Recv() (*ProductChange, error) {
+	m := new(ProductChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ProductsServiceServer is the server API for ProductsService service.
 // All implementations should embed UnimplementedProductsServiceServer
 // for forward compatibility
@@ -75,6 +143,11 @@ type ProductsServiceServer interface {
 	CreateProduct(context.Context, *CreateProductReq) (*CreateProductRes, error)
 	UpdateProduct(context.Context, *UpdateProductReq) (*UpdateProductRes, error)
 	GetProductById(context.Context, *GetProductByIdReq) (*GetProductByIdRes, error)
+	DeleteProduct(context.Context, *DeleteProductReq) (*DeleteProductRes, error)
+	GetProducts(context.Context, *GetProductsReq) (*GetProductsRes, error)
+	SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsRes, error)
+	// WatchProducts streams the product changes which happen after starting the watch
+	WatchProducts(*WatchProductsReq, ProductsService_WatchProductsServer) error
 }
 
 // UnimplementedProductsServiceServer should be embedded to have forward compatible implementations.
@@ -90,6 +163,18 @@ func (UnimplementedProductsServiceServer) UpdateProduct(context.Context, *Update
 func (UnimplementedProductsServiceServer) GetProductById(context.Context, *GetProductByIdReq) (*GetProductByIdRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductById not implemented")
 }
+func (UnimplementedProductsServiceServer) DeleteProduct(context.Context, *DeleteProductReq) (*DeleteProductRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductsServiceServer) GetProducts(context.Context, *GetProductsReq) (*GetProductsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
+}
+func (UnimplementedProductsServiceServer) SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductsServiceServer) WatchProducts(*WatchProductsReq, ProductsService_WatchProductsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProducts not implemented")
+}
 
 // UnsafeProductsServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ProductsServiceServer will
@@ -156,6 +241,81 @@ func _ProductsService_GetProductById_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductsService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).DeleteProduct(ctx, req.(*DeleteProductReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).GetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_GetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).GetProducts(ctx, req.(*GetProductsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).SearchProducts(ctx, req.(*SearchProductsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_WatchProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProductsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductsServiceServer).WatchProducts(m, &productsServiceWatchProductsServer{stream})
+}
+
+type ProductsService_WatchProductsServer interface {
+	Send(*ProductChange) error
+	grpc.ServerStream
+}
+
+type productsServiceWatchProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *productsServiceWatchProductsServer) Send(m *ProductChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ProductsService_ServiceDesc is the grpc.ServiceDesc for ProductsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -175,7 +335,25 @@ var ProductsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductById",
 			Handler:    _ProductsService_GetProductById_Handler,
 		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductsService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "GetProducts",
+			Handler:    _ProductsService_GetProducts_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductsService_SearchProducts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchProducts",
+			Handler:       _ProductsService_WatchProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "catalogwriteservice/products.proto",
 }
@@ -3,16 +3,25 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/attribute"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	productsContracts "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/contracts"
 	createProductCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1"
 	createProductDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/dtos"
+	deleteProductCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1"
 	getProductByIdQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/gettingproductbyid/v1"
 	getProductByIdDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/gettingproductbyid/v1/dtos"
+	getProductsQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/gettingproducts/v1"
+	getProductsDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/gettingproducts/v1/dtos"
+	searchProductsQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/searchingproduct/v1"
+	searchProductsDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/searchingproduct/v1/dtos"
 	updateProductCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/models"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/contracts"
 	productsService "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/grpc/genproto"
 
@@ -22,6 +31,7 @@ import (
 	attribute2 "go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var grpcMetricsAttr = api.WithAttributes(
@@ -31,6 +41,7 @@ var grpcMetricsAttr = api.WithAttributes(
 type ProductGrpcServiceServer struct {
 	catalogsMetrics *contracts.CatalogsMetrics
 	logger          logger.Logger
+	productChanges  productsContracts.ProductChangesFeed
 	// Ref:https://github.com/grpc/grpc-go/issues/3794#issuecomment-720599532
 	// product_service_client.UnimplementedProductsServiceServer
 }
@@ -38,10 +49,12 @@ type ProductGrpcServiceServer struct {
 func NewProductGrpcService(
 	catalogsMetrics *contracts.CatalogsMetrics,
 	logger logger.Logger,
+	productChanges productsContracts.ProductChangesFeed,
 ) *ProductGrpcServiceServer {
 	return &ProductGrpcServiceServer{
 		catalogsMetrics: catalogsMetrics,
 		logger:          logger,
+		productChanges:  productChanges,
 	}
 }
 
@@ -232,3 +245,313 @@ func (s *ProductGrpcServiceServer) GetProductById(
 
 	return &productsService.GetProductByIdRes{Product: product}, nil
 }
+
+func (s *ProductGrpcServiceServer) DeleteProduct(
+	ctx context.Context,
+	req *productsService.DeleteProductReq,
+) (*productsService.DeleteProductRes, error) {
+	s.catalogsMetrics.DeleteProductGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Object("Request", req))
+
+	productUUID, err := uuid.FromString(req.GetProductId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_DeleteProduct.uuid.FromString] error in converting uuid",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_DeleteProduct.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	command, err := deleteProductCommandV1.NewDeleteProductWithValidation(productUUID)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_DeleteProduct.StructCtx] command validation failed",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_DeleteProduct.StructCtx] err: %v",
+				validationErr,
+			),
+		)
+		return nil, validationErr
+	}
+
+	if _, err = mediatr.Send[*deleteProductCommandV1.DeleteProduct, *mediatr.Unit](ctx, command); err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_DeleteProduct.Send] error in sending DeleteProduct",
+		)
+		s.logger.Errorw(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_DeleteProduct.Send] id: {%s}, err: %v",
+				command.ProductID,
+				err,
+			),
+			logger.Fields{"Id": command.ProductID},
+		)
+		return nil, err
+	}
+
+	return &productsService.DeleteProductRes{}, nil
+}
+
+func (s *ProductGrpcServiceServer) GetProducts(
+	ctx context.Context,
+	req *productsService.GetProductsReq,
+) (*productsService.GetProductsRes, error) {
+	s.catalogsMetrics.GetProductsGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Object("Request", req))
+
+	listQuery, err := newListQuery(req.GetPage(), req.GetSize(), req.GetOrderBy(), req.GetFilters())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_GetProducts.newListQuery] error in parsing the filters",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_GetProducts.newListQuery] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+	listQuery.After = req.GetAfter()
+	listQuery.Before = req.GetBefore()
+
+	query, err := getProductsQueryV1.NewGetProducts(
+		listQuery,
+	)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_GetProducts.StructCtx] query validation failed",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_GetProducts.StructCtx] err: %v",
+				validationErr,
+			),
+		)
+		return nil, validationErr
+	}
+
+	queryResult, err := mediatr.Send[*getProductsQueryV1.GetProducts, *getProductsDtosV1.GetProductsResponseDto](
+		ctx,
+		query,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_GetProducts.Send] error in sending GetProducts",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_GetProducts.Send] err: %v",
+				err,
+			),
+		)
+		return nil, err
+	}
+
+	products, err := mapper.Map[[]*productsService.Product](queryResult.Products.Items)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_GetProducts.Map] error in mapping products",
+		)
+		return nil, err
+	}
+
+	return &productsService.GetProductsRes{
+		Products:       products,
+		Page:           int32(queryResult.Products.Page),
+		Size:           int32(queryResult.Products.Size),
+		TotalItems:     queryResult.Products.TotalItems,
+		TotalPage:      int32(queryResult.Products.TotalPage),
+		PreviousCursor: queryResult.Products.PreviousCursor,
+		NextCursor:     queryResult.Products.NextCursor,
+	}, nil
+}
+
+func (s *ProductGrpcServiceServer) SearchProducts(
+	ctx context.Context,
+	req *productsService.SearchProductsReq,
+) (*productsService.SearchProductsRes, error) {
+	s.catalogsMetrics.SearchProductGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Object("Request", req))
+
+	listQuery, err := newListQuery(req.GetPage(), req.GetSize(), req.GetOrderBy(), req.GetFilters())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_SearchProducts.newListQuery] error in parsing the filters",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_SearchProducts.newListQuery] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	query, err := searchProductsQueryV1.NewSearchProductsWithValidation(
+		req.GetSearchText(),
+		listQuery,
+	)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_SearchProducts.StructCtx] query validation failed",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_SearchProducts.StructCtx] err: %v",
+				validationErr,
+			),
+		)
+		return nil, validationErr
+	}
+
+	queryResult, err := mediatr.Send[*searchProductsQueryV1.SearchProducts, *searchProductsDtosV1.SearchProductsResponseDto](
+		ctx,
+		query,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_SearchProducts.Send] error in sending SearchProducts",
+		)
+		s.logger.Errorw(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_SearchProducts.Send] searchText: {%s}, err: %v",
+				query.SearchText,
+				err,
+			),
+			logger.Fields{"SearchText": query.SearchText},
+		)
+		return nil, err
+	}
+
+	products, err := mapper.Map[[]*productsService.Product](queryResult.Products.Items)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_SearchProducts.Map] error in mapping products",
+		)
+		return nil, err
+	}
+
+	return &productsService.SearchProductsRes{
+		Products:   products,
+		Page:       int32(queryResult.Products.Page),
+		Size:       int32(queryResult.Products.Size),
+		TotalItems: queryResult.Products.TotalItems,
+		TotalPage:  int32(queryResult.Products.TotalPage),
+	}, nil
+}
+
+// WatchProducts streams the product changes of this instance until the client cancels the stream, the stream is ended by
+// the server when the client doesn't receive the changes fast enough, so the client should watch again
+func (s *ProductGrpcServiceServer) WatchProducts(
+	req *productsService.WatchProductsReq,
+	stream productsService.ProductsService_WatchProductsServer,
+) error {
+	ctx := stream.Context()
+	s.catalogsMetrics.WatchProductsGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+
+	changes := s.productChanges.Subscribe(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return customErrors.NewApplicationError(
+					"[ProductGrpcServiceServer_WatchProducts] watcher is too slow, watch the products again",
+				)
+			}
+
+			productChange, err := toProductChange(change)
+			if err != nil {
+				return errors.WithMessage(
+					err,
+					"[ProductGrpcServiceServer_WatchProducts.Map] error in mapping product change",
+				)
+			}
+
+			if err := stream.Send(productChange); err != nil {
+				s.logger.Errorw(
+					fmt.Sprintf(
+						"[ProductGrpcServiceServer_WatchProducts.Send] id: {%s}, err: %v",
+						change.ProductId,
+						err,
+					),
+					logger.Fields{"Id": change.ProductId},
+				)
+				return err
+			}
+		}
+	}
+}
+
+// newListQuery creates the list query of the grpc list requests, the default page and size are used for the zero values,
+// and the filters have the same `field:comparison:value` syntax as the query string filters
+func newListQuery(page int32, size int32, orderBy string, filters []string) (*utils.ListQuery, error) {
+	query := utils.NewListQueryFromQueryParams(
+		strconv.Itoa(int(size)),
+		strconv.Itoa(int(page)),
+	)
+	query.OrderBy = orderBy
+
+	filterModels, err := utils.ParseFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	query.Filters = filterModels
+
+	return query, nil
+}
+
+func toProductChange(change *models.ProductChange) (*productsService.ProductChange, error) {
+	product, err := mapper.Map[*productsService.Product](change.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	return &productsService.ProductChange{
+		ChangeType: toProductChangeType(change.ChangeType),
+		ProductId:  change.ProductId.String(),
+		Product:    product,
+		OccurredAt: timestamppb.New(change.OccurredAt),
+	}, nil
+}
+
+func toProductChangeType(changeType models.ProductChangeType) productsService.ProductChangeType {
+	switch changeType {
+	case models.ProductCreated:
+		return productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_CREATED
+	case models.ProductUpdated:
+		return productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_UPDATED
+	case models.ProductDeleted:
+		return productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_DELETED
+	default:
+		return productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_UNSPECIFIED
+	}
+}
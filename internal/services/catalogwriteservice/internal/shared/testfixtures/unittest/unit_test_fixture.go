@@ -15,7 +15,9 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/postgresgorm/helpers/gormextensions"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/changefeed"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/configurations/mappings"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/contracts"
 	datamodel "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/data/dbcontext"
 
@@ -35,6 +37,8 @@ type UnitTestSharedFixture struct {
 	suite.Suite
 	Products         []*datamodel.ProductDataModel
 	Bus              *mocks.Bus
+	MessageStore     *mocks.MessagePersistenceService
+	ProductChanges   contracts.ProductChangesFeed
 	Tracer           trace.Tracer
 	CatalogDBContext *dbcontext.CatalogsGormDBContext
 	Ctx              context.Context
@@ -103,6 +107,8 @@ func (c *UnitTestSharedFixture) SetupTest() {
 
 	c.setupBus()
 
+	c.ProductChanges = changefeed.NewInMemoryProductChangesFeed()
+
 	c.setupDB()
 
 	err := mappings.ConfigureProductsMappings()
@@ -123,6 +129,12 @@ func (c *UnitTestSharedFixture) setupBus() {
 	bus.On("PublishMessage", mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 	c.Bus = bus
+
+	messageStore := &mocks.MessagePersistenceService{}
+
+	messageStore.On("ScheduleInternalMessage", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	c.MessageStore = messageStore
 }
 
 func (c *UnitTestSharedFixture) setupDB() {
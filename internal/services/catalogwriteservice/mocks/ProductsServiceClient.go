@@ -65,7 +65,7 @@ type ProductsServiceClient_CreateProduct_Call struct {
 
 // CreateProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *products_service.CreateProductReq
+//   - in *productsservice.CreateProductReq
 //   - opts ...grpc.CallOption
 func (_e *ProductsServiceClient_Expecter) CreateProduct(ctx interface{}, in interface{}, opts ...interface{}) *ProductsServiceClient_CreateProduct_Call {
 	return &ProductsServiceClient_CreateProduct_Call{Call: _e.mock.On("CreateProduct",
@@ -95,6 +95,76 @@ func (_c *ProductsServiceClient_CreateProduct_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// DeleteProduct provides a mock function with given fields: ctx, in, opts
+func (_m *ProductsServiceClient) DeleteProduct(ctx context.Context, in *productsservice.DeleteProductReq, opts ...grpc.CallOption) (*productsservice.DeleteProductRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *productsservice.DeleteProductRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.DeleteProductReq, ...grpc.CallOption) (*productsservice.DeleteProductRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.DeleteProductReq, ...grpc.CallOption) *productsservice.DeleteProductRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*productsservice.DeleteProductRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *productsservice.DeleteProductReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductsServiceClient_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type ProductsServiceClient_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *productsservice.DeleteProductReq
+//   - opts ...grpc.CallOption
+func (_e *ProductsServiceClient_Expecter) DeleteProduct(ctx interface{}, in interface{}, opts ...interface{}) *ProductsServiceClient_DeleteProduct_Call {
+	return &ProductsServiceClient_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ProductsServiceClient_DeleteProduct_Call) Run(run func(ctx context.Context, in *productsservice.DeleteProductReq, opts ...grpc.CallOption)) *ProductsServiceClient_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*productsservice.DeleteProductReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ProductsServiceClient_DeleteProduct_Call) Return(_a0 *productsservice.DeleteProductRes, _a1 error) *ProductsServiceClient_DeleteProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductsServiceClient_DeleteProduct_Call) RunAndReturn(run func(context.Context, *productsservice.DeleteProductReq, ...grpc.CallOption) (*productsservice.DeleteProductRes, error)) *ProductsServiceClient_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProductById provides a mock function with given fields: ctx, in, opts
 func (_m *ProductsServiceClient) GetProductById(ctx context.Context, in *productsservice.GetProductByIdReq, opts ...grpc.CallOption) (*productsservice.GetProductByIdRes, error) {
 	_va := make([]interface{}, len(opts))
@@ -135,7 +205,7 @@ type ProductsServiceClient_GetProductById_Call struct {
 
 // GetProductById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *products_service.GetProductByIdReq
+//   - in *productsservice.GetProductByIdReq
 //   - opts ...grpc.CallOption
 func (_e *ProductsServiceClient_Expecter) GetProductById(ctx interface{}, in interface{}, opts ...interface{}) *ProductsServiceClient_GetProductById_Call {
 	return &ProductsServiceClient_GetProductById_Call{Call: _e.mock.On("GetProductById",
@@ -165,6 +235,146 @@ func (_c *ProductsServiceClient_GetProductById_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetProducts provides a mock function with given fields: ctx, in, opts
+func (_m *ProductsServiceClient) GetProducts(ctx context.Context, in *productsservice.GetProductsReq, opts ...grpc.CallOption) (*productsservice.GetProductsRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *productsservice.GetProductsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.GetProductsReq, ...grpc.CallOption) (*productsservice.GetProductsRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.GetProductsReq, ...grpc.CallOption) *productsservice.GetProductsRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*productsservice.GetProductsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *productsservice.GetProductsReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductsServiceClient_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
+type ProductsServiceClient_GetProducts_Call struct {
+	*mock.Call
+}
+
+// GetProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *productsservice.GetProductsReq
+//   - opts ...grpc.CallOption
+func (_e *ProductsServiceClient_Expecter) GetProducts(ctx interface{}, in interface{}, opts ...interface{}) *ProductsServiceClient_GetProducts_Call {
+	return &ProductsServiceClient_GetProducts_Call{Call: _e.mock.On("GetProducts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ProductsServiceClient_GetProducts_Call) Run(run func(ctx context.Context, in *productsservice.GetProductsReq, opts ...grpc.CallOption)) *ProductsServiceClient_GetProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*productsservice.GetProductsReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ProductsServiceClient_GetProducts_Call) Return(_a0 *productsservice.GetProductsRes, _a1 error) *ProductsServiceClient_GetProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductsServiceClient_GetProducts_Call) RunAndReturn(run func(context.Context, *productsservice.GetProductsReq, ...grpc.CallOption) (*productsservice.GetProductsRes, error)) *ProductsServiceClient_GetProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchProducts provides a mock function with given fields: ctx, in, opts
+func (_m *ProductsServiceClient) SearchProducts(ctx context.Context, in *productsservice.SearchProductsReq, opts ...grpc.CallOption) (*productsservice.SearchProductsRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *productsservice.SearchProductsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.SearchProductsReq, ...grpc.CallOption) (*productsservice.SearchProductsRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.SearchProductsReq, ...grpc.CallOption) *productsservice.SearchProductsRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*productsservice.SearchProductsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *productsservice.SearchProductsReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductsServiceClient_SearchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchProducts'
+type ProductsServiceClient_SearchProducts_Call struct {
+	*mock.Call
+}
+
+// SearchProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *productsservice.SearchProductsReq
+//   - opts ...grpc.CallOption
+func (_e *ProductsServiceClient_Expecter) SearchProducts(ctx interface{}, in interface{}, opts ...interface{}) *ProductsServiceClient_SearchProducts_Call {
+	return &ProductsServiceClient_SearchProducts_Call{Call: _e.mock.On("SearchProducts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ProductsServiceClient_SearchProducts_Call) Run(run func(ctx context.Context, in *productsservice.SearchProductsReq, opts ...grpc.CallOption)) *ProductsServiceClient_SearchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*productsservice.SearchProductsReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ProductsServiceClient_SearchProducts_Call) Return(_a0 *productsservice.SearchProductsRes, _a1 error) *ProductsServiceClient_SearchProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductsServiceClient_SearchProducts_Call) RunAndReturn(run func(context.Context, *productsservice.SearchProductsReq, ...grpc.CallOption) (*productsservice.SearchProductsRes, error)) *ProductsServiceClient_SearchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProduct provides a mock function with given fields: ctx, in, opts
 func (_m *ProductsServiceClient) UpdateProduct(ctx context.Context, in *productsservice.UpdateProductReq, opts ...grpc.CallOption) (*productsservice.UpdateProductRes, error) {
 	_va := make([]interface{}, len(opts))
@@ -205,7 +415,7 @@ type ProductsServiceClient_UpdateProduct_Call struct {
 
 // UpdateProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *products_service.UpdateProductReq
+//   - in *productsservice.UpdateProductReq
 //   - opts ...grpc.CallOption
 func (_e *ProductsServiceClient_Expecter) UpdateProduct(ctx interface{}, in interface{}, opts ...interface{}) *ProductsServiceClient_UpdateProduct_Call {
 	return &ProductsServiceClient_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct",
@@ -235,6 +445,76 @@ func (_c *ProductsServiceClient_UpdateProduct_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// WatchProducts provides a mock function with given fields: ctx, in, opts
+func (_m *ProductsServiceClient) WatchProducts(ctx context.Context, in *productsservice.WatchProductsReq, opts ...grpc.CallOption) (productsservice.ProductsService_WatchProductsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 productsservice.ProductsService_WatchProductsClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.WatchProductsReq, ...grpc.CallOption) (productsservice.ProductsService_WatchProductsClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.WatchProductsReq, ...grpc.CallOption) productsservice.ProductsService_WatchProductsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(productsservice.ProductsService_WatchProductsClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *productsservice.WatchProductsReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductsServiceClient_WatchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchProducts'
+type ProductsServiceClient_WatchProducts_Call struct {
+	*mock.Call
+}
+
+// WatchProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *productsservice.WatchProductsReq
+//   - opts ...grpc.CallOption
+func (_e *ProductsServiceClient_Expecter) WatchProducts(ctx interface{}, in interface{}, opts ...interface{}) *ProductsServiceClient_WatchProducts_Call {
+	return &ProductsServiceClient_WatchProducts_Call{Call: _e.mock.On("WatchProducts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ProductsServiceClient_WatchProducts_Call) Run(run func(ctx context.Context, in *productsservice.WatchProductsReq, opts ...grpc.CallOption)) *ProductsServiceClient_WatchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*productsservice.WatchProductsReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ProductsServiceClient_WatchProducts_Call) Return(_a0 productsservice.ProductsService_WatchProductsClient, _a1 error) *ProductsServiceClient_WatchProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductsServiceClient_WatchProducts_Call) RunAndReturn(run func(context.Context, *productsservice.WatchProductsReq, ...grpc.CallOption) (productsservice.ProductsService_WatchProductsClient, error)) *ProductsServiceClient_WatchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewProductsServiceClient creates a new instance of ProductsServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProductsServiceClient(t interface {
@@ -56,7 +56,7 @@ type ProductsServiceServer_CreateProduct_Call struct {
 
 // CreateProduct is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *products_service.CreateProductReq
+//   - _a1 *productsservice.CreateProductReq
 func (_e *ProductsServiceServer_Expecter) CreateProduct(_a0 interface{}, _a1 interface{}) *ProductsServiceServer_CreateProduct_Call {
 	return &ProductsServiceServer_CreateProduct_Call{Call: _e.mock.On("CreateProduct", _a0, _a1)}
 }
@@ -78,6 +78,61 @@ func (_c *ProductsServiceServer_CreateProduct_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// DeleteProduct provides a mock function with given fields: _a0, _a1
+func (_m *ProductsServiceServer) DeleteProduct(_a0 context.Context, _a1 *productsservice.DeleteProductReq) (*productsservice.DeleteProductRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *productsservice.DeleteProductRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.DeleteProductReq) (*productsservice.DeleteProductRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.DeleteProductReq) *productsservice.DeleteProductRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*productsservice.DeleteProductRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *productsservice.DeleteProductReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductsServiceServer_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type ProductsServiceServer_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *productsservice.DeleteProductReq
+func (_e *ProductsServiceServer_Expecter) DeleteProduct(_a0 interface{}, _a1 interface{}) *ProductsServiceServer_DeleteProduct_Call {
+	return &ProductsServiceServer_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", _a0, _a1)}
+}
+
+func (_c *ProductsServiceServer_DeleteProduct_Call) Run(run func(_a0 context.Context, _a1 *productsservice.DeleteProductReq)) *ProductsServiceServer_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*productsservice.DeleteProductReq))
+	})
+	return _c
+}
+
+func (_c *ProductsServiceServer_DeleteProduct_Call) Return(_a0 *productsservice.DeleteProductRes, _a1 error) *ProductsServiceServer_DeleteProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductsServiceServer_DeleteProduct_Call) RunAndReturn(run func(context.Context, *productsservice.DeleteProductReq) (*productsservice.DeleteProductRes, error)) *ProductsServiceServer_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProductById provides a mock function with given fields: _a0, _a1
 func (_m *ProductsServiceServer) GetProductById(_a0 context.Context, _a1 *productsservice.GetProductByIdReq) (*productsservice.GetProductByIdRes, error) {
 	ret := _m.Called(_a0, _a1)
@@ -111,7 +166,7 @@ type ProductsServiceServer_GetProductById_Call struct {
 
 // GetProductById is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *products_service.GetProductByIdReq
+//   - _a1 *productsservice.GetProductByIdReq
 func (_e *ProductsServiceServer_Expecter) GetProductById(_a0 interface{}, _a1 interface{}) *ProductsServiceServer_GetProductById_Call {
 	return &ProductsServiceServer_GetProductById_Call{Call: _e.mock.On("GetProductById", _a0, _a1)}
 }
@@ -133,6 +188,116 @@ func (_c *ProductsServiceServer_GetProductById_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetProducts provides a mock function with given fields: _a0, _a1
+func (_m *ProductsServiceServer) GetProducts(_a0 context.Context, _a1 *productsservice.GetProductsReq) (*productsservice.GetProductsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *productsservice.GetProductsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.GetProductsReq) (*productsservice.GetProductsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.GetProductsReq) *productsservice.GetProductsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*productsservice.GetProductsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *productsservice.GetProductsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductsServiceServer_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
+type ProductsServiceServer_GetProducts_Call struct {
+	*mock.Call
+}
+
+// GetProducts is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *productsservice.GetProductsReq
+func (_e *ProductsServiceServer_Expecter) GetProducts(_a0 interface{}, _a1 interface{}) *ProductsServiceServer_GetProducts_Call {
+	return &ProductsServiceServer_GetProducts_Call{Call: _e.mock.On("GetProducts", _a0, _a1)}
+}
+
+func (_c *ProductsServiceServer_GetProducts_Call) Run(run func(_a0 context.Context, _a1 *productsservice.GetProductsReq)) *ProductsServiceServer_GetProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*productsservice.GetProductsReq))
+	})
+	return _c
+}
+
+func (_c *ProductsServiceServer_GetProducts_Call) Return(_a0 *productsservice.GetProductsRes, _a1 error) *ProductsServiceServer_GetProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductsServiceServer_GetProducts_Call) RunAndReturn(run func(context.Context, *productsservice.GetProductsReq) (*productsservice.GetProductsRes, error)) *ProductsServiceServer_GetProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchProducts provides a mock function with given fields: _a0, _a1
+func (_m *ProductsServiceServer) SearchProducts(_a0 context.Context, _a1 *productsservice.SearchProductsReq) (*productsservice.SearchProductsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *productsservice.SearchProductsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.SearchProductsReq) (*productsservice.SearchProductsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *productsservice.SearchProductsReq) *productsservice.SearchProductsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*productsservice.SearchProductsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *productsservice.SearchProductsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductsServiceServer_SearchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchProducts'
+type ProductsServiceServer_SearchProducts_Call struct {
+	*mock.Call
+}
+
+// SearchProducts is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *productsservice.SearchProductsReq
+func (_e *ProductsServiceServer_Expecter) SearchProducts(_a0 interface{}, _a1 interface{}) *ProductsServiceServer_SearchProducts_Call {
+	return &ProductsServiceServer_SearchProducts_Call{Call: _e.mock.On("SearchProducts", _a0, _a1)}
+}
+
+func (_c *ProductsServiceServer_SearchProducts_Call) Run(run func(_a0 context.Context, _a1 *productsservice.SearchProductsReq)) *ProductsServiceServer_SearchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*productsservice.SearchProductsReq))
+	})
+	return _c
+}
+
+func (_c *ProductsServiceServer_SearchProducts_Call) Return(_a0 *productsservice.SearchProductsRes, _a1 error) *ProductsServiceServer_SearchProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductsServiceServer_SearchProducts_Call) RunAndReturn(run func(context.Context, *productsservice.SearchProductsReq) (*productsservice.SearchProductsRes, error)) *ProductsServiceServer_SearchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProduct provides a mock function with given fields: _a0, _a1
 func (_m *ProductsServiceServer) UpdateProduct(_a0 context.Context, _a1 *productsservice.UpdateProductReq) (*productsservice.UpdateProductRes, error) {
 	ret := _m.Called(_a0, _a1)
@@ -166,7 +331,7 @@ type ProductsServiceServer_UpdateProduct_Call struct {
 
 // UpdateProduct is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *products_service.UpdateProductReq
+//   - _a1 *productsservice.UpdateProductReq
 func (_e *ProductsServiceServer_Expecter) UpdateProduct(_a0 interface{}, _a1 interface{}) *ProductsServiceServer_UpdateProduct_Call {
 	return &ProductsServiceServer_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", _a0, _a1)}
 }
@@ -188,6 +353,49 @@ func (_c *ProductsServiceServer_UpdateProduct_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// WatchProducts provides a mock function with given fields: _a0, _a1
+func (_m *ProductsServiceServer) WatchProducts(_a0 *productsservice.WatchProductsReq, _a1 productsservice.ProductsService_WatchProductsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*productsservice.WatchProductsReq, productsservice.ProductsService_WatchProductsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductsServiceServer_WatchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchProducts'
+type ProductsServiceServer_WatchProducts_Call struct {
+	*mock.Call
+}
+
+// WatchProducts is a helper method to define mock.On call
+//   - _a0 *productsservice.WatchProductsReq
+//   - _a1 productsservice.ProductsService_WatchProductsServer
+func (_e *ProductsServiceServer_Expecter) WatchProducts(_a0 interface{}, _a1 interface{}) *ProductsServiceServer_WatchProducts_Call {
+	return &ProductsServiceServer_WatchProducts_Call{Call: _e.mock.On("WatchProducts", _a0, _a1)}
+}
+
+func (_c *ProductsServiceServer_WatchProducts_Call) Run(run func(_a0 *productsservice.WatchProductsReq, _a1 productsservice.ProductsService_WatchProductsServer)) *ProductsServiceServer_WatchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*productsservice.WatchProductsReq), args[1].(productsservice.ProductsService_WatchProductsServer))
+	})
+	return _c
+}
+
+func (_c *ProductsServiceServer_WatchProducts_Call) Return(_a0 error) *ProductsServiceServer_WatchProducts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductsServiceServer_WatchProducts_Call) RunAndReturn(run func(*productsservice.WatchProductsReq, productsservice.ProductsService_WatchProductsServer) error) *ProductsServiceServer_WatchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewProductsServiceServer creates a new instance of ProductsServiceServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProductsServiceServer(t interface {
@@ -0,0 +1,72 @@
+//go:build unit
+// +build unit
+
+package changefeed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/changefeed"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/models"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Publish_Should_Send_Change_To_All_Subscribers(t *testing.T) {
+	feed := changefeed.NewInMemoryProductChangesFeed()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := feed.Subscribe(ctx)
+	second := feed.Subscribe(ctx)
+
+	change := &models.ProductChange{
+		ChangeType: models.ProductDeleted,
+		ProductId:  uuid.NewV4(),
+		OccurredAt: time.Now(),
+	}
+	feed.Publish(change)
+
+	assert.Equal(t, change, <-first)
+	assert.Equal(t, change, <-second)
+}
+
+func Test_Subscribe_Should_Close_Channel_When_Context_Is_Done(t *testing.T) {
+	feed := changefeed.NewInMemoryProductChangesFeed()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := feed.Subscribe(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-changes:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		require.Fail(t, "subscriber channel is not closed")
+	}
+}
+
+func Test_Publish_Should_Drop_Slow_Subscriber(t *testing.T) {
+	feed := changefeed.NewInMemoryProductChangesFeed()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := feed.Subscribe(ctx)
+
+	// the subscriber doesn't receive any change, so its buffer is filled and it's dropped
+	for i := 0; i < 101; i++ {
+		feed.Publish(&models.ProductChange{ChangeType: models.ProductCreated, ProductId: uuid.NewV4()})
+	}
+
+	received := 0
+	for range changes {
+		received++
+	}
+	assert.Equal(t, 100, received)
+}
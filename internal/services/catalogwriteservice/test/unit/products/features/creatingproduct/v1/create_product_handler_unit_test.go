@@ -45,6 +45,7 @@ func (c *createProductHandlerUnitTests) SetupTest() {
 			CatalogsDBContext: c.CatalogDBContext,
 			Tracer:            c.Tracer,
 			RabbitmqProducer:  c.Bus,
+			ProductChanges:    c.ProductChanges,
 			Log:               c.Log,
 		},
 	)
@@ -66,6 +67,8 @@ func (c *createProductHandlerUnitTests) Test_Handle_Should_Create_New_Product_Wi
 		Price:       gofakeit.Price(100, 1000),
 	}
 
+	changes := c.ProductChanges.Subscribe(c.Ctx)
+
 	c.BeginTx()
 	_, err := c.handler.Handle(c.Ctx, createProduct)
 	c.CommitTx()
@@ -74,6 +77,11 @@ func (c *createProductHandlerUnitTests) Test_Handle_Should_Create_New_Product_Wi
 
 	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 1)
 
+	c.Require().Len(changes, 1)
+	change := <-changes
+	c.Assert().Equal(models.ProductCreated, change.ChangeType)
+	c.Assert().Equal(id, change.ProductId)
+
 	res, err := gormdbcontext.FindModelByID[*datamodels.ProductDataModel, *models.Product](
 		c.Ctx,
 		c.CatalogDBContext,
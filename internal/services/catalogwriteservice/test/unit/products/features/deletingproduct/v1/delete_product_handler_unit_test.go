@@ -6,6 +6,7 @@ package v1
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
@@ -13,6 +14,7 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
 	deletingproductv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1"
+	purgingproductv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/testfixtures/unittest"
 
 	"emperror.dev/errors"
@@ -44,6 +46,8 @@ func (c *deleteProductHandlerUnitTests) SetupTest() {
 			Log:               c.Log,
 			CatalogsDBContext: c.CatalogDBContext,
 			RabbitmqProducer:  c.Bus,
+			ProductChanges:    c.ProductChanges,
+			MessageStore:      c.MessageStore,
 			Tracer:            c.Tracer,
 		},
 	)
@@ -73,6 +77,15 @@ func (c *deleteProductHandlerUnitTests) Test_Handle_Should_Delete_Product_With_V
 	c.Require().Error(err)
 
 	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 1)
+
+	// the product is purged permanently by an internal command after the retention period
+	c.MessageStore.AssertCalled(
+		c.T(),
+		"ScheduleInternalMessage",
+		mock.MatchedBy(func(command *purgingproductv1.PurgeProduct) bool { return command.ProductID == id }),
+		mock.MatchedBy(func(processAt time.Time) bool { return processAt.After(time.Now()) }),
+		mock.Anything,
+	)
 }
 
 func (c *deleteProductHandlerUnitTests) Test_Handle_Should_Return_NotFound_Error_When_Id_Is_Invalid() {
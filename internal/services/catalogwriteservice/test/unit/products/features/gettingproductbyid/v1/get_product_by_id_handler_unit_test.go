@@ -38,6 +38,7 @@ func (c *getProductByIdHandlerTest) SetupTest() {
 			CatalogsDBContext: c.CatalogDBContext,
 			Tracer:            c.Tracer,
 			RabbitmqProducer:  c.Bus,
+			ProductChanges:    c.ProductChanges,
 			Log:               c.Log,
 		})
 }
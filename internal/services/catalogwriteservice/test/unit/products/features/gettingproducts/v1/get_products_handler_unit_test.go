@@ -5,6 +5,7 @@ package v1
 
 import (
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
@@ -41,6 +42,7 @@ func (c *getProductsHandlerUnitTests) SetupTest() {
 			CatalogsDBContext: c.CatalogDBContext,
 			Tracer:            c.Tracer,
 			RabbitmqProducer:  c.Bus,
+			ProductChanges:    c.ProductChanges,
 			Log:               c.Log,
 		})
 }
@@ -61,6 +63,102 @@ func (c *getProductsHandlerUnitTests) Test_Handle_Should_Return_Products_Success
 	c.Equal(len(c.Products), len(res.Products.Items))
 }
 
+func (c *getProductsHandlerUnitTests) Test_Handle_Should_Return_Filtered_And_Sorted_Products() {
+	cheapest := c.Products[0]
+	for _, product := range c.Products {
+		if product.Price < cheapest.Price {
+			cheapest = product
+		}
+	}
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.OrderBy = "-price"
+	listQuery.Filters = []*utils.FilterModel{
+		{Field: "price", Comparison: "gt", Value: strconv.FormatFloat(cheapest.Price, 'f', -1, 64)},
+	}
+
+	query, err := gettingproductsv1.NewGetProducts(listQuery)
+	c.Require().NoError(err)
+
+	res, err := c.handler.Handle(c.Ctx, query)
+	c.Require().NoError(err)
+	c.Equal(int64(len(c.Products)-1), res.Products.TotalItems)
+	c.Require().Len(res.Products.Items, len(c.Products)-1)
+
+	for i, product := range res.Products.Items {
+		c.NotEqual(cheapest.Id, product.Id)
+		if i > 0 {
+			c.LessOrEqual(product.Price, res.Products.Items[i-1].Price)
+		}
+	}
+}
+
+func (c *getProductsHandlerUnitTests) Test_Handle_Should_Page_Products_With_Cursors() {
+	listQuery := utils.NewListQuery(1, 1)
+	listQuery.OrderBy = "-price"
+
+	var pages []*dtos.GetProductsResponseDto
+	for {
+		query, err := gettingproductsv1.NewGetProducts(listQuery)
+		c.Require().NoError(err)
+
+		res, err := c.handler.Handle(c.Ctx, query)
+		c.Require().NoError(err)
+		c.Require().Len(res.Products.Items, 1)
+		c.Equal(int64(len(c.Products)), res.Products.TotalItems)
+		pages = append(pages, res)
+
+		if res.Products.NextCursor == "" {
+			break
+		}
+		c.Require().Less(len(pages), len(c.Products))
+
+		listQuery = utils.NewListQuery(1, 1)
+		listQuery.OrderBy = "-price"
+		listQuery.After = res.Products.NextCursor
+	}
+
+	c.Require().Len(pages, len(c.Products))
+	c.Empty(pages[0].Products.PreviousCursor)
+	for i := 1; i < len(pages); i++ {
+		c.LessOrEqual(pages[i].Products.Items[0].Price, pages[i-1].Products.Items[0].Price)
+	}
+
+	// going back from the last page returns the page before it
+	listQuery = utils.NewListQuery(1, 1)
+	listQuery.OrderBy = "-price"
+	listQuery.Before = pages[len(pages)-1].Products.PreviousCursor
+
+	query, err := gettingproductsv1.NewGetProducts(listQuery)
+	c.Require().NoError(err)
+
+	res, err := c.handler.Handle(c.Ctx, query)
+	c.Require().NoError(err)
+	c.Require().Len(res.Products.Items, 1)
+	c.Equal(pages[len(pages)-2].Products.Items[0].Id, res.Products.Items[0].Id)
+	c.Equal(pages[len(pages)-2].Products.PreviousCursor, res.Products.PreviousCursor)
+}
+
+func (c *getProductsHandlerUnitTests) Test_New_Get_Products_Should_Return_Validation_Error_For_Invalid_Cursor() {
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.After = "invalid"
+
+	query, err := gettingproductsv1.NewGetProducts(listQuery)
+	c.Require().Error(err)
+	c.True(customErrors.IsValidationError(err))
+	c.Nil(query)
+}
+
+func (c *getProductsHandlerUnitTests) Test_New_Get_Products_Should_Return_Validation_Error_For_Not_Allowed_Field() {
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.Filters = []*utils.FilterModel{{Field: "password", Comparison: "eq", Value: "secret"}}
+
+	query, err := gettingproductsv1.NewGetProducts(listQuery)
+	c.Require().Error(err)
+	c.True(customErrors.IsValidationError(err))
+	c.Nil(query)
+}
+
 func (c *getProductsHandlerUnitTests) Test_Handle_Should_Return_Error_For_Mapping_List_Result() {
 	query, err := gettingproductsv1.NewGetProducts(utils.NewListQuery(10, 1))
 	c.Require().NoError(err)
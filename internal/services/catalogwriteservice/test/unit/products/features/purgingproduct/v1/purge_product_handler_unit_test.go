@@ -0,0 +1,111 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	purgingproductv1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/catalogwriteservice/internal/shared/testfixtures/unittest"
+
+	"github.com/goccy/go-json"
+	"github.com/mehdihadeli/go-mediatr"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type purgeProductHandlerUnitTests struct {
+	*unittest.UnitTestSharedFixture
+	handler cqrs.RequestHandlerWithRegisterer[*purgingproductv1.PurgeProduct, *mediatr.Unit]
+}
+
+func TestPurgeProductHandlerUnit(t *testing.T) {
+	suite.Run(
+		t,
+		&purgeProductHandlerUnitTests{
+			UnitTestSharedFixture: unittest.NewUnitTestSharedFixture(t),
+		},
+	)
+}
+
+func (c *purgeProductHandlerUnitTests) SetupTest() {
+	// call base SetupTest hook before running child hook
+	c.UnitTestSharedFixture.SetupTest()
+	c.handler = purgingproductv1.NewPurgeProductHandler(
+		fxparams.ProductHandlerParams{
+			Log:               c.Log,
+			CatalogsDBContext: c.CatalogDBContext,
+			RabbitmqProducer:  c.Bus,
+			ProductChanges:    c.ProductChanges,
+			MessageStore:      c.MessageStore,
+			Tracer:            c.Tracer,
+		},
+	)
+}
+
+func (c *purgeProductHandlerUnitTests) TearDownTest() {
+	// call base TearDownTest hook before running child hook
+	c.UnitTestSharedFixture.TearDownTest()
+
+	mediatr.ClearRequestRegistrations()
+	cqrs.ClearInternalCommandRegistrations()
+}
+
+func (c *purgeProductHandlerUnitTests) Test_Handle_Should_Purge_Soft_Deleted_Product() {
+	id := c.Products[0].Id
+
+	err := c.CatalogDBContext.DB().Delete(&datamodels.ProductDataModel{}, id).Error
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, purgingproductv1.NewPurgeProduct(id))
+	c.CommitTx()
+
+	c.Require().NoError(err)
+	c.Assert().Equal(int64(0), c.countProductsWithDeleted(id))
+}
+
+func (c *purgeProductHandlerUnitTests) Test_Handle_Should_Keep_Not_Deleted_Product() {
+	id := c.Products[0].Id
+
+	c.BeginTx()
+	_, err := c.handler.Handle(c.Ctx, purgingproductv1.NewPurgeProduct(id))
+	c.CommitTx()
+
+	c.Require().NoError(err)
+	c.Assert().Equal(int64(1), c.countProductsWithDeleted(id))
+}
+
+func (c *purgeProductHandlerUnitTests) Test_RegisterHandler_Should_Register_Internal_Command_For_Stored_Commands() {
+	err := c.handler.RegisterHandler()
+	c.Require().NoError(err)
+
+	id := c.Products[0].Id
+	err = c.CatalogDBContext.DB().Delete(&datamodels.ProductDataModel{}, id).Error
+	c.Require().NoError(err)
+
+	// the message dispatcher creates the stored command by its type name and sends it through mediatr
+	data, err := json.Marshal(purgingproductv1.NewPurgeProduct(id))
+	c.Require().NoError(err)
+
+	command, err := cqrs.NewInternalCommandInstance("*v1.PurgeProduct")
+	c.Require().NoError(err)
+	c.Require().NoError(json.Unmarshal(data, command))
+
+	err = cqrs.SendInternalCommand(c.Ctx, command)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(int64(0), c.countProductsWithDeleted(id))
+}
+
+func (c *purgeProductHandlerUnitTests) countProductsWithDeleted(id uuid.UUID) int64 {
+	var count int64
+	err := c.CatalogDBContext.DB().Unscoped().Model(&datamodels.ProductDataModel{}).Where("id = ?", id).Count(&count).Error
+	c.Require().NoError(err)
+
+	return count
+}
@@ -38,6 +38,7 @@ func (c *searchProductsHandlerUnitTests) SetupTest() {
 			CatalogsDBContext: c.CatalogDBContext,
 			Tracer:            c.Tracer,
 			RabbitmqProducer:  c.Bus,
+			ProductChanges:    c.ProductChanges,
 			Log:               c.Log,
 		})
 }
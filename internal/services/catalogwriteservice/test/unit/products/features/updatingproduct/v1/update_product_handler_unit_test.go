@@ -46,6 +46,7 @@ func (c *updateProductHandlerUnitTests) SetupTest() {
 			CatalogsDBContext: c.CatalogDBContext,
 			Tracer:            c.Tracer,
 			RabbitmqProducer:  c.Bus,
+			ProductChanges:    c.ProductChanges,
 			Log:               c.Log,
 		},
 	)
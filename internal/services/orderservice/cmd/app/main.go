@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"os"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/app"
 
 	"github.com/pterm/pterm"
@@ -10,16 +12,80 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var rootCmd = &cobra.Command{
-	Use:              "orders-microservice",
-	Short:            "orders-microservice based on vertical slice architecture",
-	Long:             `This is a command runner or cli for api architecture in golang.`,
-	TraverseChildren: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		app.NewApp().Run()
-	},
+func init() {
+	rebuildProjectionsCmd.Flags().
+		String("subscription-id", "", "Subscription id for resetting its checkpoint, the configured subscription is used by default")
+	rebuildProjectionsCmd.Flags().
+		StringSlice("projections", nil, "Projections for rebuilding like mongo-orders,elastic-orders, all projections are rebuilt by default")
+	rebuildProjectionsCmd.Flags().
+		Bool("truncate", false, "Truncate the read models of the projections before replaying the events")
+
+	rootCmd.AddCommand(rebuildProjectionsCmd)
 }
 
+var (
+	rootCmd = &cobra.Command{ //nolint:gochecknoglobals
+		Use:              "orders-microservice",
+		Short:            "orders-microservice based on vertical slice architecture",
+		Long:             `This is a command runner or cli for api architecture in golang.`,
+		TraverseChildren: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			app.NewApp().Run()
+		},
+	}
+
+	rebuildProjectionsCmd = &cobra.Command{ //nolint:gochecknoglobals
+		Use:   "rebuild-projections",
+		Short: "Rebuild the order projections by replaying all events, the running service instances should be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			subscriptionId, err := cmd.Flags().GetString("subscription-id")
+			if err != nil {
+				return err
+			}
+
+			projections, err := cmd.Flags().GetStringSlice("projections")
+			if err != nil {
+				return err
+			}
+
+			truncate, err := cmd.Flags().GetBool("truncate")
+			if err != nil {
+				return err
+			}
+
+			result, err := app.NewApp().RebuildProjections(
+				context.Background(),
+				&eventstroredb.RebuildProjectionsOptions{
+					SubscriptionId:     subscriptionId,
+					Projections:        projections,
+					TruncateReadModels: truncate,
+					OnProgress: func(progress *eventstroredb.RebuildProjectionsProgress) {
+						pterm.Info.Printfln(
+							"%d events replayed, position %d of %d",
+							progress.ProcessedEvents,
+							progress.Position,
+							progress.LivePosition,
+						)
+					},
+				},
+			)
+			if err != nil {
+				pterm.Error.Printfln("rebuilding projections failed: %v", err)
+				return err
+			}
+
+			pterm.Success.Printfln(
+				"projections %v of subscription '%s' rebuilt with %d events",
+				result.Projections,
+				result.SubscriptionId,
+				result.ProcessedEvents,
+			)
+
+			return nil
+		},
+	}
+)
+
 // https://github.com/swaggo/swag#how-to-use-it-with-gin
 
 // @contact.name Mehdi Hadeli
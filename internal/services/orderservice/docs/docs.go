@@ -1,5 +1,4 @@
-// Code generated by swaggo/swag. DO NOT EDIT.
-
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
 package docs
 
 import "github.com/swaggo/swag"
@@ -33,6 +32,26 @@ const docTemplate = `{
                 ],
                 "summary": "Get all orders",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "After and Before are the cursors of the list results for the keyset pagination, the page is ignored with a cursor",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "filters",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "name": "orderBy",
@@ -91,6 +110,85 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/orders/projections/rebuild": {
+            "post": {
+                "description": "Reset the subscription checkpoint and replay all events through the selected order projections, then continue the live subscription",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Rebuild order projections",
+                "parameters": [
+                    {
+                        "description": "RebuildProjections data",
+                        "name": "RebuildProjectionsRequestDto",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_rebuilding_projections_v1_dtos.RebuildProjectionsRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_rebuilding_projections_v1_dtos.RebuildProjectionsResponseDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/search": {
+            "get": {
+                "description": "Full-text search orders by account email, delivery address and item titles, filtered by status and creation date range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Search orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "toDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_searching_orders_v1_dtos.SearchOrdersResponseDto"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/orders/{id}": {
             "get": {
                 "description": "Get order by id",
@@ -122,6 +220,169 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/api/v1/orders/{id}/cancel": {
+            "post": {
+                "description": "Cancel an existing order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Cancel order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CancelOrder data",
+                        "name": "CancelOrderRequestDto",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_canceling_order_v1_dtos.CancelOrderRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/complete": {
+            "post": {
+                "description": "Complete an existing order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Complete order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/pay": {
+            "post": {
+                "description": "Pay an existing order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Pay order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "PayOrder data",
+                        "name": "PayOrderRequestDto",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_paying_order_v1_dtos.PayOrderRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/ship": {
+            "post": {
+                "description": "Ship an existing order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Ship order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/submit": {
+            "post": {
+                "description": "Submit an existing order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Submit order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
         }
     },
     "definitions": {
@@ -161,12 +422,18 @@ const docTemplate = `{
                 "paymentId": {
                     "type": "string"
                 },
+                "shipped": {
+                    "type": "boolean"
+                },
                 "shopItems": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_dtos_v1.ShopItemReadDto"
                     }
                 },
+                "status": {
+                    "type": "string"
+                },
                 "submitted": {
                     "type": "boolean"
                 },
@@ -212,6 +479,14 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_canceling_order_v1_dtos.CancelOrderRequestDto": {
+            "type": "object",
+            "properties": {
+                "cancelReason": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_creating_order_v1_dtos.CreateOrderRequestDto": {
             "type": "object",
             "properties": {
@@ -256,17 +531,85 @@ const docTemplate = `{
                 }
             }
         },
-        "utils.FilterModel": {
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_paying_order_v1_dtos.PayOrderRequestDto": {
+            "type": "object",
+            "properties": {
+                "paymentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_rebuilding_projections_v1_dtos.RebuildProjectionsRequestDto": {
+            "type": "object",
+            "properties": {
+                "projections": {
+                    "description": "Projections are the projection names like ` + "`" + `mongo-orders` + "`" + ` and ` + "`" + `elastic-orders` + "`" + `, all projections are rebuilt when it is empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subscriptionId": {
+                    "description": "SubscriptionId is the subscription which its checkpoint will reset, the configured subscription is used when it is empty",
+                    "type": "string"
+                },
+                "truncateReadModels": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_rebuilding_projections_v1_dtos.RebuildProjectionsResponseDto": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "type": "integer"
+                },
+                "processedEvents": {
+                    "type": "integer"
+                },
+                "projections": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subscriptionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_features_searching_orders_v1_dtos.SearchOrdersResponseDto": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "$ref": "#/definitions/utils.ListResult-github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_dtos_v1_OrderReadDto"
+                }
+            }
+        },
+        "utils.ListQuery": {
             "type": "object",
             "properties": {
-                "comparison": {
+                "after": {
+                    "description": "After and Before are the cursors of the list results for the keyset pagination, the page is ignored with a cursor",
                     "type": "string"
                 },
-                "field": {
+                "before": {
                     "type": "string"
                 },
-                "value": {
+                "filters": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "orderBy": {
                     "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
                 }
             }
         },
@@ -279,9 +622,16 @@ const docTemplate = `{
                         "$ref": "#/definitions/github_com_mehdihadeli_go-food-delivery-microservices_internal_services_orderservice_internal_orders_dtos_v1.OrderReadDto"
                     }
                 },
+                "nextCursor": {
+                    "type": "string"
+                },
                 "page": {
                     "type": "integer"
                 },
+                "previousCursor": {
+                    "description": "PreviousCursor and NextCursor are the ` + "`" + `before` + "`" + ` and the ` + "`" + `after` + "`" + ` cursors of the pages around the items, they are\nempty when there is no page",
+                    "type": "string"
+                },
                 "size": {
                     "type": "integer"
                 },
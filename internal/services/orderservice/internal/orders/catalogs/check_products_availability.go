@@ -0,0 +1,26 @@
+package catalogs
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+)
+
+// CheckProductsAvailabilityV1 is the request of the catalog service for checking the availability of the products, its response
+// is a `ProductsAvailabilityV1`
+type CheckProductsAvailabilityV1 struct {
+	*types.Message
+	ProductIds []string `json:"productIds"`
+}
+
+// ProductsAvailabilityV1 is the response of the catalog service, the error is set when the catalog service rejected the request
+type ProductsAvailabilityV1 struct {
+	*types.Message
+	Products []*ProductAvailability `json:"products"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+type ProductAvailability struct {
+	ProductId string  `json:"productId"`
+	Name      string  `json:"name,omitempty"`
+	Price     float64 `json:"price,omitempty"`
+	Available bool    `json:"available"`
+}
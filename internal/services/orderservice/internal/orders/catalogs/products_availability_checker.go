@@ -0,0 +1,63 @@
+package catalogs
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/catalogs"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+const checkProductsAvailabilityTimeout = 10 * time.Second
+
+type productsAvailabilityChecker struct {
+	bus    bus.Bus
+	logger logger.Logger
+}
+
+// NewProductsAvailabilityChecker creates a checker which requests the availability of the products from the catalog service over
+// the bus, so the order service doesn't depend on the catalog service apis
+func NewProductsAvailabilityChecker(bus bus.Bus, logger logger.Logger) catalogs.ProductsAvailabilityChecker {
+	return &productsAvailabilityChecker{bus: bus, logger: logger}
+}
+
+func (p *productsAvailabilityChecker) CheckProductsAvailability(
+	ctx context.Context,
+	productIds []string,
+) (map[string]bool, error) {
+	request := &CheckProductsAvailabilityV1{
+		Message:    types.NewMessage(uuid.NewV4().String()),
+		ProductIds: productIds,
+	}
+
+	response, err := bus.Request[*CheckProductsAvailabilityV1, *ProductsAvailabilityV1](
+		ctx,
+		p.bus,
+		request,
+		checkProductsAvailabilityTimeout,
+	)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error in requesting the products availability from the catalog service")
+	}
+	if response.Error != "" {
+		return nil, errors.Errorf("the catalog service rejected the products availability request: %s", response.Error)
+	}
+
+	availability := make(map[string]bool, len(productIds))
+	for _, productId := range productIds {
+		availability[productId] = false
+	}
+
+	for _, product := range response.Products {
+		availability[product.ProductId] = product.Available
+	}
+
+	p.logger.Infow("products availability received from the catalog service", logger.Fields{"ProductIds": productIds})
+
+	return availability, nil
+}
@@ -0,0 +1,72 @@
+package catalogs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+	inMemoryBus "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/test/in-memory"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCatalogConsumer struct {
+	products map[string]bool
+}
+
+func (f *fakeCatalogConsumer) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	request := consumeContext.Message().(*CheckProductsAvailabilityV1)
+
+	response := &ProductsAvailabilityV1{Message: types.NewMessage(uuid.NewV4().String())}
+	for _, productId := range request.ProductIds {
+		response.Products = append(
+			response.Products,
+			&ProductAvailability{ProductId: productId, Available: f.products[productId]},
+		)
+	}
+
+	return consumeContext.Respond(ctx, response)
+}
+
+func Test_Check_Products_Availability_From_Catalog(t *testing.T) {
+	bus := inMemoryBus.NewRabbitmqInMemoryHarnesses(empty.EmptyLogger, nil)
+	err := bus.ConnectConsumerHandler(
+		&CheckProductsAvailabilityV1{},
+		&fakeCatalogConsumer{products: map[string]bool{"1": true}},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, bus.Start(context.Background()))
+	defer bus.Stop()
+
+	checker := NewProductsAvailabilityChecker(bus, empty.EmptyLogger)
+	availability, err := checker.CheckProductsAvailability(context.Background(), []string{"1", "2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"1": true, "2": false}, availability)
+}
+
+type rejectingCatalogConsumer struct{}
+
+func (r *rejectingCatalogConsumer) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	return consumeContext.Respond(ctx, &ProductsAvailabilityV1{
+		Message: types.NewMessage(uuid.NewV4().String()),
+		Error:   "product ids are required",
+	})
+}
+
+func Test_Check_Products_Availability_Should_Return_Error_Of_Rejected_Request(t *testing.T) {
+	bus := inMemoryBus.NewRabbitmqInMemoryHarnesses(empty.EmptyLogger, nil)
+	require.NoError(t, bus.ConnectConsumerHandler(&CheckProductsAvailabilityV1{}, &rejectingCatalogConsumer{}))
+
+	require.NoError(t, bus.Start(context.Background()))
+	defer bus.Stop()
+
+	checker := NewProductsAvailabilityChecker(bus, empty.EmptyLogger)
+	_, err := checker.CheckProductsAvailability(context.Background(), []string{"1"})
+
+	assert.ErrorContains(t, err, "product ids are required")
+}
@@ -81,6 +81,8 @@ func ConfigureOrdersMappings() error {
 				Completed:       orderReadDto.Completed,
 				Paid:            orderReadDto.Paid,
 				Submitted:       orderReadDto.Submitted,
+				Shipped:         orderReadDto.Shipped,
+				Status:          orderReadDto.Status,
 				CancelReason:    orderReadDto.CancelReason,
 				ShopItems:       items,
 				CreatedAt:       timestamppb.New(orderReadDto.CreatedAt),
@@ -185,6 +187,7 @@ func ConfigureOrdersMappings() error {
 				Paid:            order.Paid(),
 				CancelReason:    order.CancelReason(),
 				Submitted:       order.Submitted(),
+				Shipped:         order.Shipped(),
 				TotalPrice:      order.TotalPrice(),
 				CreatedAt:       timestamppb.New(order.CreatedAt()),
 				UpdatedAt:       timestamppb.New(order.UpdatedAt()),
@@ -205,10 +208,13 @@ func ConfigureOrdersMappings() error {
 			}
 			return &grpcOrderService.GetOrdersRes{
 				Pagination: &grpcOrderService.Pagination{
-					Size:       int32(orders.Size),
-					Page:       int32(orders.Page),
-					TotalItems: orders.TotalItems,
-					TotalPages: int32(orders.TotalPage),
+					Size:           int32(orders.Size),
+					Page:           int32(orders.Page),
+					TotalItems:     orders.TotalItems,
+					TotalPages:     int32(orders.TotalPage),
+					HasMore:        orders.NextCursor != "",
+					PreviousCursor: orders.PreviousCursor,
+					NextCursor:     orders.NextCursor,
 				},
 				Orders: o,
 			}
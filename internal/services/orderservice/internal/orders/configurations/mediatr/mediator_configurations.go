@@ -1,16 +1,28 @@
 package mediatr
 
 import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/catalogs"
 	repositories2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/repositories"
+	cancelOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/commands"
+	completeOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/completing_order/v1/commands"
 	createOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/commands"
 	createOrderDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/dtos"
 	getOrderByIdDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_order_by_id/v1/dtos"
 	getOrderByIdQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_order_by_id/v1/queries"
 	getOrdersDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_orders/v1/dtos"
 	getOrdersQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_orders/v1/queries"
+	payOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/paying_order/v1/commands"
+	rebuildProjectionsCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/rebuilding_projections/v1/commands"
+	rebuildProjectionsDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/rebuilding_projections/v1/dtos"
+	searchOrdersDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/searching_orders/v1/dtos"
+	searchOrdersQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/searching_orders/v1/queries"
+	shipOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/shipping_order/v1/commands"
+	submitOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/submitting_order/v1/commands"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/aggregate"
 
 	"github.com/mehdihadeli/go-mediatr"
@@ -19,17 +31,61 @@ import (
 func ConfigOrdersMediator(
 	logger logger.Logger,
 	mongoOrderReadRepository repositories2.OrderMongoRepository,
+	elasticOrderReadRepository repositories2.OrderElasticRepository,
 	orderAggregateStore store.AggregateStore[*aggregate.Order],
+	subscriptionAllWorker eventstroredb.EsdbSubscriptionAllWorker,
 	tracer tracing.AppTracer,
+	productsAvailabilityChecker catalogs.ProductsAvailabilityChecker,
 ) error {
 	// https://stackoverflow.com/questions/72034479/how-to-implement-generic-interfaces
 	err := mediatr.RegisterRequestHandler[*createOrderCommandV1.CreateOrder, *createOrderDtosV1.CreateOrderResponseDto](
-		createOrderCommandV1.NewCreateOrderHandler(logger, orderAggregateStore, tracer),
+		createOrderCommandV1.NewCreateOrderHandler(logger, orderAggregateStore, tracer, productsAvailabilityChecker),
 	)
 	if err != nil {
 		return err
 	}
 
+	err = mediatr.RegisterRequestHandler[*submitOrderCommandV1.SubmitOrder, *mediatr.Unit](
+		submitOrderCommandV1.NewSubmitOrderHandler(logger, orderAggregateStore),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*payOrderCommandV1.PayOrder, *mediatr.Unit](
+		payOrderCommandV1.NewPayOrderHandler(logger, orderAggregateStore),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*shipOrderCommandV1.ShipOrder, *mediatr.Unit](
+		shipOrderCommandV1.NewShipOrderHandler(logger, orderAggregateStore),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*completeOrderCommandV1.CompleteOrder, *mediatr.Unit](
+		completeOrderCommandV1.NewCompleteOrderHandler(logger, orderAggregateStore),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*cancelOrderCommandV1.CancelOrder, *mediatr.Unit](
+		cancelOrderCommandV1.NewCancelOrderHandler(logger, orderAggregateStore),
+	)
+	if err != nil {
+		return err
+	}
+
+	// the order fulfillment saga stores the cancel command in the message store for dispatching it after its commit
+	err = cqrs.RegisterInternalCommand[*cancelOrderCommandV1.CancelOrder, *mediatr.Unit]()
+	if err != nil {
+		return err
+	}
+
 	err = mediatr.RegisterRequestHandler[*getOrderByIdQueryV1.GetOrderById, *getOrderByIdDtosV1.GetOrderByIdResponseDto](
 		getOrderByIdQueryV1.NewGetOrderByIdHandler(logger, mongoOrderReadRepository, tracer),
 	)
@@ -44,5 +100,19 @@ func ConfigOrdersMediator(
 		return err
 	}
 
+	err = mediatr.RegisterRequestHandler[*searchOrdersQueryV1.SearchOrders, *searchOrdersDtosV1.SearchOrdersResponseDto](
+		searchOrdersQueryV1.NewSearchOrdersHandler(logger, elasticOrderReadRepository, tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*rebuildProjectionsCommandV1.RebuildProjections, *rebuildProjectionsDtosV1.RebuildProjectionsResponseDto](
+		rebuildProjectionsCommandV1.NewRebuildProjectionsHandler(logger, subscriptionAllWorker, tracer),
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
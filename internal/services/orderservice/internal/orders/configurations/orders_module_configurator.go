@@ -3,6 +3,7 @@ package configurations
 import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb"
 	contracts2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/fxapp/contracts"
 	grpcServer "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/grpc"
 	echocontracts "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/customecho/contracts"
@@ -10,6 +11,7 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/configurations/mappings"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/configurations/mediatr"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/catalogs"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/repositories"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/aggregate"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/contracts"
@@ -37,8 +39,11 @@ func (c *OrdersModuleConfigurator) ConfigureOrdersModule() {
 		func(logger logger.Logger,
 			server echocontracts.EchoHttpServer,
 			orderRepository repositories.OrderMongoRepository,
+			orderElasticRepository repositories.OrderElasticRepository,
 			orderAggregateStore store.AggregateStore[*aggregate.Order],
+			subscriptionAllWorker eventstroredb.EsdbSubscriptionAllWorker,
 			tracer tracing.AppTracer,
+			productsAvailabilityChecker catalogs.ProductsAvailabilityChecker,
 		) error {
 			// config Orders Mappings
 			err := mappings.ConfigureOrdersMappings()
@@ -47,7 +52,15 @@ func (c *OrdersModuleConfigurator) ConfigureOrdersModule() {
 			}
 
 			// config Orders Mediators
-			err = mediatr.ConfigOrdersMediator(logger, orderRepository, orderAggregateStore, tracer)
+			err = mediatr.ConfigOrdersMediator(
+				logger,
+				orderRepository,
+				orderElasticRepository,
+				orderAggregateStore,
+				subscriptionAllWorker,
+				tracer,
+				productsAvailabilityChecker,
+			)
 			if err != nil {
 				return err
 			}
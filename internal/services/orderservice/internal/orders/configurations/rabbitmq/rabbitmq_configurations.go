@@ -3,7 +3,9 @@ package rabbitmq
 import (
 	rabbitmqConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/configurations"
 	producerConfigurations "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/producer/configurations"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/catalogs"
 	createOrderIntegrationEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/events/integration_events"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/sagas"
 )
 
 func ConfigOrdersRabbitMQ(builder rabbitmqConfigurations.RabbitMQConfigurationBuilder) {
@@ -14,4 +16,33 @@ func ConfigOrdersRabbitMQ(builder rabbitmqConfigurations.RabbitMQConfigurationBu
 		createOrderIntegrationEventsV1.OrderCreatedV1{},
 		func(builder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
 		})
+
+	// the requests of the catalog service, their responses are consumed from the reply queue of the bus
+	builder.AddProducer(
+		catalogs.CheckProductsAvailabilityV1{},
+		func(builder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+		})
+
+	// the commands of the order fulfillment saga, they are published by the outbox dispatcher when the saga is registered
+	builder.
+		AddProducer(
+			sagas.ReserveProductsStockV1{},
+			func(builder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+			}).
+		AddProducer(
+			sagas.ReleaseProductsStockV1{},
+			func(builder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+			}).
+		AddProducer(
+			sagas.ProcessOrderPaymentV1{},
+			func(builder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+			}).
+		AddProducer(
+			sagas.RefundOrderPaymentV1{},
+			func(builder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+			}).
+		AddProducer(
+			sagas.ConfirmOrderShippingV1{},
+			func(builder producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+			})
 }
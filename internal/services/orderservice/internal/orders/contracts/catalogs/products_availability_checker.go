@@ -0,0 +1,11 @@
+package catalogs
+
+import (
+	"context"
+)
+
+// ProductsAvailabilityChecker asks the catalog service about the availability of the products
+type ProductsAvailabilityChecker interface {
+	// CheckProductsAvailability returns the availability of the products by their ids
+	CheckProductsAvailability(ctx context.Context, productIds []string) (map[string]bool, error)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
@@ -30,10 +31,26 @@ type orderReadRepository interface {
 		order *read_models.OrderReadModel,
 	) (*read_models.OrderReadModel, error)
 	DeleteOrderByID(ctx context.Context, uuid uuid.UUID) error
+	// DeleteAllOrders removes all the orders from the read model, before rebuilding the projections
+	DeleteAllOrders(ctx context.Context) error
+}
+
+// OrderSearchCriteria is the full-text search term and the filters for searching the orders, empty fields are ignored
+type OrderSearchCriteria struct {
+	SearchText string
+	Status     read_models.OrderStatus
+	FromDate   time.Time
+	ToDate     time.Time
 }
 
 type OrderElasticRepository interface {
 	orderReadRepository
+	// FullTextSearchOrders searches the account email, delivery address and item titles of the orders with the criteria filters
+	FullTextSearchOrders(
+		ctx context.Context,
+		criteria *OrderSearchCriteria,
+		listQuery *utils.ListQuery,
+	) (*utils.ListResult[*read_models.OrderReadModel], error)
 }
 
 type OrderMongoRepository interface {
@@ -1,22 +1,99 @@
 package repositories
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+	elasticsearch2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/elasticsearch"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/attribute"
+	utils2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/utils"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/repositories"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
 
+	"emperror.dev/errors"
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/goccy/go-json"
 	uuid "github.com/satori/go.uuid"
+	attribute2 "go.opentelemetry.io/otel/attribute"
 )
 
+const (
+	orderIndex = "orders"
+	// wait for the index refresh after writes, so the projected orders are visible to the search right after projection
+	refreshPolicy = "wait_for"
+)
+
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping.html
+const orderIndexMapping = `{
+  "mappings": {
+    "properties": {
+      "id":              { "type": "keyword" },
+      "orderId":         { "type": "keyword" },
+      "accountEmail":    { "type": "text", "fields": { "keyword": { "type": "keyword" } } },
+      "deliveryAddress": { "type": "text" },
+      "cancelReason":    { "type": "text" },
+      "paymentId":       { "type": "keyword" },
+      "status":          { "type": "keyword" },
+      "totalPrice":      { "type": "double" },
+      "paid":            { "type": "boolean" },
+      "submitted":       { "type": "boolean" },
+      "shipped":         { "type": "boolean" },
+      "completed":       { "type": "boolean" },
+      "canceled":        { "type": "boolean" },
+      "deliveredTime":   { "type": "date" },
+      "createdAt":       { "type": "date" },
+      "updatedAt":       { "type": "date" },
+      "shopItems": {
+        "properties": {
+          "title":       { "type": "text" },
+          "description": { "type": "text" },
+          "quantity":    { "type": "long" },
+          "price":       { "type": "double" }
+        }
+      }
+    }
+  }
+}`
+
+var orderSearchFields = []string{"accountEmail", "deliveryAddress", "shopItems.title"}
+
+// orderKeywordFields are the text fields which are compared and sorted by their keyword sub field in the list queries
+var orderKeywordFields = []string{"accountEmail"}
+
 type elasticOrderReadRepository struct {
 	log           logger.Logger
 	elasticClient *elasticsearch.Client
 	tracer        tracing.AppTracer
+	indexLock     sync.Mutex
+	indexCreated  bool
+}
+
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source *read_models.OrderReadModel `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+type getResponse struct {
+	Found  bool                        `json:"found"`
+	Source *read_models.OrderReadModel `json:"_source"`
 }
 
 func NewElasticOrderReadRepository(
@@ -27,56 +104,601 @@ func NewElasticOrderReadRepository(
 	return &elasticOrderReadRepository{log: log, elasticClient: elasticClient, tracer: tracer}
 }
 
-func (e elasticOrderReadRepository) GetAllOrders(
+func (e *elasticOrderReadRepository) GetAllOrders(
 	ctx context.Context,
 	listQuery *utils.ListQuery,
 ) (*utils.ListResult[*read_models.OrderReadModel], error) {
-	// TODO implement me
-	panic("implement me")
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.GetAllOrders")
+	defer span.End()
+
+	query, err := read_models.OrderListQuerySchema.Parse(listQuery)
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_GetAllOrders.Parse] error in parsing the list query",
+			),
+		)
+	}
+
+	searchQuery, err := elasticsearch2.SpecificationToQuery(query.Specification, orderKeywordFields...)
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_GetAllOrders.SpecificationToQuery] error in compiling the filters",
+			),
+		)
+	}
+
+	result, err := e.search(ctx, searchQuery, query, listQuery)
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_GetAllOrders.search] error in searching the orders",
+			),
+		)
+	}
+
+	e.log.Infow(
+		"[elasticOrderReadRepository.GetAllOrders] orders loaded",
+		logger.Fields{"OrdersResult": result},
+	)
+
+	span.SetAttributes(attribute.Object("OrdersResult", result))
+
+	return result, nil
 }
 
-func (e elasticOrderReadRepository) SearchOrders(
+func (e *elasticOrderReadRepository) SearchOrders(
 	ctx context.Context,
 	searchText string,
 	listQuery *utils.ListQuery,
 ) (*utils.ListResult[*read_models.OrderReadModel], error) {
-	// TODO implement me
-	panic("implement me")
+	return e.FullTextSearchOrders(ctx, &repositories.OrderSearchCriteria{SearchText: searchText}, listQuery)
+}
+
+func (e *elasticOrderReadRepository) FullTextSearchOrders(
+	ctx context.Context,
+	criteria *repositories.OrderSearchCriteria,
+	listQuery *utils.ListQuery,
+) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.FullTextSearchOrders")
+	span.SetAttributes(attribute.Object("Criteria", criteria))
+	defer span.End()
+
+	query, err := read_models.OrderListQuerySchema.Parse(listQuery)
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_FullTextSearchOrders.Parse] error in parsing the list query",
+			),
+		)
+	}
+
+	searchQuery, err := buildSearchQuery(criteria, query.Specification)
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_FullTextSearchOrders.buildSearchQuery] error in building the search query",
+			),
+		)
+	}
+
+	if criteria.SearchText != "" && listQuery.GetOrderBy() == "" && query.Keyset == nil {
+		// the matches are sorted by their relevance, which can't be paged by the cursors
+		query = &listquery.Query{Specification: query.Specification}
+	}
+
+	result, err := e.search(ctx, searchQuery, query, listQuery)
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_FullTextSearchOrders.search] error in searching the orders",
+			),
+		)
+	}
+	span.SetAttributes(attribute.Object("OrdersResult", result))
+
+	e.log.Infow(
+		fmt.Sprintf(
+			"[elasticOrderReadRepository.FullTextSearchOrders] orders loaded for search term '%s'",
+			criteria.SearchText,
+		),
+		logger.Fields{"OrdersResult": result},
+	)
+
+	return result, nil
 }
 
-func (e elasticOrderReadRepository) GetOrderById(
+func (e *elasticOrderReadRepository) GetOrderById(
 	ctx context.Context,
-	uuid uuid.UUID,
+	id uuid.UUID,
 ) (*read_models.OrderReadModel, error) {
-	// TODO implement me
-	panic("implement me")
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.GetOrderById")
+	span.SetAttributes(attribute2.String("Id", id.String()))
+	defer span.End()
+
+	query := map[string]interface{}{"term": map[string]interface{}{"id": id.String()}}
+
+	result, err := e.search(ctx, query, &listquery.Query{}, utils.NewListQuery(1, 1))
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf(
+					"[elasticOrderReadRepository_GetOrderById.search] can't find the order with id %s into the elasticsearch.",
+					id,
+				),
+			),
+		)
+	}
+
+	if len(result.Items) == 0 {
+		return nil, nil
+	}
+
+	order := result.Items[0]
+	span.SetAttributes(attribute.Object("Order", order))
+
+	e.log.Infow(
+		fmt.Sprintf("[elasticOrderReadRepository.GetOrderById] order with id %s laoded", id),
+		logger.Fields{"Order": order, "Id": id},
+	)
+
+	return order, nil
 }
 
-func (e elasticOrderReadRepository) GetOrderByOrderId(
+func (e *elasticOrderReadRepository) GetOrderByOrderId(
 	ctx context.Context,
-	uuid uuid.UUID,
+	orderId uuid.UUID,
 ) (*read_models.OrderReadModel, error) {
-	// TODO implement me
-	panic("implement me")
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.GetOrderByOrderId")
+	span.SetAttributes(attribute2.String("OrderId", orderId.String()))
+	defer span.End()
+
+	res, err := e.elasticClient.Get(
+		orderIndex,
+		orderId.String(),
+		e.elasticClient.Get.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf(
+					"[elasticOrderReadRepository_GetOrderByOrderId.Get] can't find the order with orderId %s into the elasticsearch.",
+					orderId,
+				),
+			),
+		)
+	}
+	defer res.Body.Close()
+
+	// the document or the index doesn't exist yet
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var response getResponse
+	if err := decodeResponse(res, &response); err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf(
+					"[elasticOrderReadRepository_GetOrderByOrderId.decodeResponse] error in getting the order with orderId %s",
+					orderId,
+				),
+			),
+		)
+	}
+
+	if !response.Found {
+		return nil, nil
+	}
+	span.SetAttributes(attribute.Object("Order", response.Source))
+
+	e.log.Infow(
+		fmt.Sprintf(
+			"[elasticOrderReadRepository.GetOrderByOrderId] order with orderId %s laoded",
+			orderId,
+		),
+		logger.Fields{"Order": response.Source, "OrderId": orderId},
+	)
+
+	return response.Source, nil
 }
 
-func (e elasticOrderReadRepository) CreateOrder(
+func (e *elasticOrderReadRepository) CreateOrder(
 	ctx context.Context,
 	order *read_models.OrderReadModel,
 ) (*read_models.OrderReadModel, error) {
-	// TODO implement me
-	panic("implement me")
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.CreateOrder")
+	defer span.End()
+
+	if err := e.indexOrder(ctx, order); err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_CreateOrder.indexOrder] error in the inserting order into the elasticsearch.",
+			),
+		)
+	}
+	span.SetAttributes(attribute.Object("Order", order))
+
+	e.log.Infow(
+		fmt.Sprintf("[elasticOrderReadRepository.CreateOrder] order with orderId '%s' created", order.OrderId),
+		logger.Fields{"Order": order, "OrderId": order.OrderId},
+	)
+
+	return order, nil
 }
 
-func (e elasticOrderReadRepository) UpdateOrder(
+func (e *elasticOrderReadRepository) UpdateOrder(
 	ctx context.Context,
 	order *read_models.OrderReadModel,
 ) (*read_models.OrderReadModel, error) {
-	// TODO implement me
-	panic("implement me")
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.UpdateOrder")
+	defer span.End()
+
+	// the whole document is replaced, so the update is idempotent for the replayed events
+	if err := e.indexOrder(ctx, order); err != nil {
+		return nil, utils2.TraceStatusFromContext(
+			ctx,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf(
+					"[elasticOrderReadRepository_UpdateOrder.indexOrder] error in updating order with orderId %s into the elasticsearch.",
+					order.OrderId,
+				),
+			),
+		)
+	}
+	span.SetAttributes(attribute.Object("Order", order))
+
+	e.log.Infow(
+		fmt.Sprintf("[elasticOrderReadRepository.UpdateOrder] order with orderId '%s' updated", order.OrderId),
+		logger.Fields{"Order": order, "OrderId": order.OrderId},
+	)
+
+	return order, nil
+}
+
+func (e *elasticOrderReadRepository) DeleteOrderByID(ctx context.Context, id uuid.UUID) error {
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.DeleteOrderByID")
+	span.SetAttributes(attribute2.String("Id", id.String()))
+	defer span.End()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{"term": map[string]interface{}{"id": id.String()}},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := e.elasticClient.DeleteByQuery(
+		[]string{orderIndex},
+		bytes.NewReader(body),
+		e.elasticClient.DeleteByQuery.WithContext(ctx),
+		e.elasticClient.DeleteByQuery.WithRefresh(true),
+	)
+	if err != nil {
+		return utils2.TraceStatusFromContext(ctx, errors.WrapIf(err, fmt.Sprintf(
+			"[elasticOrderReadRepository_DeleteOrderByID.DeleteByQuery] error in deleting order with id %s from the elasticsearch.",
+			id,
+		)))
+	}
+	defer res.Body.Close()
+
+	if err := decodeResponse(res, nil); err != nil {
+		return utils2.TraceStatusFromContext(ctx, errors.WrapIf(err, fmt.Sprintf(
+			"[elasticOrderReadRepository_DeleteOrderByID.decodeResponse] error in deleting order with id %s from the elasticsearch.",
+			id,
+		)))
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("[elasticOrderReadRepository.DeleteOrderByID] order with id %s deleted", id),
+		logger.Fields{"Id": id},
+	)
+
+	return nil
+}
+
+// DeleteAllOrders deletes the orders index, it will be created again with its mapping by the next indexing
+func (e *elasticOrderReadRepository) DeleteAllOrders(ctx context.Context) error {
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.DeleteAllOrders")
+	defer span.End()
+
+	e.indexLock.Lock()
+	defer e.indexLock.Unlock()
+
+	res, err := e.elasticClient.Indices.Delete(
+		[]string{orderIndex},
+		e.elasticClient.Indices.Delete.WithContext(ctx),
+	)
+	if err != nil {
+		return utils2.TraceStatusFromContext(ctx, errors.WrapIf(
+			err,
+			"[elasticOrderReadRepository_DeleteAllOrders.Delete] error in deleting the orders index from the elasticsearch.",
+		))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNotFound {
+		if err := decodeResponse(res, nil); err != nil {
+			return utils2.TraceStatusFromContext(ctx, errors.WrapIf(
+				err,
+				"[elasticOrderReadRepository_DeleteAllOrders.decodeResponse] error in deleting the orders index from the elasticsearch.",
+			))
+		}
+	}
+
+	e.indexCreated = false
+
+	e.log.Info("[elasticOrderReadRepository.DeleteAllOrders] orders index deleted")
+
+	return nil
+}
+
+// indexOrder stores the order document with the orderId as the document id
+func (e *elasticOrderReadRepository) indexOrder(ctx context.Context, order *read_models.OrderReadModel) error {
+	if err := e.ensureIndex(ctx); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(order)
+	if err != nil {
+		return errors.WrapIf(err, "error in marshaling order document")
+	}
+
+	res, err := e.elasticClient.Index(
+		orderIndex,
+		bytes.NewReader(body),
+		e.elasticClient.Index.WithDocumentID(order.OrderId),
+		e.elasticClient.Index.WithRefresh(refreshPolicy),
+		e.elasticClient.Index.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return decodeResponse(res, nil)
+}
+
+// ensureIndex creates the orders index with its mapping, if it doesn't exist
+func (e *elasticOrderReadRepository) ensureIndex(ctx context.Context) error {
+	e.indexLock.Lock()
+	defer e.indexLock.Unlock()
+
+	if e.indexCreated {
+		return nil
+	}
+
+	res, err := e.elasticClient.Indices.Exists(
+		[]string{orderIndex},
+		e.elasticClient.Indices.Exists.WithContext(ctx),
+	)
+	if err != nil {
+		return errors.WrapIf(err, "error in checking the orders index existence")
+	}
+	res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		res, err = e.elasticClient.Indices.Create(
+			orderIndex,
+			e.elasticClient.Indices.Create.WithBody(strings.NewReader(orderIndexMapping)),
+			e.elasticClient.Indices.Create.WithContext(ctx),
+		)
+		if err != nil {
+			return errors.WrapIf(err, "error in creating the orders index")
+		}
+		defer res.Body.Close()
+
+		// another instance could create the index at the same time
+		if res.IsError() && !strings.Contains(res.String(), "resource_already_exists_exception") {
+			return errors.Errorf("error in creating the orders index: %s", res.String())
+		}
+	}
+
+	e.indexCreated = true
+
+	return nil
+}
+
+// search finds the page of the orders, the total items are the matches of the query without the keyset of the cursor, so
+// they are counted separately for the cursor pages
+func (e *elasticOrderReadRepository) search(
+	ctx context.Context,
+	query map[string]interface{},
+	listQuery *listquery.Query,
+	pageQuery *utils.ListQuery,
+) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	request := map[string]interface{}{
+		"query": query,
+		"from":  listQuery.Offset(pageQuery),
+		"size":  listQuery.Limit(pageQuery),
+		"sort":  buildSort(listQuery.Sorts),
+	}
+
+	if listQuery.Keyset != nil {
+		keysetQuery, err := elasticsearch2.SpecificationToQuery(listQuery.Keyset.Specification, orderKeywordFields...)
+		if err != nil {
+			return nil, err
+		}
+
+		request["query"] = map[string]interface{}{
+			"bool": map[string]interface{}{"filter": []interface{}{query, keysetQuery}},
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in marshaling search request")
+	}
+
+	res, err := e.elasticClient.Search(
+		e.elasticClient.Search.WithContext(ctx),
+		e.elasticClient.Search.WithIndex(orderIndex),
+		e.elasticClient.Search.WithBody(bytes.NewReader(body)),
+		e.elasticClient.Search.WithTrackTotalHits(true),
+		e.elasticClient.Search.WithIgnoreUnavailable(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var response searchResponse
+	if err := decodeResponse(res, &response); err != nil {
+		return nil, err
+	}
+
+	items := make([]*read_models.OrderReadModel, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		items = append(items, hit.Source)
+	}
+
+	items, cursors, err := listquery.Page(listQuery, pageQuery, items)
+	if err != nil {
+		return nil, err
+	}
+
+	totalItems := response.Hits.Total.Value
+	if listQuery.Keyset != nil {
+		if totalItems, err = e.count(ctx, query); err != nil {
+			return nil, err
+		}
+	}
+
+	result := utils.NewListResult[*read_models.OrderReadModel](
+		items,
+		pageQuery.GetSize(),
+		pageQuery.GetPage(),
+		totalItems,
+	)
+	result.PreviousCursor, result.NextCursor = cursors.Previous, cursors.Next
+
+	return result, nil
+}
+
+// count returns the number of the orders which match the query
+func (e *elasticOrderReadRepository) count(ctx context.Context, query map[string]interface{}) (int64, error) {
+	body, err := json.Marshal(map[string]interface{}{"query": query})
+	if err != nil {
+		return 0, errors.WrapIf(err, "error in marshaling count request")
+	}
+
+	res, err := e.elasticClient.Count(
+		e.elasticClient.Count.WithContext(ctx),
+		e.elasticClient.Count.WithIndex(orderIndex),
+		e.elasticClient.Count.WithBody(bytes.NewReader(body)),
+		e.elasticClient.Count.WithIgnoreUnavailable(true),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	var response struct {
+		Count int64 `json:"count"`
+	}
+	if err := decodeResponse(res, &response); err != nil {
+		return 0, err
+	}
+
+	return response.Count, nil
 }
 
-func (e elasticOrderReadRepository) DeleteOrderByID(ctx context.Context, uuid uuid.UUID) error {
-	// TODO implement me
-	panic("implement me")
+// buildSearchQuery builds the full-text search query of the criteria, the specification of the list query filters is
+// added to the filters of the search
+func buildSearchQuery(
+	criteria *repositories.OrderSearchCriteria,
+	spec specification.Specification,
+) (map[string]interface{}, error) {
+	var must []interface{}
+	var filter []interface{}
+
+	if criteria.SearchText != "" {
+		must = append(must, map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":     criteria.SearchText,
+				"fields":    orderSearchFields,
+				"fuzziness": "AUTO",
+			},
+		})
+	}
+
+	if criteria.Status != "" {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{"status": criteria.Status},
+		})
+	}
+
+	createdAtRange := map[string]interface{}{}
+	if !criteria.FromDate.IsZero() {
+		createdAtRange["gte"] = criteria.FromDate.Format(time.RFC3339)
+	}
+	if !criteria.ToDate.IsZero() {
+		createdAtRange["lte"] = criteria.ToDate.Format(time.RFC3339)
+	}
+	if len(createdAtRange) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"range": map[string]interface{}{"createdAt": createdAtRange},
+		})
+	}
+
+	specificationQuery, err := elasticsearch2.SpecificationToQuery(spec, orderKeywordFields...)
+	if err != nil {
+		return nil, err
+	}
+	filter = append(filter, specificationQuery)
+
+	if len(must) == 0 {
+		must = append(must, map[string]interface{}{"match_all": map[string]interface{}{}})
+	}
+
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must":   must,
+			"filter": filter,
+		},
+	}, nil
+}
+
+// buildSort sorts by the sorts of the list query, then the relevance score for a search and the newest orders
+func buildSort(sorts []listquery.Sort) []interface{} {
+	sort := elasticsearch2.SortsToSort(sorts, orderKeywordFields...)
+	sort = append(sort, "_score")
+
+	return append(sort, map[string]interface{}{"createdAt": map[string]interface{}{"order": "desc"}})
+}
+
+// decodeResponse returns an error for the failed elasticsearch responses and decodes the response body
+func decodeResponse(res *esapi.Response, v interface{}) error {
+	if res.IsError() {
+		return errors.Errorf("elasticsearch response error: %s", res.String())
+	}
+
+	if v == nil {
+		_, err := io.Copy(io.Discard, res.Body)
+		return err
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
 }
@@ -56,7 +56,13 @@ func (m mongoOrderReadRepository) GetAllOrders(
 
 	collection := m.mongoClient.Database(m.mongoOptions.Database).Collection(orderCollection)
 
-	result, err := mongodb.Paginate[*read_models.OrderReadModel](ctx, listQuery, collection, nil)
+	result, err := mongodb.FilterPaginate[*read_models.OrderReadModel](
+		ctx,
+		listQuery,
+		read_models.OrderListQuerySchema,
+		collection,
+		nil,
+	)
 	if err != nil {
 		return nil, utils2.TraceStatusFromContext(
 			ctx,
@@ -97,7 +103,13 @@ func (m mongoOrderReadRepository) SearchOrders(
 		}},
 	}
 
-	result, err := mongodb.Paginate[*read_models.OrderReadModel](ctx, listQuery, collection, filter)
+	result, err := mongodb.FilterPaginate[*read_models.OrderReadModel](
+		ctx,
+		listQuery,
+		read_models.OrderListQuerySchema,
+		collection,
+		filter,
+	)
 	if err != nil {
 		return nil, utils2.TraceStatusFromContext(
 			ctx,
@@ -242,7 +254,7 @@ func (m mongoOrderReadRepository) UpdateOrder(
 	ops.SetUpsert(true)
 
 	var updated read_models.OrderReadModel
-	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": order.OrderId}, bson.M{"$set": order}, ops).Decode(&updated); err != nil {
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": order.Id}, bson.M{"$set": order}, ops).Decode(&updated); err != nil {
 		return nil, utils2.TraceStatusFromContext(
 			ctx,
 			errors.WrapIf(
@@ -288,3 +300,25 @@ func (m mongoOrderReadRepository) DeleteOrderByID(ctx context.Context, uuid uuid
 
 	return nil
 }
+
+func (m mongoOrderReadRepository) DeleteAllOrders(ctx context.Context) error {
+	ctx, span := m.tracer.Start(ctx, "mongoOrderReadRepository.DeleteAllOrders")
+	defer span.End()
+
+	collection := m.mongoClient.Database(m.mongoOptions.Database).Collection(orderCollection)
+
+	result, err := collection.DeleteMany(ctx, bson.M{})
+	if err != nil {
+		return utils2.TraceStatusFromContext(ctx, errors.WrapIf(
+			err,
+			"[mongoOrderReadRepository_DeleteAllOrders.DeleteMany] error in deleting all orders from the database.",
+		))
+	}
+
+	m.log.Infow(
+		fmt.Sprintf("[mongoOrderReadRepository.DeleteAllOrders] %d orders deleted", result.DeletedCount),
+		logger.Fields{"DeletedCount": result.DeletedCount},
+	)
+
+	return nil
+}
@@ -16,6 +16,7 @@ type OrderDto struct {
 	DeliveredTime   time.Time      `json:"deliveredTime"`
 	Paid            bool           `json:"paid"`
 	Submitted       bool           `json:"submitted"`
+	Shipped         bool           `json:"shipped"`
 	Completed       bool           `json:"completed"`
 	Canceled        bool           `json:"canceled"`
 	PaymentId       uuid.UUID      `json:"paymentId"`
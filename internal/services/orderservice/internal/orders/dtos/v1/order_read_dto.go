@@ -13,8 +13,10 @@ type OrderReadDto struct {
 	DeliveredTime   time.Time          `json:"deliveredTime"`
 	Paid            bool               `json:"paid"`
 	Submitted       bool               `json:"submitted"`
+	Shipped         bool               `json:"shipped"`
 	Completed       bool               `json:"completed"`
 	Canceled        bool               `json:"canceled"`
+	Status          string             `json:"status"`
 	PaymentId       string             `json:"paymentId"`
 	CreatedAt       time.Time          `json:"createdAt"`
 	UpdatedAt       time.Time          `json:"updatedAt"`
@@ -1,6 +1,8 @@
 package dtosV1
 
 type ShopItemDto struct {
+	// ProductId is the id of the catalog product, its availability is checked with the catalog service before creating the order
+	ProductId   string  `json:"productId,omitempty"`
 	Title       string  `json:"title"`
 	Description string  `json:"description"`
 	Quantity    uint64  `json:"quantity"`
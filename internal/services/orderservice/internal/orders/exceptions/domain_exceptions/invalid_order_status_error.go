@@ -0,0 +1,41 @@
+package domainExceptions
+
+import (
+	"net/http"
+
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+
+	"emperror.dev/errors"
+)
+
+type invalidOrderStatusError struct {
+	customErrors.DomainError
+}
+
+type InvalidOrderStatusError interface {
+	customErrors.DomainError
+}
+
+func NewInvalidOrderStatusError(message string) error {
+	domainErr := customErrors.NewDomainErrorWithCode(message, http.StatusConflict)
+	customErr := customErrors.GetCustomError(domainErr).(customErrors.DomainError)
+	br := &invalidOrderStatusError{
+		DomainError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (i *invalidOrderStatusError) isInvalidOrderStatusError() bool {
+	return true
+}
+
+func IsInvalidOrderStatusError(err error) bool {
+	var ie *invalidOrderStatusError
+
+	if errors.As(err, &ie) {
+		return ie.isInvalidOrderStatusError()
+	}
+
+	return false
+}
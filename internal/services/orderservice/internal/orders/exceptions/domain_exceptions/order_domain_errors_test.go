@@ -2,11 +2,13 @@ package domainExceptions
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	errorUtils "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils/errorutils"
 
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,7 +19,7 @@ func Test_Order_Shop_Items_Required_Error(t *testing.T) {
 }
 
 func Test_Order_Not_Found_Error(t *testing.T) {
-	err := NewOrderNotFoundError(1)
+	err := NewOrderNotFoundError(uuid.NewV4())
 	assert.True(t, IsOrderNotFoundError(err))
 	fmt.Println(errorUtils.ErrorsWithStack(err))
 }
@@ -53,3 +55,19 @@ func Test_Is_Not_InvalidEmail_Address_Error(t *testing.T) {
 	err := customErrors.NewBadRequestError("email address is not valid")
 	assert.False(t, IsInvalidEmailAddressError(err))
 }
+
+func Test_Invalid_Order_Status_Error(t *testing.T) {
+	t.Parallel()
+
+	err := NewInvalidOrderStatusError("order is already submitted")
+	assert.True(t, IsInvalidOrderStatusError(err))
+	assert.True(t, customErrors.IsDomainError(err, http.StatusConflict))
+	fmt.Println(errorUtils.ErrorsWithStack(err))
+}
+
+func Test_Is_Not_Invalid_Order_Status_Error(t *testing.T) {
+	t.Parallel()
+
+	err := customErrors.NewDomainError("order is already submitted")
+	assert.False(t, IsInvalidOrderStatusError(err))
+}
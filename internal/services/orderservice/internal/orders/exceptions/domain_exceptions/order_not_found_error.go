@@ -6,6 +6,7 @@ import (
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 
 	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
 )
 
 type orderNotFoundError struct {
@@ -16,9 +17,9 @@ type OrderNotFoundError interface {
 	customErrors.NotFoundError
 }
 
-func NewOrderNotFoundError(id int) error {
+func NewOrderNotFoundError(id uuid.UUID) error {
 	notFound := customErrors.NewNotFoundError(
-		fmt.Sprintf("order with id %d not found", id),
+		fmt.Sprintf("order with id %s not found", id),
 	)
 	customErr := customErrors.GetCustomError(notFound).(customErrors.NotFoundError)
 	br := &orderNotFoundError{
@@ -0,0 +1,38 @@
+package cancelOrderCommandV1
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	uuid "github.com/satori/go.uuid"
+)
+
+// CancelOrder cancels the order, it is also an internal command, so the order fulfillment saga stores it with its state and
+// the message dispatcher sends it after the commit
+type CancelOrder struct {
+	cqrs.InternalCommand
+	OrderId      uuid.UUID
+	CancelReason string
+}
+
+func NewCancelOrder(orderId uuid.UUID, cancelReason string) (*CancelOrder, error) {
+	command := &CancelOrder{
+		InternalCommand: cqrs.NewInternalCommandByT[*CancelOrder](),
+		OrderId:         orderId,
+		CancelReason:    cancelReason,
+	}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+func (c CancelOrder) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.OrderId, validation.Required),
+		validation.Field(&c.CancelReason, validation.Required),
+	)
+}
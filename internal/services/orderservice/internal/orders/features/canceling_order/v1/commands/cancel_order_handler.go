@@ -0,0 +1,78 @@
+package cancelOrderCommandV1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	esErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/errors"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	domainExceptions "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/exceptions/domain_exceptions"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/aggregate"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type CancelOrderHandler struct {
+	log            logger.Logger
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewCancelOrderHandler(
+	log logger.Logger,
+	aggregateStore store.AggregateStore[*aggregate.Order],
+) *CancelOrderHandler {
+	return &CancelOrderHandler{log: log, aggregateStore: aggregateStore}
+}
+
+func (c *CancelOrderHandler) Handle(
+	ctx context.Context,
+	command *CancelOrder,
+) (*mediatr.Unit, error) {
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		if esErrors.IsAggregateNotFoundError(err) {
+			return nil, domainExceptions.NewOrderNotFoundError(command.OrderId)
+		}
+
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[CancelOrderHandler_Handle.Load] error in loading order aggregate",
+		)
+	}
+
+	// the command is redelivered by the message dispatcher and the saga, so an already canceled order is a success
+	if order.Canceled() {
+		c.log.Infow(
+			fmt.Sprintf("[CancelOrderHandler.Handle] order with id: {%s} is already canceled", command.OrderId),
+			logger.Fields{"OrderId": command.OrderId},
+		)
+
+		return &mediatr.Unit{}, nil
+	}
+
+	err = order.Cancel(command.CancelReason)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[CancelOrderHandler_Handle.Cancel] error in canceling the order",
+		)
+	}
+
+	_, err = c.aggregateStore.Store(order, nil, ctx)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[CancelOrderHandler_Handle.Store] error in storing order aggregate",
+		)
+	}
+
+	c.log.Infow(
+		fmt.Sprintf("[CancelOrderHandler.Handle] order with id: {%s} canceled", command.OrderId),
+		logger.Fields{"OrderId": command.OrderId},
+	)
+
+	return &mediatr.Unit{}, nil
+}
@@ -0,0 +1,9 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// CancelOrderRequestDto validation will handle in command level
+type CancelOrderRequestDto struct {
+	OrderId      uuid.UUID `param:"id" json:"-"`
+	CancelReason string    `json:"cancelReason"`
+}
@@ -0,0 +1,92 @@
+package cancelOrderV1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/params"
+	cancelOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/commands"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/dtos"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type cancelOrderEndpoint struct {
+	params.OrderRouteParams
+}
+
+func NewCancelOrderEndpoint(params params.OrderRouteParams) route.Endpoint {
+	return &cancelOrderEndpoint{OrderRouteParams: params}
+}
+
+func (ep *cancelOrderEndpoint) MapEndpoint() {
+	ep.OrdersGroup.POST("/:id/cancel", ep.handler())
+}
+
+// Cancel Order
+// @Tags Orders
+// @Summary Cancel order
+// @Description Cancel an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param CancelOrderRequestDto body dtos.CancelOrderRequestDto true "CancelOrder data"
+// @Success 204
+// @Router /api/v1/orders/{id}/cancel [post]
+func (ep *cancelOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.CancelOrderHttpRequests.Add(ctx, 1)
+
+		request := &dtos.CancelOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[cancelOrderEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[cancelOrderEndpoint_handler.Bind] err: %v", badRequestErr),
+			)
+			return badRequestErr
+		}
+
+		command, err := cancelOrderCommandV1.NewCancelOrder(request.OrderId, request.CancelReason)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[cancelOrderEndpoint_handler.StructCtx] command validation failed",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[cancelOrderEndpoint_handler.StructCtx] err: %v", validationErr),
+			)
+			return validationErr
+		}
+
+		_, err = mediatr.Send[*cancelOrderCommandV1.CancelOrder, *mediatr.Unit](
+			ctx,
+			command,
+		)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[cancelOrderEndpoint_handler.Send] error in sending CancelOrder",
+			)
+			ep.Logger.Errorw(
+				fmt.Sprintf(
+					"[cancelOrderEndpoint_handler.Send] id: {%s}, err: %v",
+					command.OrderId,
+					err,
+				),
+				logger.Fields{"Id": command.OrderId},
+			)
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,39 @@
+package domainEvents
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type OrderCanceledV1 struct {
+	*domain.DomainEvent
+	OrderId      uuid.UUID `json:"orderId"      bson:"orderId,omitempty"`
+	CancelReason string    `json:"cancelReason" bson:"cancelReason,omitempty"`
+	CanceledAt   time.Time `json:"canceledAt"   bson:"canceledAt,omitempty"`
+}
+
+func NewOrderCanceledEventV1(orderId uuid.UUID, cancelReason string, canceledAt time.Time) (*OrderCanceledV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError(fmt.Sprintf("orderId {%s} is invalid", orderId))
+	}
+
+	if cancelReason == "" {
+		return nil, customErrors.NewDomainError("cancelReason is required")
+	}
+
+	if canceledAt.IsZero() {
+		return nil, customErrors.NewDomainError("canceledAt can't be zero")
+	}
+
+	eventData := &OrderCanceledV1{OrderId: orderId, CancelReason: cancelReason, CanceledAt: canceledAt}
+
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
@@ -0,0 +1,27 @@
+package completeOrderCommandV1
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CompleteOrder struct {
+	OrderId uuid.UUID
+}
+
+func NewCompleteOrder(orderId uuid.UUID) (*CompleteOrder, error) {
+	command := &CompleteOrder{OrderId: orderId}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+func (c CompleteOrder) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.OrderId, validation.Required),
+	)
+}
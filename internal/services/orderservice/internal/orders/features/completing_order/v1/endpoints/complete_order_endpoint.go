@@ -0,0 +1,91 @@
+package completeOrderV1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/params"
+	completeOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/completing_order/v1/commands"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/completing_order/v1/dtos"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type completeOrderEndpoint struct {
+	params.OrderRouteParams
+}
+
+func NewCompleteOrderEndpoint(params params.OrderRouteParams) route.Endpoint {
+	return &completeOrderEndpoint{OrderRouteParams: params}
+}
+
+func (ep *completeOrderEndpoint) MapEndpoint() {
+	ep.OrdersGroup.POST("/:id/complete", ep.handler())
+}
+
+// Complete Order
+// @Tags Orders
+// @Summary Complete order
+// @Description Complete an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 204
+// @Router /api/v1/orders/{id}/complete [post]
+func (ep *completeOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.CompleteOrderHttpRequests.Add(ctx, 1)
+
+		request := &dtos.CompleteOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[completeOrderEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[completeOrderEndpoint_handler.Bind] err: %v", badRequestErr),
+			)
+			return badRequestErr
+		}
+
+		command, err := completeOrderCommandV1.NewCompleteOrder(request.OrderId)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[completeOrderEndpoint_handler.StructCtx] command validation failed",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[completeOrderEndpoint_handler.StructCtx] err: %v", validationErr),
+			)
+			return validationErr
+		}
+
+		_, err = mediatr.Send[*completeOrderCommandV1.CompleteOrder, *mediatr.Unit](
+			ctx,
+			command,
+		)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[completeOrderEndpoint_handler.Send] error in sending CompleteOrder",
+			)
+			ep.Logger.Errorw(
+				fmt.Sprintf(
+					"[completeOrderEndpoint_handler.Send] id: {%s}, err: %v",
+					command.OrderId,
+					err,
+				),
+				logger.Fields{"Id": command.OrderId},
+			)
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,34 @@
+package domainEvents
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type OrderCompletedV1 struct {
+	*domain.DomainEvent
+	OrderId     uuid.UUID `json:"orderId"     bson:"orderId,omitempty"`
+	CompletedAt time.Time `json:"completedAt" bson:"completedAt,omitempty"`
+}
+
+func NewOrderCompletedEventV1(orderId uuid.UUID, completedAt time.Time) (*OrderCompletedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError(fmt.Sprintf("orderId {%s} is invalid", orderId))
+	}
+
+	if completedAt.IsZero() {
+		return nil, customErrors.NewDomainError("completedAt can't be zero")
+	}
+
+	eventData := &OrderCompletedV1{OrderId: orderId, CompletedAt: completedAt}
+
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
@@ -3,12 +3,18 @@ package createOrderCommandV1
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/bus"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/catalogs"
+	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/dtos"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/aggregate"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/value_objects"
@@ -17,22 +23,34 @@ import (
 type CreateOrderHandler struct {
 	log logger.Logger
 	// goland can't detect this generic type, but it is ok in vscode
-	aggregateStore store.AggregateStore[*aggregate.Order]
-	tracer         tracing.AppTracer
+	aggregateStore              store.AggregateStore[*aggregate.Order]
+	tracer                      tracing.AppTracer
+	productsAvailabilityChecker catalogs.ProductsAvailabilityChecker
 }
 
 func NewCreateOrderHandler(
 	log logger.Logger,
 	aggregateStore store.AggregateStore[*aggregate.Order],
 	tracer tracing.AppTracer,
+	productsAvailabilityChecker catalogs.ProductsAvailabilityChecker,
 ) *CreateOrderHandler {
-	return &CreateOrderHandler{log: log, aggregateStore: aggregateStore, tracer: tracer}
+	return &CreateOrderHandler{
+		log:                         log,
+		aggregateStore:              aggregateStore,
+		tracer:                      tracer,
+		productsAvailabilityChecker: productsAvailabilityChecker,
+	}
 }
 
 func (c *CreateOrderHandler) Handle(
 	ctx context.Context,
 	command *CreateOrder,
 ) (*dtos.CreateOrderResponseDto, error) {
+	err := c.checkProductsAvailability(ctx, command.ShopItems)
+	if err != nil {
+		return nil, err
+	}
+
 	shopItems, err := mapper.Map[[]*value_objects.ShopItem](command.ShopItems)
 	if err != nil {
 		return nil,
@@ -74,3 +92,48 @@ func (c *CreateOrderHandler) Handle(
 
 	return response, nil
 }
+
+// checkProductsAvailability asks the catalog service about the products of the shop items, the shop items without a product id
+// are not checked
+func (c *CreateOrderHandler) checkProductsAvailability(ctx context.Context, shopItems []*dtosV1.ShopItemDto) error {
+	var productIds []string
+	for _, shopItem := range shopItems {
+		if shopItem.ProductId != "" {
+			productIds = append(productIds, shopItem.ProductId)
+		}
+	}
+
+	if len(productIds) == 0 {
+		return nil
+	}
+
+	availability, err := c.productsAvailabilityChecker.CheckProductsAvailability(ctx, productIds)
+	if bus.IsRequestTimeoutError(err) {
+		return customErrors.NewApplicationErrorWrapWithCode(
+			err,
+			http.StatusServiceUnavailable,
+			"[CreateOrderHandler_Handle.CheckProductsAvailability] the catalog service didn't respond to the products availability request",
+		)
+	}
+	if err != nil {
+		return customErrors.NewApplicationErrorWrap(
+			err,
+			"[CreateOrderHandler_Handle.CheckProductsAvailability] error in checking the products availability",
+		)
+	}
+
+	var unavailableProductIds []string
+	for _, productId := range productIds {
+		if !availability[productId] {
+			unavailableProductIds = append(unavailableProductIds, productId)
+		}
+	}
+
+	if len(unavailableProductIds) > 0 {
+		return customErrors.NewBadRequestError(
+			fmt.Sprintf("products {%s} are not available", strings.Join(unavailableProductIds, ", ")),
+		)
+	}
+
+	return nil
+}
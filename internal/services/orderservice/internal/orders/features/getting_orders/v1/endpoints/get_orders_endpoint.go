@@ -54,7 +54,7 @@ func (ep *getOrdersEndpoint) handler() echo.HandlerFunc {
 					badRequestErr,
 				),
 			)
-			return err
+			return badRequestErr
 		}
 
 		request := &dtos.GetOrdersRequestDto{ListQuery: listQuery}
@@ -67,7 +67,15 @@ func (ep *getOrdersEndpoint) handler() echo.HandlerFunc {
 			return badRequestErr
 		}
 
-		query := queries.NewGetOrders(request.ListQuery)
+		query, err := queries.NewGetOrders(request.ListQuery)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[getOrdersEndpoint_handler.NewGetOrders] query validation failed",
+			)
+			ep.Logger.Errorf(fmt.Sprintf("[getOrdersEndpoint_handler.NewGetOrders] err: {%v}", validationErr))
+			return validationErr
+		}
 
 		queryResult, err := mediatr.Send[*queries.GetOrders, *dtos.GetOrdersResponseDto](ctx, query)
 		if err != nil {
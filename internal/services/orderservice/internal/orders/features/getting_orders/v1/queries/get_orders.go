@@ -1,6 +1,9 @@
 package queries
 
-import "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
+)
 
 // Ref: https://golangbot.com/inheritance/
 
@@ -8,6 +11,18 @@ type GetOrders struct {
 	*utils.ListQuery
 }
 
-func NewGetOrders(query *utils.ListQuery) *GetOrders {
-	return &GetOrders{ListQuery: query}
+func NewGetOrders(query *utils.ListQuery) (*GetOrders, error) {
+	getOrders := &GetOrders{ListQuery: query}
+
+	err := getOrders.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return getOrders, nil
+}
+
+// Validate checks the filters and the sorts of the list query against the allowed order fields
+func (g GetOrders) Validate() error {
+	return read_models.OrderListQuerySchema.Validate(g.ListQuery)
 }
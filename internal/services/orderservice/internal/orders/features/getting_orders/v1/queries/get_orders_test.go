@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Get_Orders_With_Valid_Filters_And_Sorts(t *testing.T) {
+	t.Parallel()
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.OrderBy = "-createdAt,totalPrice"
+	listQuery.Filters = []*utils.FilterModel{
+		{Field: "status", Comparison: "in", Value: "paid,shipped"},
+		{Field: "totalPrice", Comparison: "gt", Value: "100"},
+		{Field: "accountEmail", Comparison: "contains", Value: "@test.com"},
+	}
+
+	_, err := NewGetOrders(listQuery)
+
+	assert.NoError(t, err)
+}
+
+func Test_Get_Orders_With_Not_Allowed_Field(t *testing.T) {
+	t.Parallel()
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.Filters = []*utils.FilterModel{{Field: "shopItems", Comparison: "eq", Value: "pizza"}}
+
+	_, err := NewGetOrders(listQuery)
+
+	assert.ErrorContains(t, err, "field 'shopItems' is not allowed in the list query")
+}
+
+func Test_Get_Orders_With_Invalid_Filter_Value(t *testing.T) {
+	t.Parallel()
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.Filters = []*utils.FilterModel{{Field: "paid", Comparison: "eq", Value: "maybe"}}
+
+	_, err := NewGetOrders(listQuery)
+
+	assert.Error(t, err)
+}
+
+func Test_Get_Orders_With_Not_Allowed_Sort(t *testing.T) {
+	t.Parallel()
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.OrderBy = "password desc"
+
+	_, err := NewGetOrders(listQuery)
+
+	assert.Error(t, err)
+}
+
+func Test_Get_Orders_With_Invalid_Cursor(t *testing.T) {
+	t.Parallel()
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.After = "invalid"
+
+	_, err := NewGetOrders(listQuery)
+
+	assert.ErrorContains(t, err, "cursor 'invalid' is not valid")
+}
@@ -0,0 +1,29 @@
+package payOrderCommandV1
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	uuid "github.com/satori/go.uuid"
+)
+
+type PayOrder struct {
+	OrderId   uuid.UUID
+	PaymentId uuid.UUID
+}
+
+func NewPayOrder(orderId uuid.UUID, paymentId uuid.UUID) (*PayOrder, error) {
+	command := &PayOrder{OrderId: orderId, PaymentId: paymentId}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+func (c PayOrder) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.OrderId, validation.Required),
+		validation.Field(&c.PaymentId, validation.Required),
+	)
+}
@@ -0,0 +1,68 @@
+package payOrderCommandV1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/store"
+	esErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/errors"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	domainExceptions "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/exceptions/domain_exceptions"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/aggregate"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type PayOrderHandler struct {
+	log            logger.Logger
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewPayOrderHandler(
+	log logger.Logger,
+	aggregateStore store.AggregateStore[*aggregate.Order],
+) *PayOrderHandler {
+	return &PayOrderHandler{log: log, aggregateStore: aggregateStore}
+}
+
+func (c *PayOrderHandler) Handle(
+	ctx context.Context,
+	command *PayOrder,
+) (*mediatr.Unit, error) {
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		if esErrors.IsAggregateNotFoundError(err) {
+			return nil, domainExceptions.NewOrderNotFoundError(command.OrderId)
+		}
+
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[PayOrderHandler_Handle.Load] error in loading order aggregate",
+		)
+	}
+
+	err = order.Pay(command.PaymentId)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[PayOrderHandler_Handle.Pay] error in paying the order",
+		)
+	}
+
+	_, err = c.aggregateStore.Store(order, nil, ctx)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[PayOrderHandler_Handle.Store] error in storing order aggregate",
+		)
+	}
+
+	c.log.Infow(
+		fmt.Sprintf("[PayOrderHandler.Handle] order with id: {%s} paid", command.OrderId),
+		logger.Fields{"OrderId": command.OrderId},
+	)
+
+	return &mediatr.Unit{}, nil
+}
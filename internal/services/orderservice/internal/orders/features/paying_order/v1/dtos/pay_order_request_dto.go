@@ -0,0 +1,9 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// PayOrderRequestDto validation will handle in command level
+type PayOrderRequestDto struct {
+	OrderId   uuid.UUID `param:"id" json:"-"`
+	PaymentId uuid.UUID `json:"paymentId"`
+}
@@ -0,0 +1,92 @@
+package payOrderV1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/params"
+	payOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/paying_order/v1/commands"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/paying_order/v1/dtos"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type payOrderEndpoint struct {
+	params.OrderRouteParams
+}
+
+func NewPayOrderEndpoint(params params.OrderRouteParams) route.Endpoint {
+	return &payOrderEndpoint{OrderRouteParams: params}
+}
+
+func (ep *payOrderEndpoint) MapEndpoint() {
+	ep.OrdersGroup.POST("/:id/pay", ep.handler())
+}
+
+// Pay Order
+// @Tags Orders
+// @Summary Pay order
+// @Description Pay an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param PayOrderRequestDto body dtos.PayOrderRequestDto true "PayOrder data"
+// @Success 204
+// @Router /api/v1/orders/{id}/pay [post]
+func (ep *payOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.PayOrderHttpRequests.Add(ctx, 1)
+
+		request := &dtos.PayOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[payOrderEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[payOrderEndpoint_handler.Bind] err: %v", badRequestErr),
+			)
+			return badRequestErr
+		}
+
+		command, err := payOrderCommandV1.NewPayOrder(request.OrderId, request.PaymentId)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[payOrderEndpoint_handler.StructCtx] command validation failed",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[payOrderEndpoint_handler.StructCtx] err: %v", validationErr),
+			)
+			return validationErr
+		}
+
+		_, err = mediatr.Send[*payOrderCommandV1.PayOrder, *mediatr.Unit](
+			ctx,
+			command,
+		)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[payOrderEndpoint_handler.Send] error in sending PayOrder",
+			)
+			ep.Logger.Errorw(
+				fmt.Sprintf(
+					"[payOrderEndpoint_handler.Send] id: {%s}, err: %v",
+					command.OrderId,
+					err,
+				),
+				logger.Fields{"Id": command.OrderId},
+			)
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
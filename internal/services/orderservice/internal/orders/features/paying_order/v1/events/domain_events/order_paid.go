@@ -0,0 +1,39 @@
+package domainEvents
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type OrderPaidV1 struct {
+	*domain.DomainEvent
+	OrderId   uuid.UUID `json:"orderId"   bson:"orderId,omitempty"`
+	PaymentId uuid.UUID `json:"paymentId" bson:"paymentId,omitempty"`
+	PaidAt    time.Time `json:"paidAt"    bson:"paidAt,omitempty"`
+}
+
+func NewOrderPaidEventV1(orderId uuid.UUID, paymentId uuid.UUID, paidAt time.Time) (*OrderPaidV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError(fmt.Sprintf("orderId {%s} is invalid", orderId))
+	}
+
+	if paymentId == uuid.Nil {
+		return nil, customErrors.NewDomainError(fmt.Sprintf("paymentId {%s} is invalid", paymentId))
+	}
+
+	if paidAt.IsZero() {
+		return nil, customErrors.NewDomainError("paidAt can't be zero")
+	}
+
+	eventData := &OrderPaidV1{OrderId: orderId, PaymentId: paymentId, PaidAt: paidAt}
+
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
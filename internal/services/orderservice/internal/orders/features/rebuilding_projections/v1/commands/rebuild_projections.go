@@ -0,0 +1,36 @@
+package rebuildProjectionsCommandV1
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type RebuildProjections struct {
+	SubscriptionId     string
+	Projections        []string
+	TruncateReadModels bool
+}
+
+func NewRebuildProjections(
+	subscriptionId string,
+	projections []string,
+	truncateReadModels bool,
+) (*RebuildProjections, error) {
+	command := &RebuildProjections{
+		SubscriptionId:     subscriptionId,
+		Projections:        projections,
+		TruncateReadModels: truncateReadModels,
+	}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+func (c RebuildProjections) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.Projections, validation.Each(validation.Required)),
+	)
+}
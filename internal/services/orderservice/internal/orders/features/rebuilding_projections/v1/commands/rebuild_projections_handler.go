@@ -0,0 +1,64 @@
+package rebuildProjectionsCommandV1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/rebuilding_projections/v1/dtos"
+)
+
+type RebuildProjectionsHandler struct {
+	log                   logger.Logger
+	subscriptionAllWorker eventstroredb.EsdbSubscriptionAllWorker
+	tracer                tracing.AppTracer
+}
+
+func NewRebuildProjectionsHandler(
+	log logger.Logger,
+	subscriptionAllWorker eventstroredb.EsdbSubscriptionAllWorker,
+	tracer tracing.AppTracer,
+) *RebuildProjectionsHandler {
+	return &RebuildProjectionsHandler{
+		log:                   log,
+		subscriptionAllWorker: subscriptionAllWorker,
+		tracer:                tracer,
+	}
+}
+
+func (c *RebuildProjectionsHandler) Handle(
+	ctx context.Context,
+	command *RebuildProjections,
+) (*dtos.RebuildProjectionsResponseDto, error) {
+	result, err := c.subscriptionAllWorker.RebuildProjections(ctx, &eventstroredb.RebuildProjectionsOptions{
+		SubscriptionId:     command.SubscriptionId,
+		Projections:        command.Projections,
+		TruncateReadModels: command.TruncateReadModels,
+	})
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[RebuildProjectionsHandler_Handle.RebuildProjections] error in rebuilding the projections",
+		)
+	}
+
+	c.log.Infow(
+		fmt.Sprintf(
+			"[RebuildProjectionsHandler.Handle] projections %v of subscription {%s} rebuilt with %d events",
+			result.Projections,
+			result.SubscriptionId,
+			result.ProcessedEvents,
+		),
+		logger.Fields{"SubscriptionId": result.SubscriptionId, "Projections": result.Projections},
+	)
+
+	return &dtos.RebuildProjectionsResponseDto{
+		SubscriptionId:  result.SubscriptionId,
+		Projections:     result.Projections,
+		Position:        result.Position,
+		ProcessedEvents: result.ProcessedEvents,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package rebuildProjectionsCommandV1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Rebuild_Projections_For_All_Projections(t *testing.T) {
+	t.Parallel()
+
+	command, err := NewRebuildProjections("", nil, true)
+
+	assert.NoError(t, err)
+	assert.True(t, command.TruncateReadModels)
+}
+
+func Test_Rebuild_Projections_With_Empty_Projection_Name(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRebuildProjections("orders-subscription", []string{"mongo-orders", ""}, false)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,10 @@
+package dtos
+
+// RebuildProjectionsRequestDto validation will handle in command level
+type RebuildProjectionsRequestDto struct {
+	// SubscriptionId is the subscription which its checkpoint will reset, the configured subscription is used when it is empty
+	SubscriptionId string `json:"subscriptionId"`
+	// Projections are the projection names like `mongo-orders` and `elastic-orders`, all projections are rebuilt when it is empty
+	Projections        []string `json:"projections"`
+	TruncateReadModels bool     `json:"truncateReadModels"`
+}
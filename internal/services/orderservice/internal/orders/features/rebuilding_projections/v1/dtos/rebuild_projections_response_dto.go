@@ -0,0 +1,8 @@
+package dtos
+
+type RebuildProjectionsResponseDto struct {
+	SubscriptionId  string   `json:"subscriptionId"`
+	Projections     []string `json:"projections"`
+	Position        uint64   `json:"position"`
+	ProcessedEvents int64    `json:"processedEvents"`
+}
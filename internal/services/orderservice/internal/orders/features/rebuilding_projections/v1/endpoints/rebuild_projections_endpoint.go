@@ -0,0 +1,89 @@
+package rebuildProjectionsV1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/params"
+	rebuildProjectionsCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/rebuilding_projections/v1/commands"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/rebuilding_projections/v1/dtos"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type rebuildProjectionsEndpoint struct {
+	params.OrderRouteParams
+}
+
+func NewRebuildProjectionsEndpoint(params params.OrderRouteParams) route.Endpoint {
+	return &rebuildProjectionsEndpoint{OrderRouteParams: params}
+}
+
+func (ep *rebuildProjectionsEndpoint) MapEndpoint() {
+	ep.OrdersGroup.POST("/projections/rebuild", ep.handler())
+}
+
+// Rebuild Projections
+// @Tags Orders
+// @Summary Rebuild order projections
+// @Description Reset the subscription checkpoint and replay all events through the selected order projections, then continue the live subscription
+// @Accept json
+// @Produce json
+// @Param RebuildProjectionsRequestDto body dtos.RebuildProjectionsRequestDto true "RebuildProjections data"
+// @Success 200 {object} dtos.RebuildProjectionsResponseDto
+// @Router /api/v1/orders/projections/rebuild [post]
+func (ep *rebuildProjectionsEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.RebuildProjectionsHttpRequests.Add(ctx, 1)
+
+		request := &dtos.RebuildProjectionsRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[rebuildProjectionsEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[rebuildProjectionsEndpoint_handler.Bind] err: %v", badRequestErr),
+			)
+			return badRequestErr
+		}
+
+		command, err := rebuildProjectionsCommandV1.NewRebuildProjections(
+			request.SubscriptionId,
+			request.Projections,
+			request.TruncateReadModels,
+		)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[rebuildProjectionsEndpoint_handler.StructCtx] command validation failed",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[rebuildProjectionsEndpoint_handler.StructCtx] err: %v", validationErr),
+			)
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*rebuildProjectionsCommandV1.RebuildProjections, *dtos.RebuildProjectionsResponseDto](
+			ctx,
+			command,
+		)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[rebuildProjectionsEndpoint_handler.Send] error in sending RebuildProjections",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[rebuildProjectionsEndpoint_handler.Send] err: %v", err),
+			)
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
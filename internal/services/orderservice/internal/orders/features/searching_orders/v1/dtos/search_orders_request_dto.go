@@ -0,0 +1,15 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+)
+
+type SearchOrdersRequestDto struct {
+	SearchText       string    `query:"search"   json:"search"`
+	Status           string    `query:"status"   json:"status"`
+	FromDate         time.Time `query:"fromDate" json:"fromDate"`
+	ToDate           time.Time `query:"toDate"   json:"toDate"`
+	*utils.ListQuery `                           json:"listQuery"`
+}
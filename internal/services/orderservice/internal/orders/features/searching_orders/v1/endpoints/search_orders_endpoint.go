@@ -0,0 +1,98 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/params"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/searching_orders/v1/dtos"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/searching_orders/v1/queries"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type searchOrdersEndpoint struct {
+	params.OrderRouteParams
+}
+
+func NewSearchOrdersEndpoint(params params.OrderRouteParams) route.Endpoint {
+	return &searchOrdersEndpoint{OrderRouteParams: params}
+}
+
+func (ep *searchOrdersEndpoint) MapEndpoint() {
+	ep.OrdersGroup.GET("/search", ep.handler())
+}
+
+// SearchOrders
+// @Tags Orders
+// @Summary Search orders
+// @Description Full-text search orders by account email, delivery address and item titles, filtered by status and creation date range
+// @Accept json
+// @Produce json
+// @Param searchOrdersRequestDto query dtos.SearchOrdersRequestDto false "SearchOrdersRequestDto"
+// @Success 200 {object} dtos.SearchOrdersResponseDto
+// @Router /api/v1/orders/search [get]
+func (ep *searchOrdersEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.SearchOrderHttpRequests.Add(ctx, 1)
+
+		listQuery, err := utils.GetListQueryFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[searchOrdersEndpoint_handler.GetListQueryFromCtx] error in getting data from query string",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf(
+					"[searchOrdersEndpoint_handler.GetListQueryFromCtx] err: %v",
+					badRequestErr,
+				),
+			)
+			return badRequestErr
+		}
+
+		request := &dtos.SearchOrdersRequestDto{ListQuery: listQuery}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[searchOrdersEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(fmt.Sprintf("[searchOrdersEndpoint_handler.Bind] err: %v", badRequestErr))
+			return badRequestErr
+		}
+
+		query, err := queries.NewSearchOrders(
+			request.SearchText,
+			request.Status,
+			request.FromDate,
+			request.ToDate,
+			request.ListQuery,
+		)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[searchOrdersEndpoint_handler.NewSearchOrders] query validation failed",
+			)
+			ep.Logger.Errorf(fmt.Sprintf("[searchOrdersEndpoint_handler.NewSearchOrders] err: {%v}", validationErr))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*queries.SearchOrders, *dtos.SearchOrdersResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[searchOrdersEndpoint_handler.Send] error in sending SearchOrders",
+			)
+			ep.Logger.Error(fmt.Sprintf("[searchOrdersEndpoint_handler.Send] err: {%v}", err))
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
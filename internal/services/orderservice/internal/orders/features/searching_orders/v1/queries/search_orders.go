@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type SearchOrders struct {
+	SearchText string
+	Status     read_models.OrderStatus
+	FromDate   time.Time
+	ToDate     time.Time
+	*utils.ListQuery
+}
+
+func NewSearchOrders(
+	searchText string,
+	status string,
+	fromDate time.Time,
+	toDate time.Time,
+	listQuery *utils.ListQuery,
+) (*SearchOrders, error) {
+	query := &SearchOrders{
+		SearchText: searchText,
+		Status:     read_models.OrderStatus(status),
+		FromDate:   fromDate,
+		ToDate:     toDate,
+		ListQuery:  listQuery,
+	}
+
+	err := query.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+func (s SearchOrders) Validate() error {
+	err := validation.ValidateStruct(&s,
+		validation.Field(&s.Status, validation.In(
+			read_models.OrderStatusCreated,
+			read_models.OrderStatusSubmitted,
+			read_models.OrderStatusPaid,
+			read_models.OrderStatusShipped,
+			read_models.OrderStatusCompleted,
+			read_models.OrderStatusCanceled,
+		)),
+		validation.Field(&s.ToDate, validation.Min(s.FromDate)),
+	)
+	if err != nil {
+		return err
+	}
+
+	return read_models.OrderListQuerySchema.Validate(s.ListQuery)
+}
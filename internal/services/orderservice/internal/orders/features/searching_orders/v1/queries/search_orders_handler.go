@@ -0,0 +1,63 @@
+package queries
+
+import (
+	"context"
+
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/repositories"
+	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/searching_orders/v1/dtos"
+)
+
+type SearchOrdersHandler struct {
+	log                        logger.Logger
+	elasticOrderReadRepository repositories.OrderElasticRepository
+	tracer                     tracing.AppTracer
+}
+
+func NewSearchOrdersHandler(
+	log logger.Logger,
+	elasticOrderReadRepository repositories.OrderElasticRepository,
+	tracer tracing.AppTracer,
+) *SearchOrdersHandler {
+	return &SearchOrdersHandler{
+		log:                        log,
+		elasticOrderReadRepository: elasticOrderReadRepository,
+		tracer:                     tracer,
+	}
+}
+
+func (c *SearchOrdersHandler) Handle(
+	ctx context.Context,
+	query *SearchOrders,
+) (*dtos.SearchOrdersResponseDto, error) {
+	criteria := &repositories.OrderSearchCriteria{
+		SearchText: query.SearchText,
+		Status:     query.Status,
+		FromDate:   query.FromDate,
+		ToDate:     query.ToDate,
+	}
+
+	orders, err := c.elasticOrderReadRepository.FullTextSearchOrders(ctx, criteria, query.ListQuery)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[SearchOrdersHandler_Handle.FullTextSearchOrders] error in searching orders in the repository",
+		)
+	}
+
+	listResultDto, err := utils.ListResultToListResultDto[*dtosV1.OrderReadDto](orders)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[SearchOrdersHandler_Handle.ListResultToListResultDto] error in the mapping ListResultToListResultDto",
+		)
+	}
+
+	c.log.Info("[SearchOrdersHandler.Handle] orders fetched")
+
+	return &dtos.SearchOrdersResponseDto{Orders: listResultDto}, nil
+}
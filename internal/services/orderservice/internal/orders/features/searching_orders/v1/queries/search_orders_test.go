@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Search_Orders_With_Valid_Filters(t *testing.T) {
+	t.Parallel()
+
+	from := time.Now().Add(-24 * time.Hour)
+	query, err := NewSearchOrders("pizza", "paid", from, time.Now(), utils.NewListQuery(10, 1))
+
+	assert.NoError(t, err)
+	assert.Equal(t, read_models.OrderStatusPaid, query.Status)
+}
+
+func Test_Search_Orders_Without_Filters(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSearchOrders("", "", time.Time{}, time.Time{}, utils.NewListQuery(10, 1))
+
+	assert.NoError(t, err)
+}
+
+func Test_Search_Orders_With_Invalid_Status(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSearchOrders("", "unknown", time.Time{}, time.Time{}, utils.NewListQuery(10, 1))
+
+	assert.Error(t, err)
+}
+
+func Test_Search_Orders_With_Invalid_Date_Range(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSearchOrders("", "", time.Now(), time.Now().Add(-time.Hour), utils.NewListQuery(10, 1))
+
+	assert.Error(t, err)
+}
+
+func Test_Search_Orders_With_Invalid_List_Query_Filter(t *testing.T) {
+	t.Parallel()
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.Filters = []*utils.FilterModel{{Field: "shopItems", Comparison: "eq", Value: "pizza"}}
+
+	_, err := NewSearchOrders("", "", time.Time{}, time.Time{}, listQuery)
+
+	assert.Error(t, err)
+}
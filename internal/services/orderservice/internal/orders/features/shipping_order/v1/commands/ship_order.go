@@ -0,0 +1,27 @@
+package shipOrderCommandV1
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ShipOrder struct {
+	OrderId uuid.UUID
+}
+
+func NewShipOrder(orderId uuid.UUID) (*ShipOrder, error) {
+	command := &ShipOrder{OrderId: orderId}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+func (c ShipOrder) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.OrderId, validation.Required),
+	)
+}
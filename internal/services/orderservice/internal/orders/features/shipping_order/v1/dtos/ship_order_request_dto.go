@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// ShipOrderRequestDto validation will handle in command level
+type ShipOrderRequestDto struct {
+	OrderId uuid.UUID `param:"id" json:"-"`
+}
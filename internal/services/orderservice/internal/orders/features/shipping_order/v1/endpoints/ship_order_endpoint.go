@@ -0,0 +1,91 @@
+package shipOrderV1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/params"
+	shipOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/shipping_order/v1/commands"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/shipping_order/v1/dtos"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type shipOrderEndpoint struct {
+	params.OrderRouteParams
+}
+
+func NewShipOrderEndpoint(params params.OrderRouteParams) route.Endpoint {
+	return &shipOrderEndpoint{OrderRouteParams: params}
+}
+
+func (ep *shipOrderEndpoint) MapEndpoint() {
+	ep.OrdersGroup.POST("/:id/ship", ep.handler())
+}
+
+// Ship Order
+// @Tags Orders
+// @Summary Ship order
+// @Description Ship an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 204
+// @Router /api/v1/orders/{id}/ship [post]
+func (ep *shipOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.ShipOrderHttpRequests.Add(ctx, 1)
+
+		request := &dtos.ShipOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[shipOrderEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[shipOrderEndpoint_handler.Bind] err: %v", badRequestErr),
+			)
+			return badRequestErr
+		}
+
+		command, err := shipOrderCommandV1.NewShipOrder(request.OrderId)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[shipOrderEndpoint_handler.StructCtx] command validation failed",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[shipOrderEndpoint_handler.StructCtx] err: %v", validationErr),
+			)
+			return validationErr
+		}
+
+		_, err = mediatr.Send[*shipOrderCommandV1.ShipOrder, *mediatr.Unit](
+			ctx,
+			command,
+		)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[shipOrderEndpoint_handler.Send] error in sending ShipOrder",
+			)
+			ep.Logger.Errorw(
+				fmt.Sprintf(
+					"[shipOrderEndpoint_handler.Send] id: {%s}, err: %v",
+					command.OrderId,
+					err,
+				),
+				logger.Fields{"Id": command.OrderId},
+			)
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
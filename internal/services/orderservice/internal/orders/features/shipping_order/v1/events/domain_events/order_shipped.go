@@ -0,0 +1,34 @@
+package domainEvents
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type OrderShippedV1 struct {
+	*domain.DomainEvent
+	OrderId   uuid.UUID `json:"orderId"   bson:"orderId,omitempty"`
+	ShippedAt time.Time `json:"shippedAt" bson:"shippedAt,omitempty"`
+}
+
+func NewOrderShippedEventV1(orderId uuid.UUID, shippedAt time.Time) (*OrderShippedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError(fmt.Sprintf("orderId {%s} is invalid", orderId))
+	}
+
+	if shippedAt.IsZero() {
+		return nil, customErrors.NewDomainError("shippedAt can't be zero")
+	}
+
+	eventData := &OrderShippedV1{OrderId: orderId, ShippedAt: shippedAt}
+
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
@@ -1,13 +1,27 @@
-package commands
+package submitOrderCommandV1
 
 import (
+	validation "github.com/go-ozzo/ozzo-validation"
 	uuid "github.com/satori/go.uuid"
 )
 
 type SubmitOrder struct {
-	OrderId uuid.UUID `validate:"required"`
+	OrderId uuid.UUID
 }
 
-func NewSubmitOrder(orderId uuid.UUID) *SubmitOrder {
-	return &SubmitOrder{OrderId: orderId}
+func NewSubmitOrder(orderId uuid.UUID) (*SubmitOrder, error) {
+	command := &SubmitOrder{OrderId: orderId}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+func (c SubmitOrder) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.OrderId, validation.Required),
+	)
 }
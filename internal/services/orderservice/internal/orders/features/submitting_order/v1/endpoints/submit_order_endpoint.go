@@ -0,0 +1,91 @@
+package submitOrderV1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/web/route"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/params"
+	submitOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/submitting_order/v1/commands"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/submitting_order/v1/dtos"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+)
+
+type submitOrderEndpoint struct {
+	params.OrderRouteParams
+}
+
+func NewSubmitOrderEndpoint(params params.OrderRouteParams) route.Endpoint {
+	return &submitOrderEndpoint{OrderRouteParams: params}
+}
+
+func (ep *submitOrderEndpoint) MapEndpoint() {
+	ep.OrdersGroup.POST("/:id/submit", ep.handler())
+}
+
+// Submit Order
+// @Tags Orders
+// @Summary Submit order
+// @Description Submit an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 204
+// @Router /api/v1/orders/{id}/submit [post]
+func (ep *submitOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.SubmitOrderHttpRequests.Add(ctx, 1)
+
+		request := &dtos.SubmitOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[submitOrderEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[submitOrderEndpoint_handler.Bind] err: %v", badRequestErr),
+			)
+			return badRequestErr
+		}
+
+		command, err := submitOrderCommandV1.NewSubmitOrder(request.OrderId)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[submitOrderEndpoint_handler.StructCtx] command validation failed",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[submitOrderEndpoint_handler.StructCtx] err: %v", validationErr),
+			)
+			return validationErr
+		}
+
+		_, err = mediatr.Send[*submitOrderCommandV1.SubmitOrder, *mediatr.Unit](
+			ctx,
+			command,
+		)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[submitOrderEndpoint_handler.Send] error in sending SubmitOrder",
+			)
+			ep.Logger.Errorw(
+				fmt.Sprintf(
+					"[submitOrderEndpoint_handler.Send] id: {%s}, err: %v",
+					command.OrderId,
+					err,
+				),
+				logger.Fields{"Id": command.OrderId},
+			)
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -2,22 +2,33 @@ package domainEvents
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
 	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 
 	uuid "github.com/satori/go.uuid"
 )
 
 type OrderSubmittedV1 struct {
-	OrderId uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	*domain.DomainEvent
+	OrderId     uuid.UUID `json:"orderId"     bson:"orderId,omitempty"`
+	SubmittedAt time.Time `json:"submittedAt" bson:"submittedAt,omitempty"`
 }
 
-func NewSubmitOrderV1(orderId uuid.UUID) (*OrderSubmittedV1, error) {
+func NewOrderSubmittedEventV1(orderId uuid.UUID, submittedAt time.Time) (*OrderSubmittedV1, error) {
 	if orderId == uuid.Nil {
 		return nil, customErrors.NewDomainError(fmt.Sprintf("orderId {%s} is invalid", orderId))
 	}
 
-	event := OrderSubmittedV1{OrderId: orderId}
+	if submittedAt.IsZero() {
+		return nil, customErrors.NewDomainError("submittedAt can't be zero")
+	}
+
+	eventData := &OrderSubmittedV1{OrderId: orderId, SubmittedAt: submittedAt}
+
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
 
-	return &event, nil
+	return eventData, nil
 }
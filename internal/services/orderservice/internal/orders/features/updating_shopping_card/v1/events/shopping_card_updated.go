@@ -1,21 +1,33 @@
 package domainEvent
 
 import (
+	"time"
+
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
-	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/value_objects"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
+	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
+	domainExceptions "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/exceptions/domain_exceptions"
 )
 
 type ShoppingCartUpdatedV1 struct {
 	*domain.DomainEvent
-	ShopItems []*value_objects.ShopItem `json:"shopItems" bson:"shopItems,omitempty"`
+	ShopItems []*dtosV1.ShopItemDto `json:"shopItems" bson:"shopItems,omitempty"`
+	UpdatedAt time.Time             `json:"updatedAt" bson:"updatedAt,omitempty"`
 }
 
-func NewShoppingCartUpdatedV1(shopItems []*value_objects.ShopItem) (*ShoppingCartUpdatedV1, error) {
-	//if shopItems == nil {
-	//	return nil, domainExceptions.ErrOrderShopItemsIsRequired
-	//}
+func NewShoppingCartUpdatedV1(shopItems []*dtosV1.ShopItemDto, updatedAt time.Time) (*ShoppingCartUpdatedV1, error) {
+	if len(shopItems) == 0 {
+		return nil, domainExceptions.NewOrderShopItemsRequiredError("shopItems is required")
+	}
+
+	if updatedAt.IsZero() {
+		return nil, customErrors.NewDomainError("updatedAt can't be zero")
+	}
+
+	eventData := &ShoppingCartUpdatedV1{ShopItems: shopItems, UpdatedAt: updatedAt}
 
-	eventData := ShoppingCartUpdatedV1{ShopItems: shopItems}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
 
-	return &eventData, nil
+	return eventData, nil
 }
@@ -13,7 +13,12 @@ import (
 	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
 	domainExceptions "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/exceptions/domain_exceptions"
+	cancelOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/events/domain_events"
+	completeOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/completing_order/v1/events/domain_events"
 	createOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/events/domain_events"
+	payOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/paying_order/v1/events/domain_events"
+	shipOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/shipping_order/v1/events/domain_events"
+	submitOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/submitting_order/v1/events/domain_events"
 	updateOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/updating_shopping_card/v1/events"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/value_objects"
 
@@ -31,6 +36,7 @@ type Order struct {
 	deliveredTime   time.Time
 	paid            bool
 	submitted       bool
+	shipped         bool
 	completed       bool
 	canceled        bool
 	paymentId       uuid.UUID
@@ -96,14 +102,191 @@ func NewOrder(
 }
 
 func (o *Order) UpdateShoppingCard(shopItems []*value_objects.ShopItem) error {
-	event, err := updateOrderDomainEventsV1.NewShoppingCartUpdatedV1(shopItems)
+	if o.canceled || o.submitted {
+		return domainExceptions.NewInvalidOrderStatusError(
+			"[Order_UpdateShoppingCard] shopping cart can't be updated after submitting or canceling the order",
+		)
+	}
+
+	itemsDto, err := mapper.Map[[]*dtosV1.ShopItemDto](shopItems)
 	if err != nil {
-		return err
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_UpdateShoppingCard.Map] error in the mapping []ShopItems to []ShopItemsDto",
+		)
+	}
+
+	event, err := updateOrderDomainEventsV1.NewShoppingCartUpdatedV1(itemsDto, time.Now())
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_UpdateShoppingCard.NewShoppingCartUpdatedV1] error in creating shopping cart updated event",
+		)
 	}
 
 	err = o.Apply(event, true)
 	if err != nil {
-		return err
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_UpdateShoppingCard.Apply] error in applying shopping cart updated event",
+		)
+	}
+
+	return nil
+}
+
+// Submit submits a created order, submitted orders are ready for the payment
+func (o *Order) Submit() error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Submit] canceled order can't be submitted")
+	}
+
+	if o.submitted {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Submit] order is already submitted")
+	}
+
+	event, err := submitOrderDomainEventsV1.NewOrderSubmittedEventV1(o.Id(), time.Now())
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Submit.NewOrderSubmittedEventV1] error in creating order submitted event",
+		)
+	}
+
+	err = o.Apply(event, true)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Submit.Apply] error in applying order submitted event",
+		)
+	}
+
+	return nil
+}
+
+// Pay records the payment of a submitted order
+func (o *Order) Pay(paymentId uuid.UUID) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Pay] canceled order can't be paid")
+	}
+
+	if !o.submitted {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Pay] order should be submitted before the payment")
+	}
+
+	if o.paid {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Pay] order is already paid")
+	}
+
+	event, err := payOrderDomainEventsV1.NewOrderPaidEventV1(o.Id(), paymentId, time.Now())
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Pay.NewOrderPaidEventV1] error in creating order paid event",
+		)
+	}
+
+	err = o.Apply(event, true)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Pay.Apply] error in applying order paid event",
+		)
+	}
+
+	return nil
+}
+
+// Ship ships a paid order
+func (o *Order) Ship() error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Ship] canceled order can't be shipped")
+	}
+
+	if !o.paid {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Ship] order should be paid before shipping")
+	}
+
+	if o.shipped {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Ship] order is already shipped")
+	}
+
+	event, err := shipOrderDomainEventsV1.NewOrderShippedEventV1(o.Id(), time.Now())
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Ship.NewOrderShippedEventV1] error in creating order shipped event",
+		)
+	}
+
+	err = o.Apply(event, true)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Ship.Apply] error in applying order shipped event",
+		)
+	}
+
+	return nil
+}
+
+// Complete completes a shipped order after the delivery
+func (o *Order) Complete() error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Complete] canceled order can't be completed")
+	}
+
+	if !o.shipped {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Complete] order should be shipped before completing")
+	}
+
+	if o.completed {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Complete] order is already completed")
+	}
+
+	event, err := completeOrderDomainEventsV1.NewOrderCompletedEventV1(o.Id(), time.Now())
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Complete.NewOrderCompletedEventV1] error in creating order completed event",
+		)
+	}
+
+	err = o.Apply(event, true)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Complete.Apply] error in applying order completed event",
+		)
+	}
+
+	return nil
+}
+
+// Cancel cancels the order, shipped and completed orders can't be canceled anymore
+func (o *Order) Cancel(cancelReason string) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Cancel] order is already canceled")
+	}
+
+	if o.shipped || o.completed {
+		return domainExceptions.NewInvalidOrderStatusError("[Order_Cancel] shipped or completed order can't be canceled")
+	}
+
+	event, err := cancelOrderDomainEventsV1.NewOrderCanceledEventV1(o.Id(), cancelReason, time.Now())
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Cancel.NewOrderCanceledEventV1] error in creating order canceled event",
+		)
+	}
+
+	err = o.Apply(event, true)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_Cancel.Apply] error in applying order canceled event",
+		)
 	}
 
 	return nil
@@ -115,6 +298,24 @@ func (o *Order) When(event domain.IDomainEvent) error {
 	case *createOrderDomainEventsV1.OrderCreatedV1:
 		return o.onOrderCreated(evt)
 
+	case *updateOrderDomainEventsV1.ShoppingCartUpdatedV1:
+		return o.onShoppingCartUpdated(evt)
+
+	case *submitOrderDomainEventsV1.OrderSubmittedV1:
+		return o.onOrderSubmitted(evt)
+
+	case *payOrderDomainEventsV1.OrderPaidV1:
+		return o.onOrderPaid(evt)
+
+	case *shipOrderDomainEventsV1.OrderShippedV1:
+		return o.onOrderShipped(evt)
+
+	case *completeOrderDomainEventsV1.OrderCompletedV1:
+		return o.onOrderCompleted(evt)
+
+	case *cancelOrderDomainEventsV1.OrderCanceledV1:
+		return o.onOrderCanceled(evt)
+
 	default:
 		return errors.InvalidEventTypeError
 	}
@@ -136,6 +337,55 @@ func (o *Order) onOrderCreated(evt *createOrderDomainEventsV1.OrderCreatedV1) er
 	return nil
 }
 
+func (o *Order) onShoppingCartUpdated(evt *updateOrderDomainEventsV1.ShoppingCartUpdatedV1) error {
+	items, err := mapper.Map[[]*value_objects.ShopItem](evt.ShopItems)
+	if err != nil {
+		return err
+	}
+
+	o.shopItems = items
+	o.updatedAt = evt.UpdatedAt
+
+	return nil
+}
+
+func (o *Order) onOrderSubmitted(evt *submitOrderDomainEventsV1.OrderSubmittedV1) error {
+	o.submitted = true
+	o.updatedAt = evt.SubmittedAt
+
+	return nil
+}
+
+func (o *Order) onOrderPaid(evt *payOrderDomainEventsV1.OrderPaidV1) error {
+	o.paid = true
+	o.paymentId = evt.PaymentId
+	o.updatedAt = evt.PaidAt
+
+	return nil
+}
+
+func (o *Order) onOrderShipped(evt *shipOrderDomainEventsV1.OrderShippedV1) error {
+	o.shipped = true
+	o.updatedAt = evt.ShippedAt
+
+	return nil
+}
+
+func (o *Order) onOrderCompleted(evt *completeOrderDomainEventsV1.OrderCompletedV1) error {
+	o.completed = true
+	o.updatedAt = evt.CompletedAt
+
+	return nil
+}
+
+func (o *Order) onOrderCanceled(evt *cancelOrderDomainEventsV1.OrderCanceledV1) error {
+	o.canceled = true
+	o.cancelReason = evt.CancelReason
+	o.updatedAt = evt.CanceledAt
+
+	return nil
+}
+
 func (o *Order) ShopItems() []*value_objects.ShopItem {
 	return o.shopItems
 }
@@ -160,6 +410,10 @@ func (o *Order) CreatedAt() time.Time {
 	return o.createdAt
 }
 
+func (o *Order) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
 func (o *Order) TotalPrice() float64 {
 	return getShopItemsTotalPrice(o.shopItems)
 }
@@ -172,6 +426,10 @@ func (o *Order) Submitted() bool {
 	return o.submitted
 }
 
+func (o *Order) Shipped() bool {
+	return o.shipped
+}
+
 func (o *Order) Completed() bool {
 	return o.completed
 }
@@ -0,0 +1,94 @@
+package aggregate
+
+import (
+	"time"
+
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
+	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/value_objects"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// orderSnapshotSchemaVersion should increase with changing the OrderSnapshot structure
+const orderSnapshotSchemaVersion = 1
+
+// OrderSnapshot is the stored state of the order aggregate in its snapshots
+type OrderSnapshot struct {
+	ShopItems       []*dtosV1.ShopItemDto `json:"shopItems"`
+	AccountEmail    string                `json:"accountEmail"`
+	DeliveryAddress string                `json:"deliveryAddress"`
+	CancelReason    string                `json:"cancelReason"`
+	DeliveredTime   time.Time             `json:"deliveredTime"`
+	Paid            bool                  `json:"paid"`
+	Submitted       bool                  `json:"submitted"`
+	Shipped         bool                  `json:"shipped"`
+	Completed       bool                  `json:"completed"`
+	Canceled        bool                  `json:"canceled"`
+	PaymentId       uuid.UUID             `json:"paymentId"`
+	CreatedAt       time.Time             `json:"createdAt"`
+	UpdatedAt       time.Time             `json:"updatedAt"`
+}
+
+func (o *Order) SnapshotSchemaVersion() int {
+	return orderSnapshotSchemaVersion
+}
+
+func (o *Order) CreateSnapshot() (interface{}, error) {
+	itemsDto, err := mapper.Map[[]*dtosV1.ShopItemDto](o.shopItems)
+	if err != nil {
+		return nil, customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_CreateSnapshot.Map] error in the mapping []ShopItems to []ShopItemsDto",
+		)
+	}
+
+	return &OrderSnapshot{
+		ShopItems:       itemsDto,
+		AccountEmail:    o.accountEmail,
+		DeliveryAddress: o.deliveryAddress,
+		CancelReason:    o.cancelReason,
+		DeliveredTime:   o.deliveredTime,
+		Paid:            o.paid,
+		Submitted:       o.submitted,
+		Shipped:         o.shipped,
+		Completed:       o.completed,
+		Canceled:        o.canceled,
+		PaymentId:       o.paymentId,
+		CreatedAt:       o.createdAt,
+		UpdatedAt:       o.updatedAt,
+	}, nil
+}
+
+func (o *Order) RestoreSnapshot(state interface{}) error {
+	snapshot, ok := state.(*OrderSnapshot)
+	if !ok {
+		return errors.Errorf("[Order_RestoreSnapshot] invalid order snapshot state type %T", state)
+	}
+
+	items, err := mapper.Map[[]*value_objects.ShopItem](snapshot.ShopItems)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(
+			err,
+			"[Order_RestoreSnapshot.Map] error in the mapping []ShopItemsDto to []ShopItems",
+		)
+	}
+
+	o.shopItems = items
+	o.accountEmail = snapshot.AccountEmail
+	o.deliveryAddress = snapshot.DeliveryAddress
+	o.cancelReason = snapshot.CancelReason
+	o.deliveredTime = snapshot.DeliveredTime
+	o.paid = snapshot.Paid
+	o.submitted = snapshot.Submitted
+	o.shipped = snapshot.Shipped
+	o.completed = snapshot.Completed
+	o.canceled = snapshot.Canceled
+	o.paymentId = snapshot.PaymentId
+	o.createdAt = snapshot.CreatedAt
+	o.updatedAt = snapshot.UpdatedAt
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package aggregate_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/configurations/mappings"
+	domainExceptions "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/exceptions/domain_exceptions"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/value_objects"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	if err := mappings.ConfigureOrdersMappings(); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_Order_Lifecycle_Transitions(t *testing.T) {
+	order := newOrder(t)
+	paymentId := uuid.NewV4()
+
+	require.NoError(t, order.Submit())
+	require.NoError(t, order.Pay(paymentId))
+	require.NoError(t, order.Ship())
+	require.NoError(t, order.Complete())
+
+	assert.True(t, order.Submitted())
+	assert.True(t, order.Paid())
+	assert.True(t, order.Shipped())
+	assert.True(t, order.Completed())
+	assert.Equal(t, paymentId, order.PaymentId())
+	assert.Len(t, order.UncommittedEvents(), 5)
+}
+
+func Test_Order_Should_Not_Pay_Before_Submit(t *testing.T) {
+	order := newOrder(t)
+
+	err := order.Pay(uuid.NewV4())
+	assert.True(t, domainExceptions.IsInvalidOrderStatusError(err))
+	assert.False(t, order.Paid())
+}
+
+func Test_Order_Should_Not_Ship_Before_Pay(t *testing.T) {
+	order := newOrder(t)
+	require.NoError(t, order.Submit())
+
+	err := order.Ship()
+	assert.True(t, domainExceptions.IsInvalidOrderStatusError(err))
+}
+
+func Test_Order_Should_Not_Complete_Before_Ship(t *testing.T) {
+	order := newOrder(t)
+	require.NoError(t, order.Submit())
+	require.NoError(t, order.Pay(uuid.NewV4()))
+
+	err := order.Complete()
+	assert.True(t, domainExceptions.IsInvalidOrderStatusError(err))
+}
+
+func Test_Order_Should_Not_Submit_Twice(t *testing.T) {
+	order := newOrder(t)
+	require.NoError(t, order.Submit())
+
+	err := order.Submit()
+	assert.True(t, domainExceptions.IsInvalidOrderStatusError(err))
+}
+
+func Test_Order_Cancel(t *testing.T) {
+	order := newOrder(t)
+	require.NoError(t, order.Submit())
+
+	require.NoError(t, order.Cancel("customer request"))
+	assert.True(t, order.Canceled())
+	assert.Equal(t, "customer request", order.CancelReason())
+
+	err := order.Pay(uuid.NewV4())
+	assert.True(t, domainExceptions.IsInvalidOrderStatusError(err))
+}
+
+func Test_Order_Should_Not_Cancel_Shipped_Order(t *testing.T) {
+	order := newOrder(t)
+	require.NoError(t, order.Submit())
+	require.NoError(t, order.Pay(uuid.NewV4()))
+	require.NoError(t, order.Ship())
+
+	err := order.Cancel("customer request")
+	assert.True(t, domainExceptions.IsInvalidOrderStatusError(err))
+	assert.False(t, order.Canceled())
+}
+
+func Test_Order_Update_Shopping_Cart(t *testing.T) {
+	order := newOrder(t)
+
+	err := order.UpdateShoppingCard(
+		[]*value_objects.ShopItem{value_objects.CreateNewShopItem("book", "a book", 2, 10)},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, float64(20), order.TotalPrice())
+
+	require.NoError(t, order.Submit())
+	err = order.UpdateShoppingCard(
+		[]*value_objects.ShopItem{value_objects.CreateNewShopItem("pen", "a pen", 1, 1)},
+	)
+	assert.True(t, domainExceptions.IsInvalidOrderStatusError(err))
+}
+
+func Test_Order_Restore_Snapshot(t *testing.T) {
+	order := newOrder(t)
+	paymentId := uuid.NewV4()
+	require.NoError(t, order.Submit())
+	require.NoError(t, order.Pay(paymentId))
+
+	state, err := order.CreateSnapshot()
+	require.NoError(t, err)
+
+	restoredOrder := &aggregate.Order{}
+	restoredOrder.NewEmptyAggregate()
+	restoredOrder.SetId(order.Id())
+	require.NoError(t, restoredOrder.RestoreSnapshot(state))
+
+	assert.True(t, restoredOrder.Submitted())
+	assert.True(t, restoredOrder.Paid())
+	assert.False(t, restoredOrder.Shipped())
+	assert.Equal(t, paymentId, restoredOrder.PaymentId())
+	assert.Equal(t, order.AccountEmail(), restoredOrder.AccountEmail())
+	assert.Equal(t, order.TotalPrice(), restoredOrder.TotalPrice())
+	assert.Len(t, restoredOrder.ShopItems(), 1)
+
+	// the restored order keeps the lifecycle guards
+	require.NoError(t, restoredOrder.Ship())
+}
+
+func newOrder(t *testing.T) *aggregate.Order {
+	t.Helper()
+
+	order, err := aggregate.NewOrder(
+		uuid.NewV4(),
+		[]*value_objects.ShopItem{value_objects.CreateNewShopItem("phone", "a phone", 1, 100)},
+		"test@example.com",
+		"street 1",
+		time.Now().Add(24*time.Hour),
+		time.Now(),
+	)
+	require.NoError(t, err)
+
+	return order
+}
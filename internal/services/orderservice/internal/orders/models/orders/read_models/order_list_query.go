@@ -0,0 +1,26 @@
+package read_models
+
+import (
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/listquery"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/data/specification"
+)
+
+// OrderListQuerySchema is the allow-list of the order fields which the list queries can filter and sort by, the public
+// names are the same as the json names of the orders
+var OrderListQuerySchema = listquery.NewSchema(
+	listquery.NewKeyField("orderId", specification.MustField[OrderReadModel, string]("OrderId")),
+	listquery.NewField("accountEmail", specification.MustField[OrderReadModel, string]("AccountEmail")),
+	listquery.NewField("paymentId", specification.MustField[OrderReadModel, string]("PaymentId")),
+	listquery.NewField("status", specification.MustField[OrderReadModel, OrderStatus]("Status")),
+	listquery.NewField("totalPrice", specification.MustField[OrderReadModel, float64]("TotalPrice")),
+	listquery.NewField("paid", specification.MustField[OrderReadModel, bool]("Paid")),
+	listquery.NewField("submitted", specification.MustField[OrderReadModel, bool]("Submitted")),
+	listquery.NewField("shipped", specification.MustField[OrderReadModel, bool]("Shipped")),
+	listquery.NewField("completed", specification.MustField[OrderReadModel, bool]("Completed")),
+	listquery.NewField("canceled", specification.MustField[OrderReadModel, bool]("Canceled")),
+	listquery.NewField("deliveredTime", specification.MustField[OrderReadModel, time.Time]("DeliveredTime")),
+	listquery.NewField("createdAt", specification.MustField[OrderReadModel, time.Time]("CreatedAt")),
+	listquery.NewField("updatedAt", specification.MustField[OrderReadModel, time.Time]("UpdatedAt")),
+)
@@ -18,8 +18,10 @@ type OrderReadModel struct {
 	DeliveredTime   time.Time            `json:"deliveredTime,omitempty"   bson:"deliveredTime,omitempty"`
 	Paid            bool                 `json:"paid,omitempty"            bson:"paid,omitempty"`
 	Submitted       bool                 `json:"submitted,omitempty"       bson:"submitted,omitempty"`
+	Shipped         bool                 `json:"shipped,omitempty"         bson:"shipped,omitempty"`
 	Completed       bool                 `json:"completed,omitempty"       bson:"completed,omitempty"`
 	Canceled        bool                 `json:"canceled,omitempty"        bson:"canceled,omitempty"`
+	Status          OrderStatus          `json:"status,omitempty"          bson:"status,omitempty"`
 	PaymentId       string               `json:"paymentId"                 bson:"paymentId,omitempty"`
 	CreatedAt       time.Time            `json:"createdAt,omitempty"       bson:"createdAt,omitempty"`
 	UpdatedAt       time.Time            `json:"updatedAt,omitempty"       bson:"updatedAt,omitempty"`
@@ -40,13 +42,14 @@ func NewOrderReadModel(
 		ShopItems:       items,
 		AccountEmail:    accountEmail,
 		DeliveryAddress: deliveryAddress,
-		TotalPrice:      getShopItemsTotalPrice(items),
+		TotalPrice:      GetShopItemsTotalPrice(items),
 		DeliveredTime:   deliveryTime,
+		Status:          OrderStatusCreated,
 		CreatedAt:       time.Now(),
 	}
 }
 
-func getShopItemsTotalPrice(shopItems []*ShopItemReadModel) float64 {
+func GetShopItemsTotalPrice(shopItems []*ShopItemReadModel) float64 {
 	var totalPrice float64 = 0
 	for _, item := range shopItems {
 		totalPrice += item.Price * float64(item.Quantity)
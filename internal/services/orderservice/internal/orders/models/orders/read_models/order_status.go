@@ -0,0 +1,27 @@
+package read_models
+
+// OrderStatus is the current lifecycle state of an order in the read models, it is used for filtering orders
+type OrderStatus string
+
+const (
+	OrderStatusCreated   OrderStatus = "created"
+	OrderStatusSubmitted OrderStatus = "submitted"
+	OrderStatusPaid      OrderStatus = "paid"
+	OrderStatusShipped   OrderStatus = "shipped"
+	OrderStatusCompleted OrderStatus = "completed"
+	OrderStatusCanceled  OrderStatus = "canceled"
+)
+
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusCreated,
+		OrderStatusSubmitted,
+		OrderStatusPaid,
+		OrderStatusShipped,
+		OrderStatusCompleted,
+		OrderStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
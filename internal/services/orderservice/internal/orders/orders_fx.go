@@ -5,10 +5,18 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb"
 	echocontracts "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/customecho/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/catalogs"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/data/repositories"
+	cancelOrderV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/endpoints"
+	completeOrderV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/completing_order/v1/endpoints"
 	createOrderV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/endpoints"
 	getOrderByIdV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_order_by_id/v1/endpoints"
 	getOrdersV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_orders/v1/endpoints"
+	payOrderV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/paying_order/v1/endpoints"
+	rebuildProjectionsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/rebuilding_projections/v1/endpoints"
+	searchOrdersV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/searching_orders/v1/endpoints"
+	shipOrderV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/shipping_order/v1/endpoints"
+	submitOrderV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/submitting_order/v1/endpoints"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/aggregate"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/projections"
 
@@ -22,8 +30,9 @@ var Module = fx.Module(
 	// Other provides
 	fx.Provide(fx.Annotate(repositories.NewMongoOrderReadRepository)),
 	fx.Provide(repositories.NewElasticOrderReadRepository),
+	fx.Provide(catalogs.NewProductsAvailabilityChecker),
 
-	fx.Provide(eventstroredb.NewEventStoreAggregateStore[*aggregate.Order]),
+	fx.Provide(eventstroredb.NewEventStoreAggregateStoreWithSnapshot[*aggregate.Order]),
 	fx.Provide(fx.Annotate(func(catalogsServer echocontracts.EchoHttpServer) *echo.Group {
 		var g *echo.Group
 		catalogsServer.RouteBuilder().RegisterGroupFunc("/api/v1", func(v1 *echo.Group) {
@@ -38,6 +47,13 @@ var Module = fx.Module(
 		route.AsRoute(createOrderV1.NewCreteOrderEndpoint, "order-routes"),
 		route.AsRoute(getOrderByIdV1.NewGetOrderByIdEndpoint, "order-routes"),
 		route.AsRoute(getOrdersV1.NewGetOrdersEndpoint, "order-routes"),
+		route.AsRoute(searchOrdersV1.NewSearchOrdersEndpoint, "order-routes"),
+		route.AsRoute(submitOrderV1.NewSubmitOrderEndpoint, "order-routes"),
+		route.AsRoute(payOrderV1.NewPayOrderEndpoint, "order-routes"),
+		route.AsRoute(shipOrderV1.NewShipOrderEndpoint, "order-routes"),
+		route.AsRoute(completeOrderV1.NewCompleteOrderEndpoint, "order-routes"),
+		route.AsRoute(cancelOrderV1.NewCancelOrderEndpoint, "order-routes"),
+		route.AsRoute(rebuildProjectionsV1.NewRebuildProjectionsEndpoint, "order-routes"),
 	),
 
 	fx.Provide(
@@ -2,26 +2,200 @@ package projections
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/models"
+	customErrors "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/httperrors/customerrors"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/attribute"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/utils"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/repositories"
+	createOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/events/domain_events"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+	attribute2 "go.opentelemetry.io/otel/attribute"
 )
 
 type elasticOrderProjection struct {
 	elasticOrderReadRepository repositories.OrderElasticRepository
+	logger                     logger.Logger
+	tracer                     tracing.AppTracer
 }
 
 func NewElasticOrderProjection(
 	elasticOrderReadRepository repositories.OrderElasticRepository,
+	logger logger.Logger,
+	tracer tracing.AppTracer,
 ) projection.IProjection {
-	return &elasticOrderProjection{elasticOrderReadRepository: elasticOrderReadRepository}
+	return &elasticOrderProjection{
+		elasticOrderReadRepository: elasticOrderReadRepository,
+		logger:                     logger,
+		tracer:                     tracer,
+	}
+}
+
+// ProjectionName is used for selecting the projection in the projections rebuild
+func (e elasticOrderProjection) ProjectionName() string {
+	return "elastic-orders"
+}
+
+// Reset removes the elastic read models before rebuilding the projection
+func (e elasticOrderProjection) Reset(ctx context.Context) error {
+	return e.elasticOrderReadRepository.DeleteAllOrders(ctx)
 }
 
 func (e elasticOrderProjection) ProcessEvent(
 	ctx context.Context,
 	streamEvent *models.StreamEvent,
 ) error {
-	// TODO: Handling and projecting event to elastic read model
+	// Handling and projecting event to elastic read model
+	switch evt := streamEvent.Event.(type) {
+	case *createOrderDomainEventsV1.OrderCreatedV1:
+		return e.onOrderCreated(ctx, evt)
+	}
+
+	if orderId, update, ok := getOrderReadModelUpdate(streamEvent.Event); ok {
+		return e.updateOrder(ctx, orderId, typeMapper.GetTypeName(streamEvent.Event), update)
+	}
+
+	return nil
+}
+
+func (e *elasticOrderProjection) onOrderCreated(
+	ctx context.Context,
+	evt *createOrderDomainEventsV1.OrderCreatedV1,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticOrderProjection.onOrderCreated")
+	span.SetAttributes(attribute.Object("Event", evt))
+	span.SetAttributes(attribute2.String("OrderId", evt.OrderId.String()))
+	defer span.End()
+
+	items, err := mapper.Map[[]*read_models.ShopItemReadModel](evt.ShopItems)
+	if err != nil {
+		return errors.WrapIf(
+			err,
+			"[elasticOrderProjection_onOrderCreated.Map] error in mapping shopItems",
+		)
+	}
+
+	orderRead := read_models.NewOrderReadModel(
+		evt.OrderId,
+		items,
+		evt.AccountEmail,
+		evt.DeliveryAddress,
+		evt.DeliveredTime,
+	)
+
+	// the order read model id is generated in the projection, so a replayed event keeps the indexed order id
+	existingOrder, err := e.elasticOrderReadRepository.GetOrderByOrderId(ctx, evt.OrderId)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[elasticOrderProjection_onOrderCreated.GetOrderByOrderId] error in getting order with elasticOrderReadRepository",
+			),
+		)
+	}
+	if existingOrder != nil {
+		orderRead.Id = existingOrder.Id
+	}
+
+	_, err = e.elasticOrderReadRepository.CreateOrder(ctx, orderRead)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[elasticOrderProjection_onOrderCreated.CreateOrder] error in creating order with elasticOrderReadRepository",
+			),
+		)
+	}
+
+	e.logger.Infow(
+		fmt.Sprintf(
+			"[elasticOrderProjection.onOrderCreated] order with orderId '%s' indexed",
+			orderRead.OrderId,
+		),
+		logger.Fields{"Id": orderRead.Id, "OrderId": orderRead.OrderId},
+	)
+
+	return nil
+}
+
+// updateOrder loads the indexed order, applies the event changes on it and re-indexes the updated read model
+func (e *elasticOrderProjection) updateOrder(
+	ctx context.Context,
+	orderId uuid.UUID,
+	eventType string,
+	update orderReadModelUpdate,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticOrderProjection.updateOrder")
+	span.SetAttributes(attribute2.String("EventType", eventType))
+	span.SetAttributes(attribute2.String("OrderId", orderId.String()))
+	defer span.End()
+
+	orderRead, err := e.elasticOrderReadRepository.GetOrderByOrderId(ctx, orderId)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf(
+					"[elasticOrderProjection_updateOrder.GetOrderByOrderId] error in getting order with orderId %s",
+					orderId,
+				),
+			),
+		)
+	}
+
+	if orderRead == nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			customErrors.NewNotFoundError(
+				fmt.Sprintf(
+					"[elasticOrderProjection_updateOrder] order with orderId %s not found",
+					orderId,
+				),
+			),
+		)
+	}
+
+	if err := update(orderRead); err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf("[elasticOrderProjection_updateOrder] error in applying %s on the order read model", eventType),
+			),
+		)
+	}
+
+	_, err = e.elasticOrderReadRepository.UpdateOrder(ctx, orderRead)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[elasticOrderProjection_updateOrder.UpdateOrder] error in updating order with elasticOrderReadRepository",
+			),
+		)
+	}
+
+	e.logger.Infow(
+		fmt.Sprintf(
+			"[elasticOrderProjection.updateOrder] order with orderId '%s' updated by %s",
+			orderId,
+			eventType,
+		),
+		logger.Fields{"Id": orderRead.Id, "OrderId": orderId},
+	)
+
 	return nil
 }
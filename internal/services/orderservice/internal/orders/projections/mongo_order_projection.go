@@ -13,6 +13,7 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/attribute"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/utils"
+	typeMapper "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/reflection/typemapper"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/repositories"
 	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
 	createOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/events/domain_events"
@@ -20,6 +21,7 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
 
 	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
 	attribute2 "go.opentelemetry.io/otel/attribute"
 )
 
@@ -44,16 +46,30 @@ func NewMongoOrderProjection(
 	}
 }
 
+// ProjectionName is used for selecting the projection in the projections rebuild
+func (m mongoOrderProjection) ProjectionName() string {
+	return "mongo-orders"
+}
+
+// Reset removes the mongo read models before rebuilding the projection
+func (m mongoOrderProjection) Reset(ctx context.Context) error {
+	return m.mongoOrderRepository.DeleteAllOrders(ctx)
+}
+
 func (m mongoOrderProjection) ProcessEvent(
 	ctx context.Context,
 	streamEvent *models.StreamEvent,
 ) error {
-	// Handling and projecting event to elastic read model
+	// Handling and projecting event to mongo read model
 	switch evt := streamEvent.Event.(type) {
 	case *createOrderDomainEventsV1.OrderCreatedV1:
 		return m.onOrderCreated(ctx, evt)
 	}
 
+	if orderId, update, ok := getOrderReadModelUpdate(streamEvent.Event); ok {
+		return m.updateOrder(ctx, orderId, typeMapper.GetTypeName(streamEvent.Event), update)
+	}
+
 	return nil
 }
 
@@ -92,6 +108,16 @@ func (m *mongoOrderProjection) onOrderCreated(
 		)
 	}
 
+	// the integration event is published already for the replayed events
+	if projection.IsReplay(ctx) {
+		m.logger.Infow(
+			fmt.Sprintf("[mongoOrderProjection.onOrderCreated] order with id '%s' replayed", orderRead.OrderId),
+			logger.Fields{"Id": orderRead.Id},
+		)
+
+		return nil
+	}
+
 	orderReadDto, err := mapper.Map[*dtosV1.OrderReadDto](orderRead)
 	if err != nil {
 		return utils.TraceErrStatusFromSpan(
@@ -134,3 +160,74 @@ func (m *mongoOrderProjection) onOrderCreated(
 
 	return nil
 }
+
+// updateOrder loads the order read model, applies the event changes on it and saves the updated read model
+func (m *mongoOrderProjection) updateOrder(
+	ctx context.Context,
+	orderId uuid.UUID,
+	eventType string,
+	update orderReadModelUpdate,
+) error {
+	ctx, span := m.tracer.Start(ctx, "mongoOrderProjection.updateOrder")
+	span.SetAttributes(attribute2.String("EventType", eventType))
+	span.SetAttributes(attribute2.String("OrderId", orderId.String()))
+	defer span.End()
+
+	orderRead, err := m.mongoOrderRepository.GetOrderByOrderId(ctx, orderId)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf(
+					"[mongoOrderProjection_updateOrder.GetOrderByOrderId] error in getting order with orderId %s",
+					orderId,
+				),
+			),
+		)
+	}
+
+	if orderRead == nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			customErrors.NewNotFoundError(
+				fmt.Sprintf(
+					"[mongoOrderProjection_updateOrder] order with orderId %s not found",
+					orderId,
+				),
+			),
+		)
+	}
+
+	if err := update(orderRead); err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf("[mongoOrderProjection_updateOrder] error in applying %s on the order read model", eventType),
+			),
+		)
+	}
+
+	_, err = m.mongoOrderRepository.UpdateOrder(ctx, orderRead)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[mongoOrderProjection_updateOrder.UpdateOrder] error in updating order with mongoOrderRepository",
+			),
+		)
+	}
+
+	m.logger.Infow(
+		fmt.Sprintf(
+			"[mongoOrderProjection.updateOrder] order with orderId '%s' updated by %s",
+			orderId,
+			eventType,
+		),
+		logger.Fields{"Id": orderRead.Id, "OrderId": orderId},
+	)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package projections
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/domain"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mapper"
+	cancelOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/events/domain_events"
+	completeOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/completing_order/v1/events/domain_events"
+	payOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/paying_order/v1/events/domain_events"
+	shipOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/shipping_order/v1/events/domain_events"
+	submitOrderDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/submitting_order/v1/events/domain_events"
+	updateShoppingCartDomainEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/updating_shopping_card/v1/events"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// orderReadModelUpdate is the change of an order event on an existing order read model
+type orderReadModelUpdate func(order *read_models.OrderReadModel) error
+
+// getOrderReadModelUpdate returns the order id and the read model change for the order events after creation,
+// it is shared between the order projections to keep the read models in the same state
+func getOrderReadModelUpdate(event domain.IDomainEvent) (uuid.UUID, orderReadModelUpdate, bool) {
+	switch evt := event.(type) {
+	case *updateShoppingCartDomainEventsV1.ShoppingCartUpdatedV1:
+		return evt.GetAggregateId(), func(order *read_models.OrderReadModel) error {
+			items, err := mapper.Map[[]*read_models.ShopItemReadModel](evt.ShopItems)
+			if err != nil {
+				return errors.WrapIf(err, "error in mapping shopItems")
+			}
+
+			order.ShopItems = items
+			order.TotalPrice = read_models.GetShopItemsTotalPrice(items)
+			order.UpdatedAt = evt.UpdatedAt
+
+			return nil
+		}, true
+
+	case *submitOrderDomainEventsV1.OrderSubmittedV1:
+		return evt.OrderId, func(order *read_models.OrderReadModel) error {
+			order.Submitted = true
+			order.Status = read_models.OrderStatusSubmitted
+			order.UpdatedAt = evt.SubmittedAt
+
+			return nil
+		}, true
+
+	case *payOrderDomainEventsV1.OrderPaidV1:
+		return evt.OrderId, func(order *read_models.OrderReadModel) error {
+			order.Paid = true
+			order.PaymentId = evt.PaymentId.String()
+			order.Status = read_models.OrderStatusPaid
+			order.UpdatedAt = evt.PaidAt
+
+			return nil
+		}, true
+
+	case *shipOrderDomainEventsV1.OrderShippedV1:
+		return evt.OrderId, func(order *read_models.OrderReadModel) error {
+			order.Shipped = true
+			order.Status = read_models.OrderStatusShipped
+			order.UpdatedAt = evt.ShippedAt
+
+			return nil
+		}, true
+
+	case *completeOrderDomainEventsV1.OrderCompletedV1:
+		return evt.OrderId, func(order *read_models.OrderReadModel) error {
+			order.Completed = true
+			order.Status = read_models.OrderStatusCompleted
+			order.UpdatedAt = evt.CompletedAt
+
+			return nil
+		}, true
+
+	case *cancelOrderDomainEventsV1.OrderCanceledV1:
+		return evt.OrderId, func(order *read_models.OrderReadModel) error {
+			order.Canceled = true
+			order.CancelReason = evt.CancelReason
+			order.Status = read_models.OrderStatusCanceled
+			order.UpdatedAt = evt.CanceledAt
+
+			return nil
+		}, true
+	}
+
+	return uuid.Nil, nil, false
+}
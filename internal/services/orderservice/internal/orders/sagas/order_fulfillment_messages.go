@@ -0,0 +1,78 @@
+package sagas
+
+import (
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+)
+
+// the commands of the order fulfillment saga, they are consumed by the catalog, payment and shipping services
+
+type ReserveProductsStockV1 struct {
+	*types.Message
+	OrderId string         `json:"orderId"`
+	Items   []*StockItemV1 `json:"items"`
+}
+
+type StockItemV1 struct {
+	Title    string `json:"title"`
+	Quantity uint64 `json:"quantity"`
+}
+
+type ReleaseProductsStockV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+}
+
+type ProcessOrderPaymentV1 struct {
+	*types.Message
+	OrderId string  `json:"orderId"`
+	Amount  float64 `json:"amount"`
+}
+
+type RefundOrderPaymentV1 struct {
+	*types.Message
+	OrderId   string  `json:"orderId"`
+	PaymentId string  `json:"paymentId"`
+	Amount    float64 `json:"amount"`
+}
+
+type ConfirmOrderShippingV1 struct {
+	*types.Message
+	OrderId         string `json:"orderId"`
+	DeliveryAddress string `json:"deliveryAddress"`
+}
+
+// the replies of the fulfillment steps, they are correlated to the saga by the order id
+
+type ProductsStockReservedV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+}
+
+type ProductsStockReservationFailedV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+	Reason  string `json:"reason"`
+}
+
+type OrderPaymentCompletedV1 struct {
+	*types.Message
+	OrderId   string `json:"orderId"`
+	PaymentId string `json:"paymentId"`
+}
+
+type OrderPaymentFailedV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+	Reason  string `json:"reason"`
+}
+
+type OrderShippingConfirmedV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+}
+
+type OrderShippingFailedV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+	Reason  string `json:"reason"`
+}
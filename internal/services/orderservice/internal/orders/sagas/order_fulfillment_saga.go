@@ -0,0 +1,255 @@
+package sagas
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/persistmessage"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/producer"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/saga"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	cancelOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/commands"
+	createOrderIntegrationEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/events/integration_events"
+
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+const OrderFulfillmentSagaName = "order-fulfillment"
+
+// the states of the order fulfillment saga
+const (
+	AwaitingStock    = "AwaitingStock"
+	AwaitingPayment  = "AwaitingPayment"
+	AwaitingShipping = "AwaitingShipping"
+	Fulfilled        = "Fulfilled"
+	Failed           = "Failed"
+)
+
+// the deadlines of the fulfillment steps, the order is canceled when a step doesn't reply before its deadline
+const (
+	stockReservationTimeout = 5 * time.Minute
+	paymentTimeout          = 30 * time.Minute
+	shippingTimeout         = 24 * time.Hour
+)
+
+const (
+	releaseStockCompensation  = "release-stock"
+	refundPaymentCompensation = "refund-payment"
+)
+
+type OrderFulfillmentData struct {
+	OrderId         string  `json:"orderId"`
+	TotalPrice      float64 `json:"totalPrice"`
+	DeliveryAddress string  `json:"deliveryAddress"`
+	PaymentId       string  `json:"paymentId"`
+	FailureReason   string  `json:"failureReason"`
+}
+
+type fulfillmentContext = saga.Context[OrderFulfillmentData]
+
+// NewOrderFulfillmentSaga creates the saga which reserves the stock of a created order, takes its payment and confirms its
+// shipping. When a step fails or times out, the completed steps are compensated and the order is canceled. The commands of
+// the saga are written to the outbox of the message persistence service, so it should be the mongo message service of the
+// saga store. The saga is not registered yet, because no service consumes its commands; registering it with `saga.AsSaga`
+// also needs the redis, queue worker, saga timeouts and saga modules and a saga store.
+func NewOrderFulfillmentSaga(
+	store saga.SagaStore,
+	producer producer.Producer,
+	scheduler saga.TimeoutScheduler,
+	messagePersistenceService persistmessage.MessagePersistenceService,
+	logger logger.Logger,
+) saga.Saga {
+	return saga.NewSaga(
+		NewOrderFulfillmentDefinition(messagePersistenceService),
+		store,
+		persistmessage.NewOutboxProducer(producer, messagePersistenceService),
+		scheduler,
+		logger,
+	)
+}
+
+func NewOrderFulfillmentDefinition(
+	messagePersistenceService persistmessage.MessagePersistenceService,
+) *saga.Definition[OrderFulfillmentData] {
+	fail := func(ctx context.Context, sagaContext *fulfillmentContext, reason string) error {
+		return failOrder(ctx, sagaContext, messagePersistenceService, reason)
+	}
+
+	return saga.NewDefinition[OrderFulfillmentData](OrderFulfillmentSagaName).
+		Initially(
+			&createOrderIntegrationEventsV1.OrderCreatedV1{},
+			saga.CorrelateBy(func(m *createOrderIntegrationEventsV1.OrderCreatedV1) string {
+				if m.OrderReadDto == nil {
+					return ""
+				}
+
+				return m.OrderId
+			}),
+			saga.Handle(reserveStock),
+		).
+		During(
+			AwaitingStock,
+			&ProductsStockReservedV1{},
+			saga.CorrelateBy(func(m *ProductsStockReservedV1) string { return m.OrderId }),
+			saga.Handle(processPayment),
+		).
+		During(
+			AwaitingStock,
+			&ProductsStockReservationFailedV1{},
+			saga.CorrelateBy(func(m *ProductsStockReservationFailedV1) string { return m.OrderId }),
+			saga.Handle(func(ctx context.Context, sagaContext *fulfillmentContext, m *ProductsStockReservationFailedV1) error {
+				return fail(ctx, sagaContext, m.Reason)
+			}),
+		).
+		During(
+			AwaitingPayment,
+			&OrderPaymentCompletedV1{},
+			saga.CorrelateBy(func(m *OrderPaymentCompletedV1) string { return m.OrderId }),
+			saga.Handle(confirmShipping),
+		).
+		During(
+			AwaitingPayment,
+			&OrderPaymentFailedV1{},
+			saga.CorrelateBy(func(m *OrderPaymentFailedV1) string { return m.OrderId }),
+			saga.Handle(func(ctx context.Context, sagaContext *fulfillmentContext, m *OrderPaymentFailedV1) error {
+				return fail(ctx, sagaContext, m.Reason)
+			}),
+		).
+		During(
+			AwaitingShipping,
+			&OrderShippingConfirmedV1{},
+			saga.CorrelateBy(func(m *OrderShippingConfirmedV1) string { return m.OrderId }),
+			saga.Handle(func(ctx context.Context, sagaContext *fulfillmentContext, m *OrderShippingConfirmedV1) error {
+				sagaContext.TransitionTo(Fulfilled)
+				sagaContext.Complete()
+
+				return nil
+			}),
+		).
+		During(
+			AwaitingShipping,
+			&OrderShippingFailedV1{},
+			saga.CorrelateBy(func(m *OrderShippingFailedV1) string { return m.OrderId }),
+			saga.Handle(func(ctx context.Context, sagaContext *fulfillmentContext, m *OrderShippingFailedV1) error {
+				return fail(ctx, sagaContext, m.Reason)
+			}),
+		).
+		OnTimeout(AwaitingStock, AwaitingStock, func(ctx context.Context, sagaContext *fulfillmentContext) error {
+			return fail(ctx, sagaContext, "stock reservation timed out")
+		}).
+		OnTimeout(AwaitingPayment, AwaitingPayment, func(ctx context.Context, sagaContext *fulfillmentContext) error {
+			return fail(ctx, sagaContext, "payment timed out")
+		}).
+		OnTimeout(AwaitingShipping, AwaitingShipping, func(ctx context.Context, sagaContext *fulfillmentContext) error {
+			return fail(ctx, sagaContext, "shipping confirmation timed out")
+		}).
+		Compensation(releaseStockCompensation, func(ctx context.Context, sagaContext *fulfillmentContext) error {
+			sagaContext.Send(&ReleaseProductsStockV1{
+				Message: newMessage(),
+				OrderId: sagaContext.Data().OrderId,
+			})
+
+			return nil
+		}).
+		Compensation(refundPaymentCompensation, func(ctx context.Context, sagaContext *fulfillmentContext) error {
+			sagaContext.Send(&RefundOrderPaymentV1{
+				Message:   newMessage(),
+				OrderId:   sagaContext.Data().OrderId,
+				PaymentId: sagaContext.Data().PaymentId,
+				Amount:    sagaContext.Data().TotalPrice,
+			})
+
+			return nil
+		})
+}
+
+func reserveStock(
+	ctx context.Context,
+	sagaContext *fulfillmentContext,
+	m *createOrderIntegrationEventsV1.OrderCreatedV1,
+) error {
+	data := sagaContext.Data()
+	data.OrderId = m.OrderId
+	data.TotalPrice = m.TotalPrice
+	data.DeliveryAddress = m.DeliveryAddress
+
+	items := make([]*StockItemV1, 0, len(m.ShopItems))
+	for _, shopItem := range m.ShopItems {
+		items = append(items, &StockItemV1{Title: shopItem.Title, Quantity: shopItem.Quantity})
+	}
+
+	sagaContext.Send(&ReserveProductsStockV1{Message: newMessage(), OrderId: data.OrderId, Items: items})
+	sagaContext.TransitionTo(AwaitingStock)
+	sagaContext.ScheduleTimeout(AwaitingStock, stockReservationTimeout)
+
+	return nil
+}
+
+func processPayment(ctx context.Context, sagaContext *fulfillmentContext, m *ProductsStockReservedV1) error {
+	sagaContext.AddCompensation(releaseStockCompensation)
+
+	sagaContext.Send(&ProcessOrderPaymentV1{
+		Message: newMessage(),
+		OrderId: sagaContext.Data().OrderId,
+		Amount:  sagaContext.Data().TotalPrice,
+	})
+	sagaContext.TransitionTo(AwaitingPayment)
+	sagaContext.ScheduleTimeout(AwaitingPayment, paymentTimeout)
+
+	return nil
+}
+
+func confirmShipping(ctx context.Context, sagaContext *fulfillmentContext, m *OrderPaymentCompletedV1) error {
+	sagaContext.Data().PaymentId = m.PaymentId
+	sagaContext.AddCompensation(refundPaymentCompensation)
+
+	sagaContext.Send(&ConfirmOrderShippingV1{
+		Message:         newMessage(),
+		OrderId:         sagaContext.Data().OrderId,
+		DeliveryAddress: sagaContext.Data().DeliveryAddress,
+	})
+	sagaContext.TransitionTo(AwaitingShipping)
+	sagaContext.ScheduleTimeout(AwaitingShipping, shippingTimeout)
+
+	return nil
+}
+
+// failOrder compensates the completed steps in reverse order and stores the cancel command of the order with the saga
+// state, the message dispatcher sends the command after the commit and the cancel of a canceled order is a success
+func failOrder(
+	ctx context.Context,
+	sagaContext *fulfillmentContext,
+	messagePersistenceService persistmessage.MessagePersistenceService,
+	reason string,
+) error {
+	if err := sagaContext.Compensate(ctx); err != nil {
+		return err
+	}
+
+	orderId, err := uuid.FromString(sagaContext.Data().OrderId)
+	if err != nil {
+		return errors.WrapIf(err, "invalid order id of the order fulfillment saga")
+	}
+
+	command, err := cancelOrderCommandV1.NewCancelOrder(orderId, reason)
+	if err != nil {
+		return err
+	}
+
+	err = messagePersistenceService.AddInternalMessage(command, ctx)
+	if err != nil {
+		return errors.WithMessage(err, "error in storing the cancel command of the failed order fulfillment saga")
+	}
+
+	sagaContext.Data().FailureReason = reason
+	sagaContext.TransitionTo(Failed)
+	sagaContext.Complete()
+
+	return nil
+}
+
+func newMessage() *types.Message {
+	return types.NewMessage(uuid.NewV4().String())
+}
@@ -0,0 +1,253 @@
+package sagas
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/cqrs"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/mocks"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/saga"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/messaging/types"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/core/metadata"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger/empty"
+	inMemoryBus "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq/test/in-memory"
+	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
+	cancelOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/commands"
+	createOrderIntegrationEventsV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/events/integration_events"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMessageStore dispatches the outbox messages on the bus immediately and records the internal commands
+type fakeMessageStore struct {
+	mu       sync.Mutex
+	commands []*cancelOrderCommandV1.CancelOrder
+}
+
+func (f *fakeMessageStore) addInternalMessage(internalCommand cqrs.InternalCommand, ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.commands = append(f.commands, internalCommand.(*cancelOrderCommandV1.CancelOrder))
+
+	return nil
+}
+
+func (f *fakeMessageStore) canceled() []*cancelOrderCommandV1.CancelOrder {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]*cancelOrderCommandV1.CancelOrder(nil), f.commands...)
+}
+
+type recordingScheduler struct {
+	mu       sync.Mutex
+	timeouts []*saga.Timeout
+}
+
+func (r *recordingScheduler) Schedule(ctx context.Context, timeout *saga.Timeout) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.timeouts = append(r.timeouts, timeout)
+
+	return nil
+}
+
+func (r *recordingScheduler) last() *saga.Timeout {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.timeouts[len(r.timeouts)-1]
+}
+
+type fulfillmentFixture struct {
+	bus       *inMemoryBus.RabbitmqInMemoryHarnesses
+	store     saga.SagaStore
+	scheduler *recordingScheduler
+	canceler  *fakeMessageStore
+	saga      saga.Saga
+	orderId   string
+}
+
+func newFulfillmentFixture(t *testing.T) *fulfillmentFixture {
+	t.Helper()
+
+	bus := inMemoryBus.NewRabbitmqInMemoryHarnesses(empty.EmptyLogger, nil)
+	store := saga.NewInMemorySagaStore()
+	scheduler := &recordingScheduler{}
+
+	canceler := &fakeMessageStore{}
+	messagePersistenceService := mocks.NewMessagePersistenceService(t)
+	messagePersistenceService.EXPECT().
+		AddPublishMessage(mock.Anything, mock.Anything).
+		RunAndReturn(func(messageEnvelope types.MessageEnvelope, ctx context.Context) error {
+			return bus.PublishMessage(ctx, messageEnvelope.Message, metadata.MapToMetadata(messageEnvelope.Headers))
+		}).
+		Maybe()
+	messagePersistenceService.EXPECT().
+		AddInternalMessage(mock.Anything, mock.Anything).
+		RunAndReturn(canceler.addInternalMessage).
+		Maybe()
+
+	orderSaga := NewOrderFulfillmentSaga(store, bus, scheduler, messagePersistenceService, empty.EmptyLogger)
+
+	require.NoError(t, saga.ConnectSagas(bus, saga.SagasParams{Sagas: []saga.Saga{orderSaga}}))
+	require.NoError(t, bus.Start(context.Background()))
+	t.Cleanup(func() { _ = bus.Stop() })
+
+	return &fulfillmentFixture{
+		bus:       bus,
+		store:     store,
+		scheduler: scheduler,
+		canceler:  canceler,
+		saga:      orderSaga,
+		orderId:   uuid.NewV4().String(),
+	}
+}
+
+func (f *fulfillmentFixture) createOrder(t *testing.T) {
+	t.Helper()
+
+	orderCreated := createOrderIntegrationEventsV1.NewOrderCreatedV1(&dtosV1.OrderReadDto{
+		OrderId:         f.orderId,
+		DeliveryAddress: "Tehran",
+		TotalPrice:      100,
+		ShopItems:       []*dtosV1.ShopItemReadDto{{Title: "pizza", Quantity: 2, Price: 50}},
+	})
+	require.NoError(t, f.bus.PublishMessage(context.Background(), orderCreated, nil))
+
+	reserve := inMemoryBus.ShouldPublish(t, f.bus, func(m *ReserveProductsStockV1) bool {
+		return m.OrderId == f.orderId
+	})
+	assert.Equal(t, []*StockItemV1{{Title: "pizza", Quantity: 2}}, reserve.Items)
+}
+
+func (f *fulfillmentFixture) instance(t *testing.T) *saga.SagaInstance {
+	t.Helper()
+
+	instance, err := f.store.Load(context.Background(), OrderFulfillmentSagaName, f.orderId)
+	require.NoError(t, err)
+	require.NotNil(t, instance)
+
+	return instance
+}
+
+func Test_Order_Fulfillment_Saga_Should_Fulfill_Order(t *testing.T) {
+	ctx := context.Background()
+	f := newFulfillmentFixture(t)
+
+	f.createOrder(t)
+
+	require.NoError(t, f.bus.PublishMessage(ctx, &ProductsStockReservedV1{Message: newMessage(), OrderId: f.orderId}, nil))
+	payment := inMemoryBus.ShouldPublish(t, f.bus, func(m *ProcessOrderPaymentV1) bool {
+		return m.OrderId == f.orderId
+	})
+	assert.Equal(t, float64(100), payment.Amount)
+
+	require.NoError(t, f.bus.PublishMessage(
+		ctx,
+		&OrderPaymentCompletedV1{Message: newMessage(), OrderId: f.orderId, PaymentId: "payment-1"},
+		nil,
+	))
+	shipping := inMemoryBus.ShouldPublish(t, f.bus, func(m *ConfirmOrderShippingV1) bool {
+		return m.OrderId == f.orderId
+	})
+	assert.Equal(t, "Tehran", shipping.DeliveryAddress)
+
+	shippingConfirmed := &OrderShippingConfirmedV1{Message: newMessage(), OrderId: f.orderId}
+	require.NoError(t, f.bus.PublishMessage(ctx, shippingConfirmed, nil))
+	inMemoryBus.ShouldConsume(t, f.bus, func(m *OrderShippingConfirmedV1) bool {
+		return m.GeMessageId() == shippingConfirmed.GeMessageId()
+	})
+
+	instance := f.instance(t)
+	assert.Equal(t, Fulfilled, instance.State)
+	assert.True(t, instance.Completed)
+	assert.Empty(t, f.canceler.canceled())
+}
+
+func Test_Order_Fulfillment_Saga_Should_Release_Stock_And_Cancel_Order_When_Payment_Fails(t *testing.T) {
+	ctx := context.Background()
+	f := newFulfillmentFixture(t)
+
+	f.createOrder(t)
+
+	require.NoError(t, f.bus.PublishMessage(ctx, &ProductsStockReservedV1{Message: newMessage(), OrderId: f.orderId}, nil))
+	inMemoryBus.ShouldPublish(t, f.bus, func(m *ProcessOrderPaymentV1) bool {
+		return m.OrderId == f.orderId
+	})
+
+	require.NoError(t, f.bus.PublishMessage(
+		ctx,
+		&OrderPaymentFailedV1{Message: newMessage(), OrderId: f.orderId, Reason: "insufficient funds"},
+		nil,
+	))
+	inMemoryBus.ShouldPublish(t, f.bus, func(m *ReleaseProductsStockV1) bool {
+		return m.OrderId == f.orderId
+	})
+
+	instance := f.instance(t)
+	assert.Equal(t, Failed, instance.State)
+	assert.True(t, instance.Completed)
+
+	canceled := f.canceler.canceled()
+	require.Len(t, canceled, 1)
+	assert.Equal(t, f.orderId, canceled[0].OrderId.String())
+	assert.Equal(t, "insufficient funds", canceled[0].CancelReason)
+}
+
+func Test_Order_Fulfillment_Saga_Should_Compensate_In_Reverse_Order_When_Shipping_Times_Out(t *testing.T) {
+	ctx := context.Background()
+	f := newFulfillmentFixture(t)
+
+	f.createOrder(t)
+
+	require.NoError(t, f.bus.PublishMessage(ctx, &ProductsStockReservedV1{Message: newMessage(), OrderId: f.orderId}, nil))
+	inMemoryBus.ShouldPublish(t, f.bus, func(m *ProcessOrderPaymentV1) bool {
+		return m.OrderId == f.orderId
+	})
+
+	require.NoError(t, f.bus.PublishMessage(
+		ctx,
+		&OrderPaymentCompletedV1{Message: newMessage(), OrderId: f.orderId, PaymentId: "payment-1"},
+		nil,
+	))
+	inMemoryBus.ShouldPublish(t, f.bus, func(m *ConfirmOrderShippingV1) bool {
+		return m.OrderId == f.orderId
+	})
+
+	timeout := f.scheduler.last()
+	assert.Equal(t, AwaitingShipping, timeout.State)
+	require.NoError(t, f.saga.HandleTimeout(ctx, timeout))
+
+	refund := inMemoryBus.ShouldPublish(t, f.bus, func(m *RefundOrderPaymentV1) bool {
+		return m.OrderId == f.orderId
+	})
+	assert.Equal(t, "payment-1", refund.PaymentId)
+	release := inMemoryBus.ShouldPublish(t, f.bus, func(m *ReleaseProductsStockV1) bool {
+		return m.OrderId == f.orderId
+	})
+
+	// the payment is refunded before releasing the stock
+	published := f.bus.PublishedMessages()
+	assert.Less(t, indexOf(published, refund.GeMessageId()), indexOf(published, release.GeMessageId()))
+
+	assert.Equal(t, Failed, f.instance(t).State)
+	require.Len(t, f.canceler.canceled(), 1)
+	assert.Equal(t, "shipping confirmation timed out", f.canceler.canceled()[0].CancelReason)
+}
+
+func indexOf(messages []types.IMessage, messageId string) int {
+	for i, message := range messages {
+		if message.GeMessageId() == messageId {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -1,6 +1,11 @@
 package app
 
-import "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/configurations/orders"
+import (
+	"context"
+
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/configurations/orders"
+)
 
 type App struct{}
 
@@ -23,3 +28,36 @@ func (a *App) Run() {
 	app.Logger().Info("Starting orders_service application")
 	app.Run()
 }
+
+// RebuildProjections starts the application, rebuilds the projections while its live subscription is paused and stops it afterward.
+// The running instances of the service should be stopped before, otherwise the rebuild endpoint of the running service should be used.
+func (a *App) RebuildProjections(
+	ctx context.Context,
+	options *eventstroredb.RebuildProjectionsOptions,
+) (*eventstroredb.RebuildProjectionsResult, error) {
+	appBuilder := NewOrdersApplicationBuilder()
+	appBuilder.ProvideModule(orders.OrderServiceModule)
+
+	app := appBuilder.Build()
+
+	app.ConfigureOrders()
+
+	var subscriptionAllWorker eventstroredb.EsdbSubscriptionAllWorker
+	app.ResolveFunc(func(worker eventstroredb.EsdbSubscriptionAllWorker) {
+		subscriptionAllWorker = worker
+	})
+
+	app.Logger().Info("Starting orders_service application for rebuilding the projections")
+	err := app.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := app.Stop(context.Background()); err != nil {
+			app.Logger().Errorf("error in stopping orders_service application: %v", err)
+		}
+	}()
+
+	return subscriptionAllWorker.RebuildProjections(ctx, options)
+}
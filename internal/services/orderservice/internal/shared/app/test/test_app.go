@@ -30,7 +30,10 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-type TestApp struct{}
+type TestApp struct {
+	// useInMemoryEventStore runs the app with the in-memory event store instead of an EventStoreDB container
+	useInMemoryEventStore bool
+}
 
 type TestAppResult struct {
 	Cfg                  *config.Config
@@ -53,6 +56,13 @@ func NewTestApp() *TestApp {
 	return &TestApp{}
 }
 
+// WithInMemoryEventStore swaps the EventStoreDB with the in-memory event store for the faster tests
+func (a *TestApp) WithInMemoryEventStore() *TestApp {
+	a.useInMemoryEventStore = true
+
+	return a
+}
+
 func (a *TestApp) Run(t *testing.T) (result *TestAppResult) {
 	lifetimeCtx := context.Background()
 
@@ -61,7 +71,15 @@ func (a *TestApp) Run(t *testing.T) (result *TestAppResult) {
 	appBuilder.ProvideModule(orders.OrderServiceModule)
 
 	appBuilder.Decorate(rabbitmq.RabbitmqContainerOptionsDecorator(t, lifetimeCtx))
-	appBuilder.Decorate(eventstoredb.EventstoreDBContainerOptionsDecorator(t, lifetimeCtx))
+	if a.useInMemoryEventStore {
+		appBuilder.Decorate(func(options *config4.EventStoreDbOptions) *config4.EventStoreDbOptions {
+			options.UseInMemory = true
+
+			return options
+		})
+	} else {
+		appBuilder.Decorate(eventstoredb.EventstoreDBContainerOptionsDecorator(t, lifetimeCtx))
+	}
 	appBuilder.Decorate(mongo2.MongoContainerOptionsDecorator(t, lifetimeCtx))
 	appBuilder.Decorate(redis.RedisContainerOptionsDecorator(t, lifetimeCtx))
 
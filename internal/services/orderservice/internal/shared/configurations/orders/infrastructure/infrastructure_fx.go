@@ -8,6 +8,7 @@ import (
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/health"
 	customEcho "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/http/customecho"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongomessaging"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/metrics"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/rabbitmq"
@@ -28,6 +29,8 @@ var Module = fx.Module(
 	customEcho.Module,
 	grpc.Module,
 	mongodb.Module,
+	// the outbox messages and the internal commands are stored in mongo with the order read models
+	mongomessaging.Module,
 	elasticsearch.Module,
 	eventstroredb.ModuleFunc(
 		func(params params.OrderProjectionParams) eventstroredb.ProjectionBuilderFuc {
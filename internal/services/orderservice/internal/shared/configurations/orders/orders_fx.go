@@ -3,11 +3,19 @@ package orders
 import (
 	"fmt"
 
+	esContracts "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/es/contracts/projection"
+	eventStoreDbConfig "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/eventstroredb/config"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/logger"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb/parkedevents"
+	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/mongodb/subscriptioncheckpoint"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/config"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/configurations/orders/infrastructure"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/contracts"
 
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.opentelemetry.io/otel/metric"
 	api "go.opentelemetry.io/otel/metric"
 	"go.uber.org/fx"
@@ -26,8 +34,41 @@ var OrderServiceModule = fx.Module(
 
 	// Other provides
 	fx.Provide(configOrdersMetrics),
+
+	// storing the subscription checkpoint in the same mongo session with the read models projections
+	fx.Decorate(decorateSubscriptionCheckpointRepository),
+	// parking the poison events of the projections in mongo for inspecting them
+	fx.Decorate(decorateParkedEventStore),
 )
 
+func decorateSubscriptionCheckpointRepository(
+	repository esContracts.SubscriptionCheckpointRepository,
+	client *mongo.Client,
+	mongoOptions *mongodb.MongoDbOptions,
+	eventStoreDbOptions *eventStoreDbConfig.EventStoreDbOptions,
+	l logger.Logger,
+) esContracts.SubscriptionCheckpointRepository {
+	// positions of the in-memory event store start from the beginning on every run, so its checkpoints should not be persisted
+	if eventStoreDbOptions.UseInMemory {
+		return repository
+	}
+
+	return subscriptioncheckpoint.NewMongoSubscriptionCheckpointRepository(client, mongoOptions, l)
+}
+
+func decorateParkedEventStore(
+	store projection.IParkedEventStore,
+	client *mongo.Client,
+	mongoOptions *mongodb.MongoDbOptions,
+	eventStoreDbOptions *eventStoreDbConfig.EventStoreDbOptions,
+) projection.IParkedEventStore {
+	if eventStoreDbOptions.UseInMemory {
+		return store
+	}
+
+	return parkedevents.NewMongoParkedEventStore(client, mongoOptions)
+}
+
 // ref: https://github.com/open-telemetry/opentelemetry-go/blob/main/example/prometheus/main.go
 
 func configOrdersMetrics(
@@ -87,6 +128,30 @@ func configOrdersMetrics(
 		return nil, err
 	}
 
+	shipOrderGrpcRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_ship_order_grpc_requests_total", appOptions.ServiceName),
+		api.WithDescription("The total number of ship order grpc requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	completeOrderGrpcRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_complete_order_grpc_requests_total", appOptions.ServiceName),
+		api.WithDescription("The total number of complete order grpc requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelOrderGrpcRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_cancel_order_grpc_requests_total", appOptions.ServiceName),
+		api.WithDescription("The total number of cancel order grpc requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	getOrderByIdGrpcRequests, err := meter.Float64Counter(
 		fmt.Sprintf("%s_get_order_by_id_grpc_requests_total", appOptions.ServiceName),
 		api.WithDescription("The total number of get order by id grpc requests"),
@@ -151,6 +216,30 @@ func configOrdersMetrics(
 		return nil, err
 	}
 
+	shipOrderHttpRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_ship_order_http_requests_total", appOptions.ServiceName),
+		api.WithDescription("The total number of ship order http requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	completeOrderHttpRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_complete_order_http_requests_total", appOptions.ServiceName),
+		api.WithDescription("The total number of complete order http requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelOrderHttpRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_cancel_order_http_requests_total", appOptions.ServiceName),
+		api.WithDescription("The total number of cancel order http requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	getOrderByIdHttpRequests, err := meter.Float64Counter(
 		fmt.Sprintf("%s_get_order_by_id_http_requests_total", appOptions.ServiceName),
 		api.WithDescription("The total number of get order by id http requests"),
@@ -167,6 +256,14 @@ func configOrdersMetrics(
 		return nil, err
 	}
 
+	rebuildProjectionsHttpRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_rebuild_projections_http_requests_total", appOptions.ServiceName),
+		api.WithDescription("The total number of rebuild projections http requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	deleteOrderRabbitMQMessages, err := meter.Float64Counter(
 		fmt.Sprintf("%s_delete_order_rabbitmq_messages_total", appOptions.ServiceName),
 		api.WithDescription("The total number of delete order rabbirmq messages"),
@@ -192,24 +289,31 @@ func configOrdersMetrics(
 	}
 
 	return &contracts.OrdersMetrics{
-		CreateOrderHttpRequests:     createOrderHttpRequests,
-		SuccessGrpcRequests:         successGrpcRequests,
-		ErrorGrpcRequests:           errorGrpcRequests,
-		CreateOrderGrpcRequests:     createOrderGrpcRequests,
-		UpdateOrderGrpcRequests:     updateOrderGrpcRequests,
-		PayOrderGrpcRequests:        payOrderGrpcRequests,
-		SubmitOrderGrpcRequests:     submitOrderGrpcRequests,
-		GetOrderByIdGrpcRequests:    getOrderByIdGrpcRequests,
-		GetOrdersGrpcRequests:       getOrdersGrpcRequests,
-		SearchOrderGrpcRequests:     searchOrderGrpcRequests,
-		GetOrdersHttpRequests:       getOrdersHttpRequests,
-		UpdateOrderHttpRequests:     updateOrderHttpRequests,
-		PayOrderHttpRequests:        payOrderHttpRequests,
-		SubmitOrderHttpRequests:     submitOrderHttpRequests,
-		GetOrderByIdHttpRequests:    getOrderByIdHttpRequests,
-		SearchOrderHttpRequests:     searchOrderHttpRequests,
-		DeleteOrderRabbitMQMessages: deleteOrderRabbitMQMessages,
-		CreateOrderRabbitMQMessages: createOrderRabbitMQMessages,
-		UpdateOrderRabbitMQMessages: updateOrderRabbitMQMessages,
+		CreateOrderHttpRequests:        createOrderHttpRequests,
+		SuccessGrpcRequests:            successGrpcRequests,
+		ErrorGrpcRequests:              errorGrpcRequests,
+		CreateOrderGrpcRequests:        createOrderGrpcRequests,
+		UpdateOrderGrpcRequests:        updateOrderGrpcRequests,
+		PayOrderGrpcRequests:           payOrderGrpcRequests,
+		SubmitOrderGrpcRequests:        submitOrderGrpcRequests,
+		ShipOrderGrpcRequests:          shipOrderGrpcRequests,
+		CompleteOrderGrpcRequests:      completeOrderGrpcRequests,
+		CancelOrderGrpcRequests:        cancelOrderGrpcRequests,
+		GetOrderByIdGrpcRequests:       getOrderByIdGrpcRequests,
+		GetOrdersGrpcRequests:          getOrdersGrpcRequests,
+		SearchOrderGrpcRequests:        searchOrderGrpcRequests,
+		GetOrdersHttpRequests:          getOrdersHttpRequests,
+		UpdateOrderHttpRequests:        updateOrderHttpRequests,
+		PayOrderHttpRequests:           payOrderHttpRequests,
+		SubmitOrderHttpRequests:        submitOrderHttpRequests,
+		ShipOrderHttpRequests:          shipOrderHttpRequests,
+		CompleteOrderHttpRequests:      completeOrderHttpRequests,
+		CancelOrderHttpRequests:        cancelOrderHttpRequests,
+		GetOrderByIdHttpRequests:       getOrderByIdHttpRequests,
+		SearchOrderHttpRequests:        searchOrderHttpRequests,
+		RebuildProjectionsHttpRequests: rebuildProjectionsHttpRequests,
+		DeleteOrderRabbitMQMessages:    deleteOrderRabbitMQMessages,
+		CreateOrderRabbitMQMessages:    createOrderRabbitMQMessages,
+		UpdateOrderRabbitMQMessages:    updateOrderRabbitMQMessages,
 	}, nil
 }
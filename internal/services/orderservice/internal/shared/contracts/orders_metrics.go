@@ -8,24 +8,32 @@ type OrdersMetrics struct {
 	SuccessGrpcRequests metric.Float64Counter
 	ErrorGrpcRequests   metric.Float64Counter
 
-	CreateOrderGrpcRequests  metric.Float64Counter
-	UpdateOrderGrpcRequests  metric.Float64Counter
-	PayOrderGrpcRequests     metric.Float64Counter
-	SubmitOrderGrpcRequests  metric.Float64Counter
-	GetOrderByIdGrpcRequests metric.Float64Counter
-	GetOrdersGrpcRequests    metric.Float64Counter
-	SearchOrderGrpcRequests  metric.Float64Counter
+	CreateOrderGrpcRequests   metric.Float64Counter
+	UpdateOrderGrpcRequests   metric.Float64Counter
+	PayOrderGrpcRequests      metric.Float64Counter
+	SubmitOrderGrpcRequests   metric.Float64Counter
+	ShipOrderGrpcRequests     metric.Float64Counter
+	CompleteOrderGrpcRequests metric.Float64Counter
+	CancelOrderGrpcRequests   metric.Float64Counter
+	GetOrderByIdGrpcRequests  metric.Float64Counter
+	GetOrdersGrpcRequests     metric.Float64Counter
+	SearchOrderGrpcRequests   metric.Float64Counter
 
 	SuccessHttpRequests metric.Float64Counter
 	ErrorHttpRequests   metric.Float64Counter
 
-	CreateOrderHttpRequests  metric.Float64Counter
-	UpdateOrderHttpRequests  metric.Float64Counter
-	PayOrderHttpRequests     metric.Float64Counter
-	SubmitOrderHttpRequests  metric.Float64Counter
-	GetOrderByIdHttpRequests metric.Float64Counter
-	SearchOrderHttpRequests  metric.Float64Counter
-	GetOrdersHttpRequests    metric.Float64Counter
+	CreateOrderHttpRequests   metric.Float64Counter
+	UpdateOrderHttpRequests   metric.Float64Counter
+	PayOrderHttpRequests      metric.Float64Counter
+	SubmitOrderHttpRequests   metric.Float64Counter
+	ShipOrderHttpRequests     metric.Float64Counter
+	CompleteOrderHttpRequests metric.Float64Counter
+	CancelOrderHttpRequests   metric.Float64Counter
+	GetOrderByIdHttpRequests  metric.Float64Counter
+	SearchOrderHttpRequests   metric.Float64Counter
+	GetOrdersHttpRequests     metric.Float64Counter
+
+	RebuildProjectionsHttpRequests metric.Float64Counter
 
 	SuccessRabbitMQMessages metric.Float64Counter
 	ErrorRabbitMQMessages   metric.Float64Counter
@@ -11,12 +11,17 @@ import (
 	utils2 "github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/otel/tracing/utils"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/pkg/utils"
 	dtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/dtos/v1"
+	cancelOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/canceling_order/v1/commands"
+	completeOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/completing_order/v1/commands"
 	createOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/commands"
 	createOrderDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/creating_order/v1/dtos"
 	getOrderByIdDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_order_by_id/v1/dtos"
 	getOrderByIdQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_order_by_id/v1/queries"
 	getOrdersDtosV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_orders/v1/dtos"
 	getOrdersQueryV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/getting_orders/v1/queries"
+	payOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/paying_order/v1/commands"
+	shipOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/shipping_order/v1/commands"
+	submitOrderCommandV1 "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/features/submitting_order/v1/commands"
 	"github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/contracts"
 	grpcOrderService "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/shared/grpc/genproto"
 
@@ -176,7 +181,305 @@ func (o OrderGrpcServiceServer) SubmitOrder(
 	ctx context.Context,
 	req *grpcOrderService.SubmitOrderReq,
 ) (*grpcOrderService.SubmitOrderRes, error) {
-	return nil, nil
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute2.Object("Request", req))
+	o.ordersMetrics.SubmitOrderGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+
+	orderIdUUID, err := uuid.FromString(req.GetOrderId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_SubmitOrder.uuid.FromString] error in converting uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_SubmitOrder.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	command, err := submitOrderCommandV1.NewSubmitOrder(orderIdUUID)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_SubmitOrder.StructCtx] command validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_SubmitOrder.StructCtx] err: %v", validationErr),
+		)
+		return nil, validationErr
+	}
+
+	_, err = mediatr.Send[*submitOrderCommandV1.SubmitOrder, *mediatr.Unit](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[OrderGrpcServiceServer_SubmitOrder.Send] error in sending SubmitOrder",
+		)
+		o.logger.Errorw(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_SubmitOrder.Send] id: {%s}, err: %v",
+				command.OrderId,
+				err,
+			),
+			logger.Fields{"Id": command.OrderId},
+		)
+		return nil, err
+	}
+
+	return &grpcOrderService.SubmitOrderRes{OrderId: command.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) PayOrder(
+	ctx context.Context,
+	req *grpcOrderService.PayOrderReq,
+) (*grpcOrderService.PayOrderRes, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute2.Object("Request", req))
+	o.ordersMetrics.PayOrderGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+
+	orderIdUUID, err := uuid.FromString(req.GetOrderId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_PayOrder.uuid.FromString] error in converting uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_PayOrder.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	paymentIdUUID, err := uuid.FromString(req.GetPaymentId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_PayOrder.uuid.FromString] error in converting payment uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_PayOrder.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	command, err := payOrderCommandV1.NewPayOrder(orderIdUUID, paymentIdUUID)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_PayOrder.StructCtx] command validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_PayOrder.StructCtx] err: %v", validationErr),
+		)
+		return nil, validationErr
+	}
+
+	_, err = mediatr.Send[*payOrderCommandV1.PayOrder, *mediatr.Unit](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[OrderGrpcServiceServer_PayOrder.Send] error in sending PayOrder",
+		)
+		o.logger.Errorw(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_PayOrder.Send] id: {%s}, err: %v",
+				command.OrderId,
+				err,
+			),
+			logger.Fields{"Id": command.OrderId},
+		)
+		return nil, err
+	}
+
+	return &grpcOrderService.PayOrderRes{OrderId: command.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) ShipOrder(
+	ctx context.Context,
+	req *grpcOrderService.ShipOrderReq,
+) (*grpcOrderService.ShipOrderRes, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute2.Object("Request", req))
+	o.ordersMetrics.ShipOrderGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+
+	orderIdUUID, err := uuid.FromString(req.GetOrderId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_ShipOrder.uuid.FromString] error in converting uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_ShipOrder.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	command, err := shipOrderCommandV1.NewShipOrder(orderIdUUID)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_ShipOrder.StructCtx] command validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_ShipOrder.StructCtx] err: %v", validationErr),
+		)
+		return nil, validationErr
+	}
+
+	_, err = mediatr.Send[*shipOrderCommandV1.ShipOrder, *mediatr.Unit](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[OrderGrpcServiceServer_ShipOrder.Send] error in sending ShipOrder",
+		)
+		o.logger.Errorw(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_ShipOrder.Send] id: {%s}, err: %v",
+				command.OrderId,
+				err,
+			),
+			logger.Fields{"Id": command.OrderId},
+		)
+		return nil, err
+	}
+
+	return &grpcOrderService.ShipOrderRes{OrderId: command.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) CompleteOrder(
+	ctx context.Context,
+	req *grpcOrderService.CompleteOrderReq,
+) (*grpcOrderService.CompleteOrderRes, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute2.Object("Request", req))
+	o.ordersMetrics.CompleteOrderGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+
+	orderIdUUID, err := uuid.FromString(req.GetOrderId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_CompleteOrder.uuid.FromString] error in converting uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_CompleteOrder.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	command, err := completeOrderCommandV1.NewCompleteOrder(orderIdUUID)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_CompleteOrder.StructCtx] command validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_CompleteOrder.StructCtx] err: %v", validationErr),
+		)
+		return nil, validationErr
+	}
+
+	_, err = mediatr.Send[*completeOrderCommandV1.CompleteOrder, *mediatr.Unit](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[OrderGrpcServiceServer_CompleteOrder.Send] error in sending CompleteOrder",
+		)
+		o.logger.Errorw(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_CompleteOrder.Send] id: {%s}, err: %v",
+				command.OrderId,
+				err,
+			),
+			logger.Fields{"Id": command.OrderId},
+		)
+		return nil, err
+	}
+
+	return &grpcOrderService.CompleteOrderRes{OrderId: command.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) CancelOrder(
+	ctx context.Context,
+	req *grpcOrderService.CancelOrderReq,
+) (*grpcOrderService.CancelOrderRes, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute2.Object("Request", req))
+	o.ordersMetrics.CancelOrderGrpcRequests.Add(ctx, 1, grpcMetricsAttr)
+
+	orderIdUUID, err := uuid.FromString(req.GetOrderId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_CancelOrder.uuid.FromString] error in converting uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_CancelOrder.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+		return nil, badRequestErr
+	}
+
+	command, err := cancelOrderCommandV1.NewCancelOrder(orderIdUUID, req.GetCancelReason())
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_CancelOrder.StructCtx] command validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_CancelOrder.StructCtx] err: %v", validationErr),
+		)
+		return nil, validationErr
+	}
+
+	_, err = mediatr.Send[*cancelOrderCommandV1.CancelOrder, *mediatr.Unit](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[OrderGrpcServiceServer_CancelOrder.Send] error in sending CancelOrder",
+		)
+		o.logger.Errorw(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_CancelOrder.Send] id: {%s}, err: %v",
+				command.OrderId,
+				err,
+			),
+			logger.Fields{"Id": command.OrderId},
+		)
+		return nil, err
+	}
+
+	return &grpcOrderService.CancelOrderRes{OrderId: command.OrderId.String()}, nil
 }
 
 func (o OrderGrpcServiceServer) UpdateShoppingCart(
@@ -194,9 +497,38 @@ func (o OrderGrpcServiceServer) GetOrders(
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute2.Object("Request", req))
 
-	query := getOrdersQueryV1.NewGetOrders(
-		&utils.ListQuery{Page: int(req.Page), Size: int(req.Size)},
+	filters, err := utils.ParseFilters(req.Filters)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_GetOrders.ParseFilters] error in parsing the filters",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_GetOrders.ParseFilters] err: %v", badRequestErr),
+		)
+		return nil, badRequestErr
+	}
+
+	query, err := getOrdersQueryV1.NewGetOrders(
+		&utils.ListQuery{
+			Page:    int(req.Page),
+			Size:    int(req.Size),
+			OrderBy: req.OrderBy,
+			Filters: filters,
+			After:   req.After,
+			Before:  req.Before,
+		},
 	)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_GetOrders.NewGetOrders] query validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_GetOrders.NewGetOrders] err: %v", validationErr),
+		)
+		return nil, validationErr
+	}
 
 	queryResult, err := mediatr.Send[*getOrdersQueryV1.GetOrders, *getOrdersDtosV1.GetOrdersResponseDto](
 		ctx,
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	repositories "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/contracts/repositories"
 	read_models "github.com/mehdihadeli/go-food-delivery-microservices/internal/services/orderservice/internal/orders/models/orders/read_models"
 	mock "github.com/stretchr/testify/mock"
 
@@ -30,6 +31,10 @@ func (_m *OrderElasticRepository) EXPECT() *OrderElasticRepository_Expecter {
 func (_m *OrderElasticRepository) CreateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, order)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *read_models.OrderReadModel) (*read_models.OrderReadModel, error)); ok {
@@ -81,10 +86,60 @@ func (_c *OrderElasticRepository_CreateOrder_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DeleteAllOrders provides a mock function with given fields: ctx
+func (_m *OrderElasticRepository) DeleteAllOrders(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllOrders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderElasticRepository_DeleteAllOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllOrders'
+type OrderElasticRepository_DeleteAllOrders_Call struct {
+	*mock.Call
+}
+
+// DeleteAllOrders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderElasticRepository_Expecter) DeleteAllOrders(ctx interface{}) *OrderElasticRepository_DeleteAllOrders_Call {
+	return &OrderElasticRepository_DeleteAllOrders_Call{Call: _e.mock.On("DeleteAllOrders", ctx)}
+}
+
+func (_c *OrderElasticRepository_DeleteAllOrders_Call) Run(run func(ctx context.Context)) *OrderElasticRepository_DeleteAllOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderElasticRepository_DeleteAllOrders_Call) Return(_a0 error) *OrderElasticRepository_DeleteAllOrders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderElasticRepository_DeleteAllOrders_Call) RunAndReturn(run func(context.Context) error) *OrderElasticRepository_DeleteAllOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteOrderByID provides a mock function with given fields: ctx, _a1
 func (_m *OrderElasticRepository) DeleteOrderByID(ctx context.Context, _a1 uuid.UUID) error {
 	ret := _m.Called(ctx, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrderByID")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
 		r0 = rf(ctx, _a1)
@@ -124,10 +179,74 @@ func (_c *OrderElasticRepository_DeleteOrderByID_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// FullTextSearchOrders provides a mock function with given fields: ctx, criteria, listQuery
+func (_m *OrderElasticRepository) FullTextSearchOrders(ctx context.Context, criteria *repositories.OrderSearchCriteria, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	ret := _m.Called(ctx, criteria, listQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FullTextSearchOrders")
+	}
+
+	var r0 *utils.ListResult[*read_models.OrderReadModel]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *repositories.OrderSearchCriteria, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)); ok {
+		return rf(ctx, criteria, listQuery)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *repositories.OrderSearchCriteria, *utils.ListQuery) *utils.ListResult[*read_models.OrderReadModel]); ok {
+		r0 = rf(ctx, criteria, listQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*utils.ListResult[*read_models.OrderReadModel])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *repositories.OrderSearchCriteria, *utils.ListQuery) error); ok {
+		r1 = rf(ctx, criteria, listQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderElasticRepository_FullTextSearchOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FullTextSearchOrders'
+type OrderElasticRepository_FullTextSearchOrders_Call struct {
+	*mock.Call
+}
+
+// FullTextSearchOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - criteria *repositories.OrderSearchCriteria
+//   - listQuery *utils.ListQuery
+func (_e *OrderElasticRepository_Expecter) FullTextSearchOrders(ctx interface{}, criteria interface{}, listQuery interface{}) *OrderElasticRepository_FullTextSearchOrders_Call {
+	return &OrderElasticRepository_FullTextSearchOrders_Call{Call: _e.mock.On("FullTextSearchOrders", ctx, criteria, listQuery)}
+}
+
+func (_c *OrderElasticRepository_FullTextSearchOrders_Call) Run(run func(ctx context.Context, criteria *repositories.OrderSearchCriteria, listQuery *utils.ListQuery)) *OrderElasticRepository_FullTextSearchOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*repositories.OrderSearchCriteria), args[2].(*utils.ListQuery))
+	})
+	return _c
+}
+
+func (_c *OrderElasticRepository_FullTextSearchOrders_Call) Return(_a0 *utils.ListResult[*read_models.OrderReadModel], _a1 error) *OrderElasticRepository_FullTextSearchOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderElasticRepository_FullTextSearchOrders_Call) RunAndReturn(run func(context.Context, *repositories.OrderSearchCriteria, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)) *OrderElasticRepository_FullTextSearchOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllOrders provides a mock function with given fields: ctx, listQuery
 func (_m *OrderElasticRepository) GetAllOrders(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
 	ret := _m.Called(ctx, listQuery)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
 	var r0 *utils.ListResult[*read_models.OrderReadModel]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)); ok {
@@ -183,6 +302,10 @@ func (_c *OrderElasticRepository_GetAllOrders_Call) RunAndReturn(run func(contex
 func (_m *OrderElasticRepository) GetOrderById(ctx context.Context, _a1 uuid.UUID) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderById")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*read_models.OrderReadModel, error)); ok {
@@ -238,6 +361,10 @@ func (_c *OrderElasticRepository_GetOrderById_Call) RunAndReturn(run func(contex
 func (_m *OrderElasticRepository) GetOrderByOrderId(ctx context.Context, orderId uuid.UUID) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, orderId)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByOrderId")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*read_models.OrderReadModel, error)); ok {
@@ -293,6 +420,10 @@ func (_c *OrderElasticRepository_GetOrderByOrderId_Call) RunAndReturn(run func(c
 func (_m *OrderElasticRepository) SearchOrders(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
 	ret := _m.Called(ctx, searchText, listQuery)
 
+	if len(ret) == 0 {
+		panic("no return value specified for SearchOrders")
+	}
+
 	var r0 *utils.ListResult[*read_models.OrderReadModel]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)); ok {
@@ -349,6 +480,10 @@ func (_c *OrderElasticRepository_SearchOrders_Call) RunAndReturn(run func(contex
 func (_m *OrderElasticRepository) UpdateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, order)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrder")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *read_models.OrderReadModel) (*read_models.OrderReadModel, error)); ok {
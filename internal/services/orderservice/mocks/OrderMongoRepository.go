@@ -30,6 +30,10 @@ func (_m *OrderMongoRepository) EXPECT() *OrderMongoRepository_Expecter {
 func (_m *OrderMongoRepository) CreateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, order)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *read_models.OrderReadModel) (*read_models.OrderReadModel, error)); ok {
@@ -81,10 +85,60 @@ func (_c *OrderMongoRepository_CreateOrder_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DeleteAllOrders provides a mock function with given fields: ctx
+func (_m *OrderMongoRepository) DeleteAllOrders(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllOrders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderMongoRepository_DeleteAllOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllOrders'
+type OrderMongoRepository_DeleteAllOrders_Call struct {
+	*mock.Call
+}
+
+// DeleteAllOrders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderMongoRepository_Expecter) DeleteAllOrders(ctx interface{}) *OrderMongoRepository_DeleteAllOrders_Call {
+	return &OrderMongoRepository_DeleteAllOrders_Call{Call: _e.mock.On("DeleteAllOrders", ctx)}
+}
+
+func (_c *OrderMongoRepository_DeleteAllOrders_Call) Run(run func(ctx context.Context)) *OrderMongoRepository_DeleteAllOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderMongoRepository_DeleteAllOrders_Call) Return(_a0 error) *OrderMongoRepository_DeleteAllOrders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderMongoRepository_DeleteAllOrders_Call) RunAndReturn(run func(context.Context) error) *OrderMongoRepository_DeleteAllOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteOrderByID provides a mock function with given fields: ctx, _a1
 func (_m *OrderMongoRepository) DeleteOrderByID(ctx context.Context, _a1 uuid.UUID) error {
 	ret := _m.Called(ctx, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrderByID")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
 		r0 = rf(ctx, _a1)
@@ -128,6 +182,10 @@ func (_c *OrderMongoRepository_DeleteOrderByID_Call) RunAndReturn(run func(conte
 func (_m *OrderMongoRepository) GetAllOrders(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
 	ret := _m.Called(ctx, listQuery)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
 	var r0 *utils.ListResult[*read_models.OrderReadModel]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)); ok {
@@ -183,6 +241,10 @@ func (_c *OrderMongoRepository_GetAllOrders_Call) RunAndReturn(run func(context.
 func (_m *OrderMongoRepository) GetOrderById(ctx context.Context, _a1 uuid.UUID) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderById")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*read_models.OrderReadModel, error)); ok {
@@ -238,6 +300,10 @@ func (_c *OrderMongoRepository_GetOrderById_Call) RunAndReturn(run func(context.
 func (_m *OrderMongoRepository) GetOrderByOrderId(ctx context.Context, orderId uuid.UUID) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, orderId)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByOrderId")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*read_models.OrderReadModel, error)); ok {
@@ -293,6 +359,10 @@ func (_c *OrderMongoRepository_GetOrderByOrderId_Call) RunAndReturn(run func(con
 func (_m *OrderMongoRepository) SearchOrders(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
 	ret := _m.Called(ctx, searchText, listQuery)
 
+	if len(ret) == 0 {
+		panic("no return value specified for SearchOrders")
+	}
+
 	var r0 *utils.ListResult[*read_models.OrderReadModel]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)); ok {
@@ -349,6 +419,10 @@ func (_c *OrderMongoRepository_SearchOrders_Call) RunAndReturn(run func(context.
 func (_m *OrderMongoRepository) UpdateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, order)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrder")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *read_models.OrderReadModel) (*read_models.OrderReadModel, error)); ok {
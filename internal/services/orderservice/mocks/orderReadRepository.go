@@ -30,6 +30,10 @@ func (_m *orderReadRepository) EXPECT() *orderReadRepository_Expecter {
 func (_m *orderReadRepository) CreateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, order)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *read_models.OrderReadModel) (*read_models.OrderReadModel, error)); ok {
@@ -81,10 +85,60 @@ func (_c *orderReadRepository_CreateOrder_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DeleteAllOrders provides a mock function with given fields: ctx
+func (_m *orderReadRepository) DeleteAllOrders(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllOrders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// orderReadRepository_DeleteAllOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllOrders'
+type orderReadRepository_DeleteAllOrders_Call struct {
+	*mock.Call
+}
+
+// DeleteAllOrders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *orderReadRepository_Expecter) DeleteAllOrders(ctx interface{}) *orderReadRepository_DeleteAllOrders_Call {
+	return &orderReadRepository_DeleteAllOrders_Call{Call: _e.mock.On("DeleteAllOrders", ctx)}
+}
+
+func (_c *orderReadRepository_DeleteAllOrders_Call) Run(run func(ctx context.Context)) *orderReadRepository_DeleteAllOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *orderReadRepository_DeleteAllOrders_Call) Return(_a0 error) *orderReadRepository_DeleteAllOrders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *orderReadRepository_DeleteAllOrders_Call) RunAndReturn(run func(context.Context) error) *orderReadRepository_DeleteAllOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteOrderByID provides a mock function with given fields: ctx, _a1
 func (_m *orderReadRepository) DeleteOrderByID(ctx context.Context, _a1 uuid.UUID) error {
 	ret := _m.Called(ctx, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrderByID")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
 		r0 = rf(ctx, _a1)
@@ -128,6 +182,10 @@ func (_c *orderReadRepository_DeleteOrderByID_Call) RunAndReturn(run func(contex
 func (_m *orderReadRepository) GetAllOrders(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
 	ret := _m.Called(ctx, listQuery)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
 	var r0 *utils.ListResult[*read_models.OrderReadModel]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)); ok {
@@ -183,6 +241,10 @@ func (_c *orderReadRepository_GetAllOrders_Call) RunAndReturn(run func(context.C
 func (_m *orderReadRepository) GetOrderById(ctx context.Context, _a1 uuid.UUID) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderById")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*read_models.OrderReadModel, error)); ok {
@@ -238,6 +300,10 @@ func (_c *orderReadRepository_GetOrderById_Call) RunAndReturn(run func(context.C
 func (_m *orderReadRepository) GetOrderByOrderId(ctx context.Context, orderId uuid.UUID) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, orderId)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByOrderId")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*read_models.OrderReadModel, error)); ok {
@@ -293,6 +359,10 @@ func (_c *orderReadRepository_GetOrderByOrderId_Call) RunAndReturn(run func(cont
 func (_m *orderReadRepository) SearchOrders(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
 	ret := _m.Called(ctx, searchText, listQuery)
 
+	if len(ret) == 0 {
+		panic("no return value specified for SearchOrders")
+	}
+
 	var r0 *utils.ListResult[*read_models.OrderReadModel]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)); ok {
@@ -349,6 +419,10 @@ func (_c *orderReadRepository_SearchOrders_Call) RunAndReturn(run func(context.C
 func (_m *orderReadRepository) UpdateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
 	ret := _m.Called(ctx, order)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrder")
+	}
+
 	var r0 *read_models.OrderReadModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *read_models.OrderReadModel) (*read_models.OrderReadModel, error)); ok {